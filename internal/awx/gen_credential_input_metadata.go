@@ -0,0 +1,465 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// credentialInputMetadataAttrTypes are the attributes of the metadata of a
+// credential input source, one nested object per external credential type
+// with the lookup metadata of that type. At most one of them is set, the one
+// of the type of the source credential, see
+// validateCredentialInputSourceMetadata.
+var credentialInputMetadataAttrTypes = map[string]attr.Type{
+	"aim": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"object_property":     types.StringType,
+		"object_query":        types.StringType,
+		"object_query_format": types.StringType,
+		"reason":              types.StringType,
+	}},
+	"aws_secretsmanager_credential": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"region_name": types.StringType,
+		"secret_name": types.StringType,
+	}},
+	"azure_kv": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"secret_field":   types.StringType,
+		"secret_version": types.StringType,
+	}},
+	"conjur": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"secret_path":    types.StringType,
+		"secret_version": types.StringType,
+	}},
+	"hashivault_kv": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"auth_path":      types.StringType,
+		"secret_backend": types.StringType,
+		"secret_key":     types.StringType,
+		"secret_path":    types.StringType,
+		"secret_version": types.StringType,
+	}},
+	"hashivault_ssh": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"auth_path":        types.StringType,
+		"public_key":       types.StringType,
+		"role":             types.StringType,
+		"secret_path":      types.StringType,
+		"valid_principals": types.StringType,
+	}},
+	"thycotic_dsv": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"path":            types.StringType,
+		"secret_decoding": types.StringType,
+		"secret_field":    types.StringType,
+	}},
+	"thycotic_tss": types.ObjectType{AttrTypes: map[string]attr.Type{
+		"secret_field": types.StringType,
+		"secret_id":    types.StringType,
+	}},
+}
+
+// credentialInputMetadataResourceAttributes returns the resource schema of the
+// attributes in credentialInputMetadataAttrTypes.
+func credentialInputMetadataResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"aim": schema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"CyberArk Central Credential Provider Lookup\" credential type, see `awx_credential_aim`.",
+			Optional:    true,
+			Validators: []validator.Object{
+				objectvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("aws_secretsmanager_credential"),
+					path.MatchRelative().AtParent().AtName("azure_kv"),
+					path.MatchRelative().AtParent().AtName("conjur"),
+					path.MatchRelative().AtParent().AtName("hashivault_kv"),
+					path.MatchRelative().AtParent().AtName("hashivault_ssh"),
+					path.MatchRelative().AtParent().AtName("thycotic_dsv"),
+					path.MatchRelative().AtParent().AtName("thycotic_tss"),
+				),
+			},
+			Attributes: map[string]schema.Attribute{
+				"object_property": schema.StringAttribute{
+					Description: "The property of the object to return. Available properties: Username, Password and Address.",
+					Optional:    true,
+				},
+				"object_query": schema.StringAttribute{
+					Description: "Lookup query for the object. Ex: Safe=TestSafe;Object=testAccountName123.",
+					Required:    true,
+				},
+				"object_query_format": schema.StringAttribute{
+					Description: "Object Query Format.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("Exact", "Regexp"),
+					},
+				},
+				"reason": schema.StringAttribute{
+					Description: "Object request reason. This is only needed if it is required by the object's policy.",
+					Optional:    true,
+				},
+			},
+		},
+		"aws_secretsmanager_credential": schema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"AWS Secrets Manager lookup\" credential type, see `awx_credential_aws_secretsmanager`.",
+			Optional:    true,
+			Validators: []validator.Object{
+				objectvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("aim"),
+					path.MatchRelative().AtParent().AtName("azure_kv"),
+					path.MatchRelative().AtParent().AtName("conjur"),
+					path.MatchRelative().AtParent().AtName("hashivault_kv"),
+					path.MatchRelative().AtParent().AtName("hashivault_ssh"),
+					path.MatchRelative().AtParent().AtName("thycotic_dsv"),
+					path.MatchRelative().AtParent().AtName("thycotic_tss"),
+				),
+			},
+			Attributes: map[string]schema.Attribute{
+				"region_name": schema.StringAttribute{
+					Description: "Region which the secrets manager is located.",
+					Required:    true,
+				},
+				"secret_name": schema.StringAttribute{
+					Description: "AWS Secret Name.",
+					Required:    true,
+				},
+			},
+		},
+		"azure_kv": schema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"Microsoft Azure Key Vault\" credential type, see `awx_credential_azure_kv`.",
+			Optional:    true,
+			Validators: []validator.Object{
+				objectvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("aim"),
+					path.MatchRelative().AtParent().AtName("aws_secretsmanager_credential"),
+					path.MatchRelative().AtParent().AtName("conjur"),
+					path.MatchRelative().AtParent().AtName("hashivault_kv"),
+					path.MatchRelative().AtParent().AtName("hashivault_ssh"),
+					path.MatchRelative().AtParent().AtName("thycotic_dsv"),
+					path.MatchRelative().AtParent().AtName("thycotic_tss"),
+				),
+			},
+			Attributes: map[string]schema.Attribute{
+				"secret_field": schema.StringAttribute{
+					Description: "The name of the secret to look up.",
+					Required:    true,
+				},
+				"secret_version": schema.StringAttribute{
+					Description: "Used to specify a specific secret version (if left empty, the latest version will be used).",
+					Optional:    true,
+				},
+			},
+		},
+		"conjur": schema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"CyberArk Conjur Secrets Manager Lookup\" credential type, see `awx_credential_conjur`.",
+			Optional:    true,
+			Validators: []validator.Object{
+				objectvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("aim"),
+					path.MatchRelative().AtParent().AtName("aws_secretsmanager_credential"),
+					path.MatchRelative().AtParent().AtName("azure_kv"),
+					path.MatchRelative().AtParent().AtName("hashivault_kv"),
+					path.MatchRelative().AtParent().AtName("hashivault_ssh"),
+					path.MatchRelative().AtParent().AtName("thycotic_dsv"),
+					path.MatchRelative().AtParent().AtName("thycotic_tss"),
+				),
+			},
+			Attributes: map[string]schema.Attribute{
+				"secret_path": schema.StringAttribute{
+					Description: "The identifier for the secret e.g., /some/identifier.",
+					Optional:    true,
+				},
+				"secret_version": schema.StringAttribute{
+					Description: "Used to specify a specific secret version (if left empty, the latest version will be used).",
+					Optional:    true,
+				},
+			},
+		},
+		"hashivault_kv": schema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"HashiCorp Vault Secret Lookup\" credential type, see `awx_credential_hashivault_kv`.",
+			Optional:    true,
+			Validators: []validator.Object{
+				objectvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("aim"),
+					path.MatchRelative().AtParent().AtName("aws_secretsmanager_credential"),
+					path.MatchRelative().AtParent().AtName("azure_kv"),
+					path.MatchRelative().AtParent().AtName("conjur"),
+					path.MatchRelative().AtParent().AtName("hashivault_ssh"),
+					path.MatchRelative().AtParent().AtName("thycotic_dsv"),
+					path.MatchRelative().AtParent().AtName("thycotic_tss"),
+				),
+			},
+			Attributes: map[string]schema.Attribute{
+				"auth_path": schema.StringAttribute{
+					Description: "The path where the Authentication method is mounted e.g, approle.",
+					Optional:    true,
+				},
+				"secret_backend": schema.StringAttribute{
+					Description: "The name of the kv secret backend (if left empty, the first segment of the secret path will be used).",
+					Optional:    true,
+				},
+				"secret_key": schema.StringAttribute{
+					Description: "The name of the key to look up in the secret.",
+					Required:    true,
+				},
+				"secret_path": schema.StringAttribute{
+					Description: "The path to the secret stored in the secret backend e.g, /some/secret/. It is recommended that you use the secret backend field to identify the storage backend and to use this field for locating a specific secret within that store. However, if you prefer to fully identify both the secret backend and one of its secrets using only this field, join their locations into a single path without any additional separators, e.g, /location/of/backend/some/secret.",
+					Required:    true,
+				},
+				"secret_version": schema.StringAttribute{
+					Description: "Used to specify a specific secret version (if left empty, the latest version will be used).",
+					Optional:    true,
+				},
+			},
+		},
+		"hashivault_ssh": schema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"HashiCorp Vault Signed SSH\" credential type, see `awx_credential_hashivault_ssh`.",
+			Optional:    true,
+			Validators: []validator.Object{
+				objectvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("aim"),
+					path.MatchRelative().AtParent().AtName("aws_secretsmanager_credential"),
+					path.MatchRelative().AtParent().AtName("azure_kv"),
+					path.MatchRelative().AtParent().AtName("conjur"),
+					path.MatchRelative().AtParent().AtName("hashivault_kv"),
+					path.MatchRelative().AtParent().AtName("thycotic_dsv"),
+					path.MatchRelative().AtParent().AtName("thycotic_tss"),
+				),
+			},
+			Attributes: map[string]schema.Attribute{
+				"auth_path": schema.StringAttribute{
+					Description: "The path where the Authentication method is mounted e.g, approle.",
+					Optional:    true,
+				},
+				"public_key": schema.StringAttribute{
+					Description: "Unsigned Public Key.",
+					Required:    true,
+				},
+				"role": schema.StringAttribute{
+					Description: "The name of the role used to sign.",
+					Required:    true,
+				},
+				"secret_path": schema.StringAttribute{
+					Description: "The path to the secret stored in the secret backend e.g, /some/secret/. It is recommended that you use the secret backend field to identify the storage backend and to use this field for locating a specific secret within that store. However, if you prefer to fully identify both the secret backend and one of its secrets using only this field, join their locations into a single path without any additional separators, e.g, /location/of/backend/some/secret.",
+					Required:    true,
+				},
+				"valid_principals": schema.StringAttribute{
+					Description: "Valid principals (either usernames or hostnames) that the certificate should be signed for.",
+					Optional:    true,
+				},
+			},
+		},
+		"thycotic_dsv": schema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"Thycotic DevOps Secrets Vault\" credential type, see `awx_credential_thycotic_dsv`.",
+			Optional:    true,
+			Validators: []validator.Object{
+				objectvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("aim"),
+					path.MatchRelative().AtParent().AtName("aws_secretsmanager_credential"),
+					path.MatchRelative().AtParent().AtName("azure_kv"),
+					path.MatchRelative().AtParent().AtName("conjur"),
+					path.MatchRelative().AtParent().AtName("hashivault_kv"),
+					path.MatchRelative().AtParent().AtName("hashivault_ssh"),
+					path.MatchRelative().AtParent().AtName("thycotic_tss"),
+				),
+			},
+			Attributes: map[string]schema.Attribute{
+				"path": schema.StringAttribute{
+					Description: "The secret path e.g. /test/secret1.",
+					Required:    true,
+				},
+				"secret_decoding": schema.StringAttribute{
+					Description: "Specify whether the secret should be base64 decoded, typically used for storing files, such as SSH keys.",
+					Required:    true,
+					Validators: []validator.String{
+						stringvalidator.OneOf("No Decoding", "Decode Base64"),
+					},
+				},
+				"secret_field": schema.StringAttribute{
+					Description: "The field to extract from the secret.",
+					Required:    true,
+				},
+			},
+		},
+		"thycotic_tss": schema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"Thycotic Secret Server\" credential type, see `awx_credential_thycotic_tss`.",
+			Optional:    true,
+			Validators: []validator.Object{
+				objectvalidator.ConflictsWith(
+					path.MatchRelative().AtParent().AtName("aim"),
+					path.MatchRelative().AtParent().AtName("aws_secretsmanager_credential"),
+					path.MatchRelative().AtParent().AtName("azure_kv"),
+					path.MatchRelative().AtParent().AtName("conjur"),
+					path.MatchRelative().AtParent().AtName("hashivault_kv"),
+					path.MatchRelative().AtParent().AtName("hashivault_ssh"),
+					path.MatchRelative().AtParent().AtName("thycotic_dsv"),
+				),
+			},
+			Attributes: map[string]schema.Attribute{
+				"secret_field": schema.StringAttribute{
+					Description: "The field to extract from the secret.",
+					Required:    true,
+				},
+				"secret_id": schema.StringAttribute{
+					Description: "The integer ID of the secret.",
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// credentialInputMetadataDataSourceAttributes returns the data source schema
+// of the attributes in credentialInputMetadataAttrTypes.
+func credentialInputMetadataDataSourceAttributes() map[string]dschema.Attribute {
+	return map[string]dschema.Attribute{
+		"aim": dschema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"CyberArk Central Credential Provider Lookup\" credential type, see `awx_credential_aim`.",
+			Computed:    true,
+			Attributes: map[string]dschema.Attribute{
+				"object_property": dschema.StringAttribute{
+					Description: "The property of the object to return. Available properties: Username, Password and Address.",
+					Computed:    true,
+				},
+				"object_query": dschema.StringAttribute{
+					Description: "Lookup query for the object. Ex: Safe=TestSafe;Object=testAccountName123.",
+					Computed:    true,
+				},
+				"object_query_format": dschema.StringAttribute{
+					Description: "Object Query Format.",
+					Computed:    true,
+				},
+				"reason": dschema.StringAttribute{
+					Description: "Object request reason. This is only needed if it is required by the object's policy.",
+					Computed:    true,
+				},
+			},
+		},
+		"aws_secretsmanager_credential": dschema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"AWS Secrets Manager lookup\" credential type, see `awx_credential_aws_secretsmanager`.",
+			Computed:    true,
+			Attributes: map[string]dschema.Attribute{
+				"region_name": dschema.StringAttribute{
+					Description: "Region which the secrets manager is located.",
+					Computed:    true,
+				},
+				"secret_name": dschema.StringAttribute{
+					Description: "AWS Secret Name.",
+					Computed:    true,
+				},
+			},
+		},
+		"azure_kv": dschema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"Microsoft Azure Key Vault\" credential type, see `awx_credential_azure_kv`.",
+			Computed:    true,
+			Attributes: map[string]dschema.Attribute{
+				"secret_field": dschema.StringAttribute{
+					Description: "The name of the secret to look up.",
+					Computed:    true,
+				},
+				"secret_version": dschema.StringAttribute{
+					Description: "Used to specify a specific secret version (if left empty, the latest version will be used).",
+					Computed:    true,
+				},
+			},
+		},
+		"conjur": dschema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"CyberArk Conjur Secrets Manager Lookup\" credential type, see `awx_credential_conjur`.",
+			Computed:    true,
+			Attributes: map[string]dschema.Attribute{
+				"secret_path": dschema.StringAttribute{
+					Description: "The identifier for the secret e.g., /some/identifier.",
+					Computed:    true,
+				},
+				"secret_version": dschema.StringAttribute{
+					Description: "Used to specify a specific secret version (if left empty, the latest version will be used).",
+					Computed:    true,
+				},
+			},
+		},
+		"hashivault_kv": dschema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"HashiCorp Vault Secret Lookup\" credential type, see `awx_credential_hashivault_kv`.",
+			Computed:    true,
+			Attributes: map[string]dschema.Attribute{
+				"auth_path": dschema.StringAttribute{
+					Description: "The path where the Authentication method is mounted e.g, approle.",
+					Computed:    true,
+				},
+				"secret_backend": dschema.StringAttribute{
+					Description: "The name of the kv secret backend (if left empty, the first segment of the secret path will be used).",
+					Computed:    true,
+				},
+				"secret_key": dschema.StringAttribute{
+					Description: "The name of the key to look up in the secret.",
+					Computed:    true,
+				},
+				"secret_path": dschema.StringAttribute{
+					Description: "The path to the secret stored in the secret backend e.g, /some/secret/. It is recommended that you use the secret backend field to identify the storage backend and to use this field for locating a specific secret within that store. However, if you prefer to fully identify both the secret backend and one of its secrets using only this field, join their locations into a single path without any additional separators, e.g, /location/of/backend/some/secret.",
+					Computed:    true,
+				},
+				"secret_version": dschema.StringAttribute{
+					Description: "Used to specify a specific secret version (if left empty, the latest version will be used).",
+					Computed:    true,
+				},
+			},
+		},
+		"hashivault_ssh": dschema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"HashiCorp Vault Signed SSH\" credential type, see `awx_credential_hashivault_ssh`.",
+			Computed:    true,
+			Attributes: map[string]dschema.Attribute{
+				"auth_path": dschema.StringAttribute{
+					Description: "The path where the Authentication method is mounted e.g, approle.",
+					Computed:    true,
+				},
+				"public_key": dschema.StringAttribute{
+					Description: "Unsigned Public Key.",
+					Computed:    true,
+				},
+				"role": dschema.StringAttribute{
+					Description: "The name of the role used to sign.",
+					Computed:    true,
+				},
+				"secret_path": dschema.StringAttribute{
+					Description: "The path to the secret stored in the secret backend e.g, /some/secret/. It is recommended that you use the secret backend field to identify the storage backend and to use this field for locating a specific secret within that store. However, if you prefer to fully identify both the secret backend and one of its secrets using only this field, join their locations into a single path without any additional separators, e.g, /location/of/backend/some/secret.",
+					Computed:    true,
+				},
+				"valid_principals": dschema.StringAttribute{
+					Description: "Valid principals (either usernames or hostnames) that the certificate should be signed for.",
+					Computed:    true,
+				},
+			},
+		},
+		"thycotic_dsv": dschema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"Thycotic DevOps Secrets Vault\" credential type, see `awx_credential_thycotic_dsv`.",
+			Computed:    true,
+			Attributes: map[string]dschema.Attribute{
+				"path": dschema.StringAttribute{
+					Description: "The secret path e.g. /test/secret1.",
+					Computed:    true,
+				},
+				"secret_decoding": dschema.StringAttribute{
+					Description: "Specify whether the secret should be base64 decoded, typically used for storing files, such as SSH keys.",
+					Computed:    true,
+				},
+				"secret_field": dschema.StringAttribute{
+					Description: "The field to extract from the secret.",
+					Computed:    true,
+				},
+			},
+		},
+		"thycotic_tss": dschema.SingleNestedAttribute{
+			Description: "The lookup metadata for a source credential of the \"Thycotic Secret Server\" credential type, see `awx_credential_thycotic_tss`.",
+			Computed:    true,
+			Attributes: map[string]dschema.Attribute{
+				"secret_field": dschema.StringAttribute{
+					Description: "The field to extract from the secret.",
+					Computed:    true,
+				},
+				"secret_id": dschema.StringAttribute{
+					Description: "The integer ID of the secret.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
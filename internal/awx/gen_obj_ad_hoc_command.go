@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
@@ -55,6 +58,12 @@ type adHocCommandTerraformModel struct {
 	Status               types.String  `tfsdk:"status" json:"status"`
 	Verbosity            types.String  `tfsdk:"verbosity" json:"verbosity"`
 	WorkUnitId           types.String  `tfsdk:"work_unit_id" json:"work_unit_id"`
+	// WaitForCompletion is a Terraform-only toggle, not synced to the AWX API.
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion" json:"-"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts" json:"-"`
+	// Stdout is captured after the wait, not synced to the AWX API.
+	Stdout         types.String `tfsdk:"stdout" json:"-"`
+	StdoutMaxBytes types.Int64  `tfsdk:"stdout_max_bytes" json:"-"`
 }
 
 func (o *adHocCommandTerraformModel) Clone() adHocCommandTerraformModel {
@@ -143,6 +152,7 @@ func NewAdHocCommandResource() resource.Resource {
 						Computed:    true,
 						PlanModifiers: []planmodifier.Bool{
 							boolplanmodifier.UseStateForUnknown(),
+							boolplanmodifier.RequiresReplace(),
 						},
 					},
 					"credential": schema.Int64Attribute{
@@ -151,6 +161,7 @@ func NewAdHocCommandResource() resource.Resource {
 						Computed:    true,
 						PlanModifiers: []planmodifier.Int64{
 							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplace(),
 						},
 					},
 					"diff_mode": schema.BoolAttribute{
@@ -159,6 +170,7 @@ func NewAdHocCommandResource() resource.Resource {
 						Computed:    true,
 						PlanModifiers: []planmodifier.Bool{
 							boolplanmodifier.UseStateForUnknown(),
+							boolplanmodifier.RequiresReplace(),
 						},
 					},
 					"execution_environment": schema.Int64Attribute{
@@ -167,6 +179,7 @@ func NewAdHocCommandResource() resource.Resource {
 						Computed:    true,
 						PlanModifiers: []planmodifier.Int64{
 							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplace(),
 						},
 					},
 					"extra_vars": schema.StringAttribute{
@@ -176,6 +189,7 @@ func NewAdHocCommandResource() resource.Resource {
 						Default:     stringdefault.StaticString(``),
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
 						},
 					},
 					"forks": schema.Int64Attribute{
@@ -185,6 +199,7 @@ func NewAdHocCommandResource() resource.Resource {
 						Default:     int64default.StaticInt64(0),
 						PlanModifiers: []planmodifier.Int64{
 							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplace(),
 						},
 						Validators: []validator.Int64{
 							int64validator.Between(0, 2147483647),
@@ -196,6 +211,7 @@ func NewAdHocCommandResource() resource.Resource {
 						Computed:    true,
 						PlanModifiers: []planmodifier.Int64{
 							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplace(),
 						},
 					},
 					"job_type": schema.StringAttribute{
@@ -205,6 +221,7 @@ func NewAdHocCommandResource() resource.Resource {
 						Default:     stringdefault.StaticString(`run`),
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
 						},
 						Validators: []validator.String{
 							stringvalidator.OneOf(
@@ -220,6 +237,7 @@ func NewAdHocCommandResource() resource.Resource {
 						Default:     stringdefault.StaticString(``),
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
 						},
 					},
 					"module_args": schema.StringAttribute{
@@ -229,6 +247,7 @@ func NewAdHocCommandResource() resource.Resource {
 						Default:     stringdefault.StaticString(``),
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
 						},
 					},
 					"module_name": schema.StringAttribute{
@@ -238,6 +257,7 @@ func NewAdHocCommandResource() resource.Resource {
 						Default:     stringdefault.StaticString(`command`),
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
 						},
 						Validators: []validator.String{
 							stringvalidator.OneOf(
@@ -270,6 +290,7 @@ func NewAdHocCommandResource() resource.Resource {
 						Default:     stringdefault.StaticString(`0`),
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
 						},
 						Validators: []validator.String{
 							stringvalidator.OneOf(
@@ -405,13 +426,93 @@ func NewAdHocCommandResource() resource.Resource {
 							stringplanmodifier.UseStateForUnknown(),
 						},
 					},
+					"wait_for_completion": schema.BoolAttribute{
+						Description: "If true, wait for the ad hoc command to finish before returning, and fail when it does not succeed. Configure the maximum wait via the timeouts block.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"stdout": schema.StringAttribute{
+						Description: "Standard output of the ad hoc command, captured once it finished when wait_for_completion is set. Truncated to stdout_max_bytes.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"stdout_max_bytes": schema.Int64Attribute{
+						Description: "Maximum number of bytes of the standard output to keep in stdout, 0 to not capture it.",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(1048576),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
 				},
 			},
 			IDAccessor: func(m *adHocCommandTerraformModel) any { return m.ID.ValueInt64() },
 			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+				}},
+			},
+			Immutable: true,
 			Hook: func(ctx context.Context, apiVersion string, source hooks.Source, callee hooks.Callee, orig, state *adHocCommandTerraformModel) error {
 				return hooks.RequireResourceStateOrOrig(ctx, apiVersion, source, callee, orig, state)
 			},
+			EmitTimeouts: true,
+			CopyExtraAttributes: func(plan, state *adHocCommandTerraformModel) {
+				state.WaitForCompletion = plan.WaitForCompletion
+				state.Timeouts = plan.Timeouts
+				state.StdoutMaxBytes = plan.StdoutMaxBytes
+				if !plan.Stdout.IsUnknown() {
+					state.Stdout = plan.Stdout
+				}
+			},
+			WaitLifecycle: &framework.WaitLifecycleCfg[adHocCommandTerraformModel]{
+				ShouldWait: func(plan *adHocCommandTerraformModel) bool {
+					return !plan.WaitForCompletion.IsNull() && plan.WaitForCompletion.ValueBool()
+				},
+				EndpointForModel: func(m *adHocCommandTerraformModel) string {
+					if m.ID.IsNull() || m.ID.IsUnknown() {
+						return ""
+					}
+					if m.ID.ValueInt64() == 0 {
+						return ""
+					}
+					return framework.EndpointWithID("/api/v2/ad_hoc_commands/", m.ID.ValueInt64())
+				},
+				Field:          "status",
+				SuccessValues:  []string{"successful"},
+				FailureValues:  []string{"failed", "error", "canceled"},
+				PollInterval:   5 * time.Second,
+				DefaultTimeout: 30 * time.Minute,
+				ResolveTimeout: func(ctx context.Context, plan *adHocCommandTerraformModel, callee hooks.Callee) (time.Duration, diag.Diagnostics) {
+					if callee == hooks.CalleeUpdate {
+						return plan.Timeouts.Update(ctx, 30*time.Minute)
+					}
+					return plan.Timeouts.Create(ctx, 30*time.Minute)
+				},
+				RefreshAfterWait: true,
+				Output: &framework.WaitOutputCfg[adHocCommandTerraformModel]{
+					EndpointForModel: func(m *adHocCommandTerraformModel) string {
+						return fmt.Sprintf("/api/v2/ad_hoc_commands/%d/stdout/?format=txt_download", m.ID.ValueInt64())
+					},
+					MaxBytes: func(plan *adHocCommandTerraformModel) int64 {
+						return plan.StdoutMaxBytes.ValueInt64()
+					},
+					SetOutput: func(state *adHocCommandTerraformModel, output string) {
+						state.Stdout = types.StringValue(output)
+					},
+				},
+			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "AdHocCommand",
 		},
@@ -563,3 +664,126 @@ func NewAdHocCommandDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type adHocCommandListDataSource = framework.GenericListDataSource[adHocCommandTerraformModel, *adHocCommandTerraformModel]
+
+// NewAdHocCommandListDataSource is a helper function to instantiate the AdHocCommand list data source.
+func NewAdHocCommandListDataSource() datasource.DataSource {
+	return &adHocCommandListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "ad_hoc_commands", Endpoint: "/api/v2/ad_hoc_commands/"}},
+		Cfg: framework.ListDataSourceCfg[adHocCommandTerraformModel]{
+			Description: "Lists every AdHocCommand matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"become_enabled": dschema.BoolAttribute{
+					Description: "Become enabled",
+					Computed:    true,
+				},
+				"canceled_on": dschema.StringAttribute{
+					Description: "The date and time when the cancel request was sent.",
+					Computed:    true,
+				},
+				"controller_node": dschema.StringAttribute{
+					Description: "The instance that managed the execution environment.",
+					Computed:    true,
+				},
+				"credential": dschema.Int64Attribute{
+					Description: "Credential",
+					Computed:    true,
+				},
+				"diff_mode": dschema.BoolAttribute{
+					Description: "Diff mode",
+					Computed:    true,
+				},
+				"elapsed": dschema.Float64Attribute{
+					Description: "Elapsed time in seconds that the job ran.",
+					Computed:    true,
+				},
+				"execution_environment": dschema.Int64Attribute{
+					Description: "The container image to be used for execution.",
+					Computed:    true,
+				},
+				"execution_node": dschema.StringAttribute{
+					Description: "The node the job executed on.",
+					Computed:    true,
+				},
+				"extra_vars": dschema.StringAttribute{
+					Description: "Extra vars",
+					Computed:    true,
+				},
+				"failed": dschema.BoolAttribute{
+					Description: "Failed",
+					Computed:    true,
+				},
+				"finished": dschema.StringAttribute{
+					Description: "The date and time the job finished execution.",
+					Computed:    true,
+				},
+				"forks": dschema.Int64Attribute{
+					Description: "Forks",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this ad hoc command.",
+					Computed:    true,
+				},
+				"inventory": dschema.Int64Attribute{
+					Description: "Inventory",
+					Computed:    true,
+				},
+				"job_explanation": dschema.StringAttribute{
+					Description: "A status field to indicate the state of the job if it wasn't able to run and capture stdout",
+					Computed:    true,
+				},
+				"job_type": dschema.StringAttribute{
+					Description: "Job type",
+					Computed:    true,
+				},
+				"launch_type": dschema.StringAttribute{
+					Description: "Launch type",
+					Computed:    true,
+				},
+				"launched_by": dschema.Int64Attribute{
+					Description: "Launched by",
+					Computed:    true,
+				},
+				"limit": dschema.StringAttribute{
+					Description: "Limit",
+					Computed:    true,
+				},
+				"module_args": dschema.StringAttribute{
+					Description: "Module args",
+					Computed:    true,
+				},
+				"module_name": dschema.StringAttribute{
+					Description: "Module name",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this ad hoc command.",
+					Computed:    true,
+				},
+				"started": dschema.StringAttribute{
+					Description: "The date and time the job was queued for starting.",
+					Computed:    true,
+				},
+				"status": dschema.StringAttribute{
+					Description: "Status",
+					Computed:    true,
+				},
+				"verbosity": dschema.StringAttribute{
+					Description: "Verbosity",
+					Computed:    true,
+				},
+				"work_unit_id": dschema.StringAttribute{
+					Description: "The Receptor work unit ID associated with this job.",
+					Computed:    true,
+				},
+			},
+			Hook: func(ctx context.Context, apiVersion string, source hooks.Source, callee hooks.Callee, orig, state *adHocCommandTerraformModel) error {
+				return hooks.RequireResourceStateOrOrig(ctx, apiVersion, source, callee, orig, state)
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "AdHocCommand",
+		},
+	}
+}
@@ -170,8 +170,21 @@ func NewApplicationResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *applicationTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *applicationTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
+				{Name: "by_name_organization", URLSuffix: "?name__exact=%s&organization=%d", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+					{Name: "organization", Type: "int64", URLEscape: false},
+				}},
+			},
 			Hook:         hookApplication,
 			ApiVersion:   ApiVersion,
 			ResourceName: "Application",
@@ -216,6 +229,7 @@ func NewApplicationDataSource() datasource.DataSource {
 						Validators: []validator.Int64{
 							int64validator.ConflictsWith(
 								path.MatchRoot("name"),
+								path.MatchRoot("organization_name"),
 								path.MatchRoot("organization"),
 							),
 						},
@@ -225,9 +239,6 @@ func NewApplicationDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.String{
-							stringvalidator.AlsoRequires(
-								path.MatchRoot("organization"),
-							),
 							stringvalidator.ConflictsWith(
 								path.MatchRoot("id"),
 							),
@@ -243,6 +254,7 @@ func NewApplicationDataSource() datasource.DataSource {
 							),
 							int64validator.ConflictsWith(
 								path.MatchRoot("id"),
+								path.MatchRoot("organization_name"),
 							),
 						},
 					},
@@ -254,12 +266,29 @@ func NewApplicationDataSource() datasource.DataSource {
 						Description: "Set True to skip authorization step for completely trusted applications.",
 						Computed:    true,
 					},
+					"organization_name": dschema.StringAttribute{
+						Description: "Search only, looks the Application up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+								path.MatchRoot("organization"),
+							),
+						},
+					},
 				},
 			},
 			SearchGroups: []framework.SearchGroup{
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
 				{Name: "by_name_organization", URLSuffix: "?name__exact=%s&organization=%d", Fields: []framework.SearchField{
 					{Name: "name", Type: "string", URLEscape: true},
 					{Name: "organization", Type: "int64", URLEscape: false},
@@ -271,3 +300,61 @@ func NewApplicationDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type applicationListDataSource = framework.GenericListDataSource[applicationTerraformModel, *applicationTerraformModel]
+
+// NewApplicationListDataSource is a helper function to instantiate the Application list data source.
+func NewApplicationListDataSource() datasource.DataSource {
+	return &applicationListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "applications", Endpoint: "/api/v2/applications/"}},
+		Cfg: framework.ListDataSourceCfg[applicationTerraformModel]{
+			Description: "Lists every Application matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"authorization_grant_type": dschema.StringAttribute{
+					Description: "The Grant type the user must use for acquire tokens for this application.",
+					Computed:    true,
+				},
+				"client_id": dschema.StringAttribute{
+					Description: "Client id",
+					Computed:    true,
+				},
+				"client_secret": dschema.StringAttribute{
+					Description: "Used for more stringent verification of access to an application when creating a token.",
+					Sensitive:   true,
+					Computed:    true,
+				},
+				"client_type": dschema.StringAttribute{
+					Description: "Set to Public or Confidential depending on how secure the client device is.",
+					Computed:    true,
+				},
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this application.",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this application.",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this application.",
+					Computed:    true,
+				},
+				"organization": dschema.Int64Attribute{
+					Description: "Organization containing this application.",
+					Computed:    true,
+				},
+				"redirect_uris": dschema.StringAttribute{
+					Description: "Allowed URIs list, space separated",
+					Computed:    true,
+				},
+				"skip_authorization": dschema.BoolAttribute{
+					Description: "Set True to skip authorization step for completely trusted applications.",
+					Computed:    true,
+				},
+			},
+			Hook:         hookApplication,
+			ApiVersion:   ApiVersion,
+			ResourceName: "Application",
+		},
+	}
+}
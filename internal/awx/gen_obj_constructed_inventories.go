@@ -269,8 +269,16 @@ func NewConstructedInventoriesResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *constructedInventoriesTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *constructedInventoriesTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+				}},
+			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "ConstructedInventories",
 		},
@@ -396,3 +404,100 @@ func NewConstructedInventoriesDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type constructedInventoriesListDataSource = framework.GenericListDataSource[constructedInventoriesTerraformModel, *constructedInventoriesTerraformModel]
+
+// NewConstructedInventoriesListDataSource is a helper function to instantiate the ConstructedInventories list data source.
+func NewConstructedInventoriesListDataSource() datasource.DataSource {
+	return &constructedInventoriesListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "constructed_inventories_list", Endpoint: "/api/v2/constructed_inventories/"}},
+		Cfg: framework.ListDataSourceCfg[constructedInventoriesTerraformModel]{
+			Description: "Lists every ConstructedInventories matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this inventory.",
+					Computed:    true,
+				},
+				"has_active_failures": dschema.BoolAttribute{
+					DeprecationMessage: "This field is deprecated and will be removed in a future release.",
+					Description:        "Flag indicating whether any hosts in this inventory have failed.",
+					Computed:           true,
+				},
+				"has_inventory_sources": dschema.BoolAttribute{
+					DeprecationMessage: "This field is deprecated and will be removed in a future release.",
+					Description:        "Flag indicating whether this inventory has any external inventory sources.",
+					Computed:           true,
+				},
+				"hosts_with_active_failures": dschema.Int64Attribute{
+					DeprecationMessage: "This field is deprecated and will be removed in a future release.",
+					Description:        "Number of hosts in this inventory with active failures.",
+					Computed:           true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this inventory.",
+					Computed:    true,
+				},
+				"inventory_sources_with_failures": dschema.Int64Attribute{
+					Description: "Number of external inventory sources in this inventory with failures.",
+					Computed:    true,
+				},
+				"kind": dschema.StringAttribute{
+					Description: "Kind of inventory being represented.",
+					Computed:    true,
+				},
+				"limit": dschema.StringAttribute{
+					Description: "The limit to restrict the returned hosts for the related auto-created inventory source, special to constructed inventory.",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this inventory.",
+					Computed:    true,
+				},
+				"organization": dschema.Int64Attribute{
+					Description: "Organization containing this inventory.",
+					Computed:    true,
+				},
+				"pending_deletion": dschema.BoolAttribute{
+					Description: "Flag indicating the inventory is being deleted.",
+					Computed:    true,
+				},
+				"prevent_instance_group_fallback": dschema.BoolAttribute{
+					Description: "If enabled, the inventory will prevent adding any organization instance groups to the list of preferred instances groups to run associated job templates on.If this setting is enabled and you provided an empty list, the global instance groups will be applied.",
+					Computed:    true,
+				},
+				"source_vars": dschema.StringAttribute{
+					Description: "The source_vars for the related auto-created inventory source, special to constructed inventory.",
+					Computed:    true,
+				},
+				"total_groups": dschema.Int64Attribute{
+					DeprecationMessage: "This field is deprecated and will be removed in a future release.",
+					Description:        "Total number of groups in this inventory.",
+					Computed:           true,
+				},
+				"total_hosts": dschema.Int64Attribute{
+					DeprecationMessage: "This field is deprecated and will be removed in a future release.",
+					Description:        "Total number of hosts in this inventory.",
+					Computed:           true,
+				},
+				"total_inventory_sources": dschema.Int64Attribute{
+					Description: "Total number of external inventory sources configured within this inventory.",
+					Computed:    true,
+				},
+				"update_cache_timeout": dschema.Int64Attribute{
+					Description: "The cache timeout for the related auto-created inventory source, special to constructed inventory",
+					Computed:    true,
+				},
+				"variables": dschema.StringAttribute{
+					Description: "Inventory variables in JSON or YAML format.",
+					Computed:    true,
+				},
+				"verbosity": dschema.Int64Attribute{
+					Description: "The verbosity level for the related auto-created inventory source, special to constructed inventory",
+					Computed:    true,
+				},
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "ConstructedInventories",
+		},
+	}
+}
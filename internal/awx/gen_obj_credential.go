@@ -195,7 +195,21 @@ func NewCredentialResource() resource.Resource {
 			},
 			IDAccessor: func(m *credentialTerraformModel) any { return m.ID.ValueInt64() },
 			IDKey:      "id",
-			Hook:       hookCredential,
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s+%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "credential_type_name", Type: "string", URLEscape: false},
+					{Name: "credential_type_kind", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
+				{Name: "by_name", URLSuffix: "/?name__exact=%s", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+				}},
+			},
+			Hook: hookCredential,
 			WriteOnlyPlanToBody: func(plan *credentialTerraformModel, body *credentialBodyRequestModel) {
 				body.Team = plan.Team.ValueInt64()
 				body.User = plan.User.ValueInt64()
@@ -236,9 +250,11 @@ func NewCredentialDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.Int64{
-							int64validator.ExactlyOneOf(
-								path.MatchRoot("id"),
+							int64validator.ConflictsWith(
 								path.MatchRoot("name"),
+								path.MatchRoot("credential_type_name"),
+								path.MatchRoot("credential_type_kind"),
+								path.MatchRoot("organization_name"),
 							),
 						},
 					},
@@ -263,9 +279,8 @@ func NewCredentialDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.String{
-							stringvalidator.ExactlyOneOf(
+							stringvalidator.ConflictsWith(
 								path.MatchRoot("id"),
-								path.MatchRoot("name"),
 							),
 						},
 					},
@@ -283,12 +298,60 @@ func NewCredentialDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 					},
+					"credential_type_name": dschema.StringAttribute{
+						Description: "Search only, looks the Credential up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+								path.MatchRoot("credential_type_kind"),
+								path.MatchRoot("organization_name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
+					"credential_type_kind": dschema.StringAttribute{
+						Description: "Search only, looks the Credential up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+								path.MatchRoot("credential_type_name"),
+								path.MatchRoot("organization_name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
+					"organization_name": dschema.StringAttribute{
+						Description: "Search only, looks the Credential up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+								path.MatchRoot("credential_type_name"),
+								path.MatchRoot("credential_type_kind"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
 				},
 			},
 			SearchGroups: []framework.SearchGroup{
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s+%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "credential_type_name", Type: "string", URLEscape: false},
+					{Name: "credential_type_kind", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
 				{Name: "by_name", URLSuffix: "/?name__exact=%s", Fields: []framework.SearchField{
 					{Name: "name", Type: "string", URLEscape: true},
 				}},
@@ -299,3 +362,68 @@ func NewCredentialDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type credentialListDataSource = framework.GenericListDataSource[credentialTerraformModel, *credentialTerraformModel]
+
+// NewCredentialListDataSource is a helper function to instantiate the Credential list data source.
+func NewCredentialListDataSource() datasource.DataSource {
+	return &credentialListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "credentials", Endpoint: "/api/v2/credentials/"}},
+		Cfg: framework.ListDataSourceCfg[credentialTerraformModel]{
+			Description: "Lists every Credential matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"cloud": dschema.BoolAttribute{
+					Description: "Cloud",
+					Computed:    true,
+				},
+				"credential_type": dschema.Int64Attribute{
+					Description: "Specify the type of credential you want to create. Refer to the documentation for details on each type.",
+					Computed:    true,
+				},
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this credential.",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this credential.",
+					Computed:    true,
+				},
+				"inputs": dschema.StringAttribute{
+					Description: "Enter inputs using either JSON or YAML syntax. Refer to the documentation for example syntax.",
+					Computed:    true,
+				},
+				"kind": dschema.StringAttribute{
+					Description: "Kind",
+					Computed:    true,
+				},
+				"kubernetes": dschema.BoolAttribute{
+					Description: "Kubernetes",
+					Computed:    true,
+				},
+				"managed": dschema.BoolAttribute{
+					Description: "Managed",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this credential.",
+					Computed:    true,
+				},
+				"organization": dschema.Int64Attribute{
+					Description: "Inherit permissions from organization roles. If provided on creation, do not give either user or team.",
+					Computed:    true,
+				},
+				"team": dschema.Int64Attribute{
+					Description: "Write-only field used to add team to owner role. If provided, do not give either user or organization. Only valid for creation.",
+					Computed:    true,
+				},
+				"user": dschema.Int64Attribute{
+					Description: "Write-only field used to add user to owner role. If provided, do not give either team or organization. Only valid for creation.",
+					Computed:    true,
+				},
+			},
+			Hook:         hookCredential,
+			ApiVersion:   ApiVersion,
+			ResourceName: "Credential",
+		},
+	}
+}
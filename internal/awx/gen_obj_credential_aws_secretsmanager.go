@@ -0,0 +1,214 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
+	"github.com/ilijamt/terraform-provider-awx/internal/hooks"
+)
+
+// credentialAwsSecretsmanagerTerraformModel exposes the typed AWX AWS Secrets Manager lookup
+// credential (credential_aws_secretsmanager) inputs as first-class schema attributes rather
+// than an opaque JSON blob.
+type credentialAwsSecretsmanagerTerraformModel struct {
+	ID             types.Int64  `tfsdk:"id" json:"id"`
+	Name           types.String `tfsdk:"name" json:"name"`
+	Description    types.String `tfsdk:"description" json:"description"`
+	Organization   types.Int64  `tfsdk:"organization" json:"organization"`
+	Team           types.Int64  `tfsdk:"team" json:"team"`
+	User           types.Int64  `tfsdk:"user" json:"user"`
+	Kind           types.String `tfsdk:"kind" json:"kind"`
+	Managed        types.Bool   `tfsdk:"managed" json:"managed"`
+	CredentialType types.Int64  `tfsdk:"credential_type" json:"credential_type"`
+	AwsAccessKey   types.String `tfsdk:"aws_access_key" json:"-"`
+	AwsSecretKey   types.String `tfsdk:"aws_secret_key" json:"-"`
+}
+
+func (o *credentialAwsSecretsmanagerTerraformModel) Clone() credentialAwsSecretsmanagerTerraformModel {
+	return *o
+}
+
+type credentialAwsSecretsmanagerBodyRequestModel struct {
+	CredentialType int64           `json:"credential_type"`
+	Description    string          `json:"description,omitempty"`
+	Inputs         json.RawMessage `json:"inputs,omitempty"`
+	Name           string          `json:"name"`
+	Organization   int64           `json:"organization,omitempty"`
+	Team           int64           `json:"team,omitempty"`
+	User           int64           `json:"user,omitempty"`
+}
+
+// BodyRequest folds typed input fields back into a single `inputs` JSON object;
+// null/unknown values are dropped so the API doesn't receive empty strings for
+// unset optionals.
+func (o *credentialAwsSecretsmanagerTerraformModel) BodyRequest() *credentialAwsSecretsmanagerBodyRequestModel {
+	req := &credentialAwsSecretsmanagerBodyRequestModel{
+		CredentialType: o.CredentialType.ValueInt64(),
+		Description:    o.Description.ValueString(),
+		Name:           o.Name.ValueString(),
+		Organization:   o.Organization.ValueInt64(),
+	}
+
+	inputs := map[string]any{}
+	if !o.AwsAccessKey.IsNull() && !o.AwsAccessKey.IsUnknown() {
+		inputs["aws_access_key"] = o.AwsAccessKey.ValueString()
+	}
+	if !o.AwsSecretKey.IsNull() && !o.AwsSecretKey.IsUnknown() {
+		inputs["aws_secret_key"] = o.AwsSecretKey.ValueString()
+	}
+	if len(inputs) > 0 {
+		payload, _ := json.Marshal(inputs)
+		req.Inputs = payload
+	}
+	return req
+}
+
+// UpdateFromApiData unfolds the AWX response back into the typed model. Secret
+// fields come back as `$encrypted$` placeholders; the per-credential-type
+// pre-state-set hook reconciles them against prior plan state.
+func (o *credentialAwsSecretsmanagerTerraformModel) UpdateFromApiData(data map[string]any) (diag.Diagnostics, error) {
+	diags := diag.Diagnostics{}
+	if data == nil {
+		return diags, fmt.Errorf("no data passed")
+	}
+	collect := func(d diag.Diagnostics, _ error) { diags.Append(d...) }
+	collect(helpers.AttrValueSetInt64(&o.ID, data["id"]))
+	collect(helpers.AttrValueSetString(&o.Name, data["name"], false))
+	collect(helpers.AttrValueSetString(&o.Description, data["description"], false))
+	collect(helpers.AttrValueSetInt64(&o.Organization, data["organization"]))
+	collect(helpers.AttrValueSetString(&o.Kind, data["kind"], false))
+	collect(helpers.AttrValueSetBool(&o.Managed, data["managed"]))
+	collect(helpers.AttrValueSetInt64(&o.CredentialType, data["credential_type"]))
+
+	if inputs, ok := data["inputs"].(map[string]any); ok {
+		collect(helpers.AttrValueSetString(&o.AwsAccessKey, inputs["aws_access_key"], false))
+		collect(helpers.AttrValueSetString(&o.AwsSecretKey, inputs["aws_secret_key"], false))
+	}
+	return diags, nil
+}
+
+// hookCredentialAwsSecretsmanager reconciles `$encrypted$` placeholders that AWX returns for
+// secret fields against the prior plan state, so Terraform doesn't see drift
+// every plan. Data-source reads have orig==nil and skip reconciliation.
+func hookCredentialAwsSecretsmanager(_ context.Context, _ string, source hooks.Source, callee hooks.Callee, orig, state *credentialAwsSecretsmanagerTerraformModel) error {
+	if source != hooks.SourceResource {
+		return nil
+	}
+
+	if callee == hooks.CalleeCreate {
+		// Secrets aren't echoed by AWX in plain form. Carry the planned value
+		// forward; force a known null when the user didn't set the field.
+		if orig.AwsSecretKey.IsNull() || orig.AwsSecretKey.IsUnknown() {
+			state.AwsSecretKey = types.StringNull()
+		} else {
+			state.AwsSecretKey = orig.AwsSecretKey
+		}
+		return nil
+	}
+
+	if callee == hooks.CalleeRead || callee == hooks.CalleeUpdate {
+		if v, subbed := helpers.MergeEncryptedField(orig.AwsSecretKey, state.AwsSecretKey); subbed {
+			state.AwsSecretKey = v
+		}
+	}
+	return nil
+}
+
+// credentialAwsSecretsmanagerTypeLookup is shared between the resource and
+// data source so a single namespace lookup at Configure time covers both.
+var credentialAwsSecretsmanagerTypeLookup = framework.NewCredentialTypeLookup()
+
+type credentialAwsSecretsmanagerResource = framework.GenericResource[credentialAwsSecretsmanagerTerraformModel, credentialAwsSecretsmanagerBodyRequestModel, *credentialAwsSecretsmanagerTerraformModel]
+
+// NewCredentialAwsSecretsmanagerResource constructs the typed AWS Secrets Manager lookup credential resource.
+// The credential_type ID is resolved by namespace (aws_secretsmanager_credential) at Configure
+// time so the resource works against any AWX instance regardless of how the
+// managed credential type is numbered locally.
+func NewCredentialAwsSecretsmanagerResource() resource.Resource {
+	attrs := framework.CredentialBaseResourceAttrs()
+	attrs["aws_access_key"] = schema.StringAttribute{
+		Description: "AWS Access Key",
+		Required:    true,
+	}
+	attrs["aws_secret_key"] = schema.StringAttribute{
+		Description: "AWS Secret Key",
+		Required:    true,
+		Sensitive:   true,
+	}
+	return &credentialAwsSecretsmanagerResource{
+		ResourceBase: framework.ResourceBase{ProviderBase: framework.ProviderBase{TypeName: "credential_aws_secretsmanager", Endpoint: "/api/v2/credentials/"}},
+		Cfg: framework.ResourceCfg[credentialAwsSecretsmanagerTerraformModel, credentialAwsSecretsmanagerBodyRequestModel]{
+			Schema: schema.Schema{
+				MarkdownDescription: "Manages the AWX `AWS Secrets Manager lookup` (aws_secretsmanager_credential) credential type with first-class typed input attributes. Equivalent to `awx_credential` with `credential_type = data.awx_credential_type.aws_secretsmanager_credential.id`, but with per-field schema validation and sensitivity.",
+				Attributes:          attrs,
+			},
+			IDAccessor:  func(m *credentialAwsSecretsmanagerTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:       "id",
+			Hook:        hookCredentialAwsSecretsmanager,
+			OnConfigure: credentialAwsSecretsmanagerTypeLookup.OnConfigure("aws_secretsmanager_credential"),
+			MutateBody: func(plan *credentialAwsSecretsmanagerTerraformModel, body *credentialAwsSecretsmanagerBodyRequestModel) {
+				body.CredentialType = credentialAwsSecretsmanagerTypeLookup.Load()
+			},
+			WriteOnlyPlanToBody: func(plan *credentialAwsSecretsmanagerTerraformModel, body *credentialAwsSecretsmanagerBodyRequestModel) {
+				body.Team = plan.Team.ValueInt64()
+				body.User = plan.User.ValueInt64()
+			},
+			WriteOnlyPlanToState: func(plan, state *credentialAwsSecretsmanagerTerraformModel) {
+				state.Team = types.Int64Value(plan.Team.ValueInt64())
+				state.User = types.Int64Value(plan.User.ValueInt64())
+				if state.CredentialType.IsNull() || state.CredentialType.IsUnknown() {
+					state.CredentialType = types.Int64Value(credentialAwsSecretsmanagerTypeLookup.Load())
+				}
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "CredentialAwsSecretsmanager",
+		},
+	}
+}
+
+type credentialAwsSecretsmanagerDataSource = framework.GenericDataSource[credentialAwsSecretsmanagerTerraformModel, *credentialAwsSecretsmanagerTerraformModel]
+
+// NewCredentialAwsSecretsmanagerDataSource constructs the typed AWS Secrets Manager lookup credential data source.
+func NewCredentialAwsSecretsmanagerDataSource() datasource.DataSource {
+	attrs := framework.CredentialBaseDataSourceAttrs()
+	attrs["aws_access_key"] = dschema.StringAttribute{
+		Description: "AWS Access Key",
+		Computed:    true,
+	}
+	attrs["aws_secret_key"] = dschema.StringAttribute{
+		Description: "AWS Secret Key",
+		Computed:    true,
+		Sensitive:   true,
+	}
+	return &credentialAwsSecretsmanagerDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "credential_aws_secretsmanager", Endpoint: "/api/v2/credentials/"}},
+		Cfg: framework.DataSourceCfg[credentialAwsSecretsmanagerTerraformModel]{
+			Schema: dschema.Schema{
+				MarkdownDescription: "Reads an AWX `AWS Secrets Manager lookup` (aws_secretsmanager_credential) credential by ID or name.",
+				Attributes:          attrs,
+			},
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_name", URLSuffix: "/?name__exact=%s", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+				}},
+			},
+			OnConfigure:  credentialAwsSecretsmanagerTypeLookup.OnConfigure("aws_secretsmanager_credential"),
+			Hook:         hookCredentialAwsSecretsmanager,
+			ApiVersion:   ApiVersion,
+			ResourceName: "CredentialAwsSecretsmanager",
+		},
+	}
+}
@@ -1,7 +1,6 @@
 package awx
 
 import (
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -24,10 +23,12 @@ import (
 )
 
 type credentialInputSourceTerraformModel struct {
-	Description      types.String `tfsdk:"description" json:"description"`
-	ID               types.Int64  `tfsdk:"id" json:"id"`
-	InputFieldName   types.String `tfsdk:"input_field_name" json:"input_field_name"`
-	Metadata         types.String `tfsdk:"metadata" json:"metadata"`
+	Description    types.String `tfsdk:"description" json:"description"`
+	ID             types.Int64  `tfsdk:"id" json:"id"`
+	InputFieldName types.String `tfsdk:"input_field_name" json:"input_field_name"`
+	Metadata       types.Object `tfsdk:"metadata" json:"metadata"`
+	// MetadataJson holds metadata as raw JSON when no nested object of it describes the value.
+	MetadataJson     types.String `tfsdk:"metadata_json" json:"-"`
 	SourceCredential types.Int64  `tfsdk:"source_credential" json:"source_credential"`
 	TargetCredential types.Int64  `tfsdk:"target_credential" json:"target_credential"`
 }
@@ -40,7 +41,7 @@ func (o *credentialInputSourceTerraformModel) BodyRequest() *credentialInputSour
 	var req credentialInputSourceBodyRequestModel
 	req.Description = o.Description.ValueString()
 	req.InputFieldName = o.InputFieldName.ValueString()
-	req.Metadata = json.RawMessage(o.Metadata.ValueString())
+	req.Metadata = helpers.NestedObjectOrJsonAsMap(o.Metadata, o.MetadataJson)
 	req.SourceCredential = o.SourceCredential.ValueInt64()
 	req.TargetCredential = o.TargetCredential.ValueInt64()
 	return &req
@@ -55,18 +56,18 @@ func (o *credentialInputSourceTerraformModel) UpdateFromApiData(data map[string]
 	collect(helpers.AttrValueSetString(&o.Description, data["description"], false))
 	collect(helpers.AttrValueSetInt64(&o.ID, data["id"]))
 	collect(helpers.AttrValueSetString(&o.InputFieldName, data["input_field_name"], false))
-	collect(helpers.AttrValueSetJsonString(&o.Metadata, data["metadata"], false))
+	collect(helpers.AttrValueSetNestedObjectOrJson(&o.Metadata, &o.MetadataJson, data["metadata"], credentialInputMetadataAttrTypes))
 	collect(helpers.AttrValueSetInt64(&o.SourceCredential, data["source_credential"]))
 	collect(helpers.AttrValueSetInt64(&o.TargetCredential, data["target_credential"]))
 	return diags, nil
 }
 
 type credentialInputSourceBodyRequestModel struct {
-	Description      string          `json:"description,omitempty"`
-	InputFieldName   string          `json:"input_field_name"`
-	Metadata         json.RawMessage `json:"metadata,omitempty"`
-	SourceCredential int64           `json:"source_credential"`
-	TargetCredential int64           `json:"target_credential"`
+	Description      string         `json:"description,omitempty"`
+	InputFieldName   string         `json:"input_field_name"`
+	Metadata         map[string]any `json:"metadata"`
+	SourceCredential int64          `json:"source_credential"`
+	TargetCredential int64          `json:"target_credential"`
 }
 
 type credentialInputSourceResource = framework.GenericResource[credentialInputSourceTerraformModel, credentialInputSourceBodyRequestModel, *credentialInputSourceTerraformModel]
@@ -77,6 +78,7 @@ func NewCredentialInputSourceResource() resource.Resource {
 		ResourceBase: framework.ResourceBase{ProviderBase: framework.ProviderBase{TypeName: "credential_input_source", Endpoint: "/api/v2/credential_input_sources/"}},
 		Cfg: framework.ResourceCfg[credentialInputSourceTerraformModel, credentialInputSourceBodyRequestModel]{
 			Schema: schema.Schema{
+				Version: 2,
 				Attributes: map[string]schema.Attribute{
 					"description": schema.StringAttribute{
 						Description: "Optional description of this credential input source.",
@@ -94,13 +96,16 @@ func NewCredentialInputSourceResource() resource.Resource {
 							stringvalidator.LengthAtMost(1024),
 						},
 					},
-					"metadata": schema.StringAttribute{
-						Description: "Metadata",
+					"metadata": schema.SingleNestedAttribute{
+						Attributes:  credentialInputMetadataResourceAttributes(),
+						Description: "The metadata of the secret lookup, set the block of the external credential type of the source credential. It is checked against that credential type at plan time.",
 						Optional:    true,
-						Computed:    true,
-						Default:     stringdefault.StaticString(`{}`),
-						PlanModifiers: []planmodifier.String{
-							stringplanmodifier.UseStateForUnknown(),
+					},
+					"metadata_json": schema.StringAttribute{
+						Description: "The metadata of the secret lookup as a JSON object, for external credential types without a block in `metadata`, e.g. custom credential types. Conflicts with `metadata`. AWX reports the metadata here when it is not known which block it belongs to, e.g. after an import.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRoot("metadata")),
 						},
 					},
 					"source_credential": schema.Int64Attribute{
@@ -120,10 +125,18 @@ func NewCredentialInputSourceResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *credentialInputSourceTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
-			ApiVersion:   ApiVersion,
-			ResourceName: "CredentialInputSource",
+			IDAccessor: func(m *credentialInputSourceTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+			},
+			Hook:           hookCredentialInputSource,
+			ValidatePlan:   validateCredentialInputSourceMetadata,
+			StateUpgraders: framework.RawStateUpgraders[credentialInputSourceTerraformModel, credentialInputSourceBodyRequestModel, *credentialInputSourceTerraformModel](2, framework.DecodeJSONStrings("metadata")),
+			ApiVersion:     ApiVersion,
+			ResourceName:   "CredentialInputSource",
 		},
 	}
 }
@@ -155,8 +168,13 @@ func NewCredentialInputSourceDataSource() datasource.DataSource {
 						Description: "Input field name",
 						Computed:    true,
 					},
-					"metadata": dschema.StringAttribute{
-						Description: "Metadata",
+					"metadata": dschema.SingleNestedAttribute{
+						Attributes:  credentialInputMetadataDataSourceAttributes(),
+						Description: "The metadata of the secret lookup, set the block of the external credential type of the source credential. It is checked against that credential type at plan time.",
+						Computed:    true,
+					},
+					"metadata_json": dschema.StringAttribute{
+						Description: "The metadata of the secret lookup as a JSON object, for external credential types without a block in `metadata`, e.g. custom credential types. Conflicts with `metadata`. AWX reports the metadata here when it is not known which block it belongs to, e.g. after an import.",
 						Computed:    true,
 					},
 					"source_credential": dschema.Int64Attribute{
@@ -174,6 +192,53 @@ func NewCredentialInputSourceDataSource() datasource.DataSource {
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
 			},
+			Hook:         hookCredentialInputSource,
+			ApiVersion:   ApiVersion,
+			ResourceName: "CredentialInputSource",
+		},
+	}
+}
+
+type credentialInputSourceListDataSource = framework.GenericListDataSource[credentialInputSourceTerraformModel, *credentialInputSourceTerraformModel]
+
+// NewCredentialInputSourceListDataSource is a helper function to instantiate the CredentialInputSource list data source.
+func NewCredentialInputSourceListDataSource() datasource.DataSource {
+	return &credentialInputSourceListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "credential_input_sources", Endpoint: "/api/v2/credential_input_sources/"}},
+		Cfg: framework.ListDataSourceCfg[credentialInputSourceTerraformModel]{
+			Description: "Lists every CredentialInputSource matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this credential input source.",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this credential input source.",
+					Computed:    true,
+				},
+				"input_field_name": dschema.StringAttribute{
+					Description: "Input field name",
+					Computed:    true,
+				},
+				"metadata": dschema.SingleNestedAttribute{
+					Attributes:  credentialInputMetadataDataSourceAttributes(),
+					Description: "The metadata of the secret lookup, set the block of the external credential type of the source credential. It is checked against that credential type at plan time.",
+					Computed:    true,
+				},
+				"metadata_json": dschema.StringAttribute{
+					Description: "The metadata of the secret lookup as a JSON object, for external credential types without a block in `metadata`, e.g. custom credential types. Conflicts with `metadata`. AWX reports the metadata here when it is not known which block it belongs to, e.g. after an import.",
+					Computed:    true,
+				},
+				"source_credential": dschema.Int64Attribute{
+					Description: "Source credential",
+					Computed:    true,
+				},
+				"target_credential": dschema.Int64Attribute{
+					Description: "Target credential",
+					Computed:    true,
+				},
+			},
+			Hook:         hookCredentialInputSource,
 			ApiVersion:   ApiVersion,
 			ResourceName: "CredentialInputSource",
 		},
@@ -0,0 +1,214 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
+	"github.com/ilijamt/terraform-provider-awx/internal/hooks"
+)
+
+// credentialInsightsTerraformModel exposes the typed AWX Insights
+// credential (credential_insights) inputs as first-class schema attributes rather
+// than an opaque JSON blob.
+type credentialInsightsTerraformModel struct {
+	ID             types.Int64  `tfsdk:"id" json:"id"`
+	Name           types.String `tfsdk:"name" json:"name"`
+	Description    types.String `tfsdk:"description" json:"description"`
+	Organization   types.Int64  `tfsdk:"organization" json:"organization"`
+	Team           types.Int64  `tfsdk:"team" json:"team"`
+	User           types.Int64  `tfsdk:"user" json:"user"`
+	Kind           types.String `tfsdk:"kind" json:"kind"`
+	Managed        types.Bool   `tfsdk:"managed" json:"managed"`
+	CredentialType types.Int64  `tfsdk:"credential_type" json:"credential_type"`
+	Password       types.String `tfsdk:"password" json:"-"`
+	Username       types.String `tfsdk:"username" json:"-"`
+}
+
+func (o *credentialInsightsTerraformModel) Clone() credentialInsightsTerraformModel {
+	return *o
+}
+
+type credentialInsightsBodyRequestModel struct {
+	CredentialType int64           `json:"credential_type"`
+	Description    string          `json:"description,omitempty"`
+	Inputs         json.RawMessage `json:"inputs,omitempty"`
+	Name           string          `json:"name"`
+	Organization   int64           `json:"organization,omitempty"`
+	Team           int64           `json:"team,omitempty"`
+	User           int64           `json:"user,omitempty"`
+}
+
+// BodyRequest folds typed input fields back into a single `inputs` JSON object;
+// null/unknown values are dropped so the API doesn't receive empty strings for
+// unset optionals.
+func (o *credentialInsightsTerraformModel) BodyRequest() *credentialInsightsBodyRequestModel {
+	req := &credentialInsightsBodyRequestModel{
+		CredentialType: o.CredentialType.ValueInt64(),
+		Description:    o.Description.ValueString(),
+		Name:           o.Name.ValueString(),
+		Organization:   o.Organization.ValueInt64(),
+	}
+
+	inputs := map[string]any{}
+	if !o.Password.IsNull() && !o.Password.IsUnknown() {
+		inputs["password"] = o.Password.ValueString()
+	}
+	if !o.Username.IsNull() && !o.Username.IsUnknown() {
+		inputs["username"] = o.Username.ValueString()
+	}
+	if len(inputs) > 0 {
+		payload, _ := json.Marshal(inputs)
+		req.Inputs = payload
+	}
+	return req
+}
+
+// UpdateFromApiData unfolds the AWX response back into the typed model. Secret
+// fields come back as `$encrypted$` placeholders; the per-credential-type
+// pre-state-set hook reconciles them against prior plan state.
+func (o *credentialInsightsTerraformModel) UpdateFromApiData(data map[string]any) (diag.Diagnostics, error) {
+	diags := diag.Diagnostics{}
+	if data == nil {
+		return diags, fmt.Errorf("no data passed")
+	}
+	collect := func(d diag.Diagnostics, _ error) { diags.Append(d...) }
+	collect(helpers.AttrValueSetInt64(&o.ID, data["id"]))
+	collect(helpers.AttrValueSetString(&o.Name, data["name"], false))
+	collect(helpers.AttrValueSetString(&o.Description, data["description"], false))
+	collect(helpers.AttrValueSetInt64(&o.Organization, data["organization"]))
+	collect(helpers.AttrValueSetString(&o.Kind, data["kind"], false))
+	collect(helpers.AttrValueSetBool(&o.Managed, data["managed"]))
+	collect(helpers.AttrValueSetInt64(&o.CredentialType, data["credential_type"]))
+
+	if inputs, ok := data["inputs"].(map[string]any); ok {
+		collect(helpers.AttrValueSetString(&o.Password, inputs["password"], false))
+		collect(helpers.AttrValueSetString(&o.Username, inputs["username"], false))
+	}
+	return diags, nil
+}
+
+// hookCredentialInsights reconciles `$encrypted$` placeholders that AWX returns for
+// secret fields against the prior plan state, so Terraform doesn't see drift
+// every plan. Data-source reads have orig==nil and skip reconciliation.
+func hookCredentialInsights(_ context.Context, _ string, source hooks.Source, callee hooks.Callee, orig, state *credentialInsightsTerraformModel) error {
+	if source != hooks.SourceResource {
+		return nil
+	}
+
+	if callee == hooks.CalleeCreate {
+		// Secrets aren't echoed by AWX in plain form. Carry the planned value
+		// forward; force a known null when the user didn't set the field.
+		if orig.Password.IsNull() || orig.Password.IsUnknown() {
+			state.Password = types.StringNull()
+		} else {
+			state.Password = orig.Password
+		}
+		return nil
+	}
+
+	if callee == hooks.CalleeRead || callee == hooks.CalleeUpdate {
+		if v, subbed := helpers.MergeEncryptedField(orig.Password, state.Password); subbed {
+			state.Password = v
+		}
+	}
+	return nil
+}
+
+// credentialInsightsTypeLookup is shared between the resource and
+// data source so a single namespace lookup at Configure time covers both.
+var credentialInsightsTypeLookup = framework.NewCredentialTypeLookup()
+
+type credentialInsightsResource = framework.GenericResource[credentialInsightsTerraformModel, credentialInsightsBodyRequestModel, *credentialInsightsTerraformModel]
+
+// NewCredentialInsightsResource constructs the typed Insights credential resource.
+// The credential_type ID is resolved by namespace (insights) at Configure
+// time so the resource works against any AWX instance regardless of how the
+// managed credential type is numbered locally.
+func NewCredentialInsightsResource() resource.Resource {
+	attrs := framework.CredentialBaseResourceAttrs()
+	attrs["password"] = schema.StringAttribute{
+		Description: "Password",
+		Required:    true,
+		Sensitive:   true,
+	}
+	attrs["username"] = schema.StringAttribute{
+		Description: "Username",
+		Required:    true,
+	}
+	return &credentialInsightsResource{
+		ResourceBase: framework.ResourceBase{ProviderBase: framework.ProviderBase{TypeName: "credential_insights", Endpoint: "/api/v2/credentials/"}},
+		Cfg: framework.ResourceCfg[credentialInsightsTerraformModel, credentialInsightsBodyRequestModel]{
+			Schema: schema.Schema{
+				MarkdownDescription: "Manages the AWX `Insights` (insights) credential type with first-class typed input attributes. Equivalent to `awx_credential` with `credential_type = data.awx_credential_type.insights.id`, but with per-field schema validation and sensitivity.",
+				Attributes:          attrs,
+			},
+			IDAccessor:  func(m *credentialInsightsTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:       "id",
+			Hook:        hookCredentialInsights,
+			OnConfigure: credentialInsightsTypeLookup.OnConfigure("insights"),
+			MutateBody: func(plan *credentialInsightsTerraformModel, body *credentialInsightsBodyRequestModel) {
+				body.CredentialType = credentialInsightsTypeLookup.Load()
+			},
+			WriteOnlyPlanToBody: func(plan *credentialInsightsTerraformModel, body *credentialInsightsBodyRequestModel) {
+				body.Team = plan.Team.ValueInt64()
+				body.User = plan.User.ValueInt64()
+			},
+			WriteOnlyPlanToState: func(plan, state *credentialInsightsTerraformModel) {
+				state.Team = types.Int64Value(plan.Team.ValueInt64())
+				state.User = types.Int64Value(plan.User.ValueInt64())
+				if state.CredentialType.IsNull() || state.CredentialType.IsUnknown() {
+					state.CredentialType = types.Int64Value(credentialInsightsTypeLookup.Load())
+				}
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "CredentialInsights",
+		},
+	}
+}
+
+type credentialInsightsDataSource = framework.GenericDataSource[credentialInsightsTerraformModel, *credentialInsightsTerraformModel]
+
+// NewCredentialInsightsDataSource constructs the typed Insights credential data source.
+func NewCredentialInsightsDataSource() datasource.DataSource {
+	attrs := framework.CredentialBaseDataSourceAttrs()
+	attrs["password"] = dschema.StringAttribute{
+		Description: "Password",
+		Computed:    true,
+		Sensitive:   true,
+	}
+	attrs["username"] = dschema.StringAttribute{
+		Description: "Username",
+		Computed:    true,
+	}
+	return &credentialInsightsDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "credential_insights", Endpoint: "/api/v2/credentials/"}},
+		Cfg: framework.DataSourceCfg[credentialInsightsTerraformModel]{
+			Schema: dschema.Schema{
+				MarkdownDescription: "Reads an AWX `Insights` (insights) credential by ID or name.",
+				Attributes:          attrs,
+			},
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_name", URLSuffix: "/?name__exact=%s", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+				}},
+			},
+			OnConfigure:  credentialInsightsTypeLookup.OnConfigure("insights"),
+			Hook:         hookCredentialInsights,
+			ApiVersion:   ApiVersion,
+			ResourceName: "CredentialInsights",
+		},
+	}
+}
@@ -0,0 +1,325 @@
+package awx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
+	"github.com/ilijamt/terraform-provider-awx/internal/hooks"
+)
+
+// credentialNetTerraformModel exposes the typed AWX Network
+// credential (credential_net) inputs as first-class schema attributes rather
+// than an opaque JSON blob.
+type credentialNetTerraformModel struct {
+	ID                types.Int64  `tfsdk:"id" json:"id"`
+	Name              types.String `tfsdk:"name" json:"name"`
+	Description       types.String `tfsdk:"description" json:"description"`
+	Organization      types.Int64  `tfsdk:"organization" json:"organization"`
+	Team              types.Int64  `tfsdk:"team" json:"team"`
+	User              types.Int64  `tfsdk:"user" json:"user"`
+	Kind              types.String `tfsdk:"kind" json:"kind"`
+	Managed           types.Bool   `tfsdk:"managed" json:"managed"`
+	CredentialType    types.Int64  `tfsdk:"credential_type" json:"credential_type"`
+	Authorize         types.Bool   `tfsdk:"authorize" json:"-"`
+	AuthorizePassword types.String `tfsdk:"authorize_password" json:"-"`
+	Password          types.String `tfsdk:"password" json:"-"`
+	SshKeyData        types.String `tfsdk:"ssh_key_data" json:"-"`
+	SshKeyUnlock      types.String `tfsdk:"ssh_key_unlock" json:"-"`
+	Username          types.String `tfsdk:"username" json:"-"`
+}
+
+func (o *credentialNetTerraformModel) Clone() credentialNetTerraformModel {
+	return *o
+}
+
+type credentialNetBodyRequestModel struct {
+	CredentialType int64           `json:"credential_type"`
+	Description    string          `json:"description,omitempty"`
+	Inputs         json.RawMessage `json:"inputs,omitempty"`
+	Name           string          `json:"name"`
+	Organization   int64           `json:"organization,omitempty"`
+	Team           int64           `json:"team,omitempty"`
+	User           int64           `json:"user,omitempty"`
+}
+
+// BodyRequest folds typed input fields back into a single `inputs` JSON object;
+// null/unknown values are dropped so the API doesn't receive empty strings for
+// unset optionals.
+func (o *credentialNetTerraformModel) BodyRequest() *credentialNetBodyRequestModel {
+	req := &credentialNetBodyRequestModel{
+		CredentialType: o.CredentialType.ValueInt64(),
+		Description:    o.Description.ValueString(),
+		Name:           o.Name.ValueString(),
+		Organization:   o.Organization.ValueInt64(),
+	}
+
+	inputs := map[string]any{}
+	if !o.Authorize.IsNull() && !o.Authorize.IsUnknown() {
+		inputs["authorize"] = o.Authorize.ValueBool()
+	}
+	if !o.AuthorizePassword.IsNull() && !o.AuthorizePassword.IsUnknown() {
+		inputs["authorize_password"] = o.AuthorizePassword.ValueString()
+	}
+	if !o.Password.IsNull() && !o.Password.IsUnknown() {
+		inputs["password"] = o.Password.ValueString()
+	}
+	if !o.SshKeyData.IsNull() && !o.SshKeyData.IsUnknown() {
+		inputs["ssh_key_data"] = o.SshKeyData.ValueString()
+	}
+	if !o.SshKeyUnlock.IsNull() && !o.SshKeyUnlock.IsUnknown() {
+		inputs["ssh_key_unlock"] = o.SshKeyUnlock.ValueString()
+	}
+	if !o.Username.IsNull() && !o.Username.IsUnknown() {
+		inputs["username"] = o.Username.ValueString()
+	}
+	if len(inputs) > 0 {
+		payload, _ := json.Marshal(inputs)
+		req.Inputs = payload
+	}
+	return req
+}
+
+// UpdateFromApiData unfolds the AWX response back into the typed model. Secret
+// fields come back as `$encrypted$` placeholders; the per-credential-type
+// pre-state-set hook reconciles them against prior plan state.
+func (o *credentialNetTerraformModel) UpdateFromApiData(data map[string]any) (diag.Diagnostics, error) {
+	diags := diag.Diagnostics{}
+	if data == nil {
+		return diags, fmt.Errorf("no data passed")
+	}
+	collect := func(d diag.Diagnostics, _ error) { diags.Append(d...) }
+	collect(helpers.AttrValueSetInt64(&o.ID, data["id"]))
+	collect(helpers.AttrValueSetString(&o.Name, data["name"], false))
+	collect(helpers.AttrValueSetString(&o.Description, data["description"], false))
+	collect(helpers.AttrValueSetInt64(&o.Organization, data["organization"]))
+	collect(helpers.AttrValueSetString(&o.Kind, data["kind"], false))
+	collect(helpers.AttrValueSetBool(&o.Managed, data["managed"]))
+	collect(helpers.AttrValueSetInt64(&o.CredentialType, data["credential_type"]))
+
+	if inputs, ok := data["inputs"].(map[string]any); ok {
+		collect(helpers.AttrValueSetBool(&o.Authorize, inputs["authorize"]))
+		collect(helpers.AttrValueSetString(&o.AuthorizePassword, inputs["authorize_password"], false))
+		collect(helpers.AttrValueSetString(&o.Password, inputs["password"], false))
+		collect(helpers.AttrValueSetString(&o.SshKeyData, inputs["ssh_key_data"], false))
+		collect(helpers.AttrValueSetString(&o.SshKeyUnlock, inputs["ssh_key_unlock"], false))
+		collect(helpers.AttrValueSetString(&o.Username, inputs["username"], false))
+	}
+	return diags, nil
+}
+
+// hookCredentialNet reconciles `$encrypted$` placeholders that AWX returns for
+// secret fields against the prior plan state, so Terraform doesn't see drift
+// every plan. Data-source reads have orig==nil and skip reconciliation.
+func hookCredentialNet(_ context.Context, _ string, source hooks.Source, callee hooks.Callee, orig, state *credentialNetTerraformModel) error {
+	if source != hooks.SourceResource {
+		return nil
+	}
+
+	if callee == hooks.CalleeCreate {
+		// Secrets aren't echoed by AWX in plain form. Carry the planned value
+		// forward; force a known null when the user didn't set the field.
+		if orig.AuthorizePassword.IsNull() || orig.AuthorizePassword.IsUnknown() {
+			state.AuthorizePassword = types.StringNull()
+		} else {
+			state.AuthorizePassword = orig.AuthorizePassword
+		}
+		if orig.Password.IsNull() || orig.Password.IsUnknown() {
+			state.Password = types.StringNull()
+		} else {
+			state.Password = orig.Password
+		}
+		if orig.SshKeyData.IsNull() || orig.SshKeyData.IsUnknown() {
+			state.SshKeyData = types.StringNull()
+		} else {
+			state.SshKeyData = orig.SshKeyData
+		}
+		if orig.SshKeyUnlock.IsNull() || orig.SshKeyUnlock.IsUnknown() {
+			state.SshKeyUnlock = types.StringNull()
+		} else {
+			state.SshKeyUnlock = orig.SshKeyUnlock
+		}
+		return nil
+	}
+
+	if callee == hooks.CalleeRead || callee == hooks.CalleeUpdate {
+		if v, subbed := helpers.MergeEncryptedField(orig.AuthorizePassword, state.AuthorizePassword); subbed {
+			state.AuthorizePassword = v
+		}
+		if v, subbed := helpers.MergeEncryptedField(orig.Password, state.Password); subbed {
+			state.Password = v
+		}
+		if v, subbed := helpers.MergeEncryptedField(orig.SshKeyData, state.SshKeyData); subbed {
+			state.SshKeyData = v
+		}
+		if v, subbed := helpers.MergeEncryptedField(orig.SshKeyUnlock, state.SshKeyUnlock); subbed {
+			state.SshKeyUnlock = v
+		}
+	}
+	return nil
+}
+
+// credentialNetTypeLookup is shared between the resource and
+// data source so a single namespace lookup at Configure time covers both.
+var credentialNetTypeLookup = framework.NewCredentialTypeLookup()
+
+type credentialNetResource = framework.GenericResource[credentialNetTerraformModel, credentialNetBodyRequestModel, *credentialNetTerraformModel]
+
+// NewCredentialNetResource constructs the typed Network credential resource.
+// The credential_type ID is resolved by namespace (net) at Configure
+// time so the resource works against any AWX instance regardless of how the
+// managed credential type is numbered locally.
+func NewCredentialNetResource() resource.Resource {
+	attrs := framework.CredentialBaseResourceAttrs()
+	attrs["authorize"] = schema.BoolAttribute{
+		Description: "Authorize",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attrs["authorize_password"] = schema.StringAttribute{
+		Description: "Authorize Password",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Sensitive: true,
+		Validators: []validator.String{
+			stringvalidator.AlsoRequires(path.MatchRoot("authorize")),
+		},
+	}
+	attrs["password"] = schema.StringAttribute{
+		Description: "Password",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Sensitive: true,
+	}
+	attrs["ssh_key_data"] = schema.StringAttribute{
+		Description: "SSH Private Key",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Sensitive: true,
+	}
+	attrs["ssh_key_unlock"] = schema.StringAttribute{
+		Description: "Private Key Passphrase",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+		Sensitive: true,
+	}
+	attrs["username"] = schema.StringAttribute{
+		Description: "Username",
+		Required:    true,
+	}
+	return &credentialNetResource{
+		ResourceBase: framework.ResourceBase{ProviderBase: framework.ProviderBase{TypeName: "credential_net", Endpoint: "/api/v2/credentials/"}},
+		Cfg: framework.ResourceCfg[credentialNetTerraformModel, credentialNetBodyRequestModel]{
+			Schema: schema.Schema{
+				MarkdownDescription: "Manages the AWX `Network` (net) credential type with first-class typed input attributes. Equivalent to `awx_credential` with `credential_type = data.awx_credential_type.net.id`, but with per-field schema validation and sensitivity.",
+				Attributes:          attrs,
+			},
+			IDAccessor:  func(m *credentialNetTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:       "id",
+			Hook:        hookCredentialNet,
+			OnConfigure: credentialNetTypeLookup.OnConfigure("net"),
+			MutateBody: func(plan *credentialNetTerraformModel, body *credentialNetBodyRequestModel) {
+				body.CredentialType = credentialNetTypeLookup.Load()
+			},
+			WriteOnlyPlanToBody: func(plan *credentialNetTerraformModel, body *credentialNetBodyRequestModel) {
+				body.Team = plan.Team.ValueInt64()
+				body.User = plan.User.ValueInt64()
+			},
+			WriteOnlyPlanToState: func(plan, state *credentialNetTerraformModel) {
+				state.Team = types.Int64Value(plan.Team.ValueInt64())
+				state.User = types.Int64Value(plan.User.ValueInt64())
+				if state.CredentialType.IsNull() || state.CredentialType.IsUnknown() {
+					state.CredentialType = types.Int64Value(credentialNetTypeLookup.Load())
+				}
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "CredentialNet",
+		},
+	}
+}
+
+type credentialNetDataSource = framework.GenericDataSource[credentialNetTerraformModel, *credentialNetTerraformModel]
+
+// NewCredentialNetDataSource constructs the typed Network credential data source.
+func NewCredentialNetDataSource() datasource.DataSource {
+	attrs := framework.CredentialBaseDataSourceAttrs()
+	attrs["authorize"] = dschema.BoolAttribute{
+		Description: "Authorize",
+		Computed:    true,
+	}
+	attrs["authorize_password"] = dschema.StringAttribute{
+		Description: "Authorize Password",
+		Computed:    true,
+		Sensitive:   true,
+	}
+	attrs["password"] = dschema.StringAttribute{
+		Description: "Password",
+		Computed:    true,
+		Sensitive:   true,
+	}
+	attrs["ssh_key_data"] = dschema.StringAttribute{
+		Description: "SSH Private Key",
+		Computed:    true,
+		Sensitive:   true,
+	}
+	attrs["ssh_key_unlock"] = dschema.StringAttribute{
+		Description: "Private Key Passphrase",
+		Computed:    true,
+		Sensitive:   true,
+	}
+	attrs["username"] = dschema.StringAttribute{
+		Description: "Username",
+		Computed:    true,
+	}
+	return &credentialNetDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "credential_net", Endpoint: "/api/v2/credentials/"}},
+		Cfg: framework.DataSourceCfg[credentialNetTerraformModel]{
+			Schema: dschema.Schema{
+				MarkdownDescription: "Reads an AWX `Network` (net) credential by ID or name.",
+				Attributes:          attrs,
+			},
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_name", URLSuffix: "/?name__exact=%s", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+				}},
+			},
+			OnConfigure:  credentialNetTypeLookup.OnConfigure("net"),
+			Hook:         hookCredentialNet,
+			ApiVersion:   ApiVersion,
+			ResourceName: "CredentialNet",
+		},
+	}
+}
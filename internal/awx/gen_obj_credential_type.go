@@ -150,8 +150,20 @@ func NewCredentialTypeResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *credentialTypeTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *credentialTypeTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s+%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "kind", Type: "string", URLEscape: false},
+				}},
+				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+				}},
+			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "CredentialType",
 		},
@@ -176,9 +188,9 @@ func NewCredentialTypeDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.Int64{
-							int64validator.ExactlyOneOf(
-								path.MatchRoot("id"),
+							int64validator.ConflictsWith(
 								path.MatchRoot("name"),
+								path.MatchRoot("kind"),
 							),
 						},
 					},
@@ -192,7 +204,16 @@ func NewCredentialTypeDataSource() datasource.DataSource {
 					},
 					"kind": dschema.StringAttribute{
 						Description: "The credential type",
+						Optional:    true,
 						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
 					},
 					"managed": dschema.BoolAttribute{
 						Description: "Is the resource managed",
@@ -203,9 +224,8 @@ func NewCredentialTypeDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.String{
-							stringvalidator.ExactlyOneOf(
+							stringvalidator.ConflictsWith(
 								path.MatchRoot("id"),
-								path.MatchRoot("name"),
 							),
 						},
 					},
@@ -219,6 +239,10 @@ func NewCredentialTypeDataSource() datasource.DataSource {
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
+				{Name: "by_named_url", URLSuffix: "%s+%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "kind", Type: "string", URLEscape: false},
+				}},
 				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
 					{Name: "name", Type: "string", URLEscape: true},
 				}},
@@ -228,3 +252,51 @@ func NewCredentialTypeDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type credentialTypeListDataSource = framework.GenericListDataSource[credentialTypeTerraformModel, *credentialTypeTerraformModel]
+
+// NewCredentialTypeListDataSource is a helper function to instantiate the CredentialType list data source.
+func NewCredentialTypeListDataSource() datasource.DataSource {
+	return &credentialTypeListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "credential_types", Endpoint: "/api/v2/credential_types/"}},
+		Cfg: framework.ListDataSourceCfg[credentialTypeTerraformModel]{
+			Description: "Lists every CredentialType matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this credential type.",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this credential type.",
+					Computed:    true,
+				},
+				"injectors": dschema.StringAttribute{
+					Description: "Enter injectors using either JSON or YAML syntax. Refer to the documentation for example syntax.",
+					Computed:    true,
+				},
+				"inputs": dschema.StringAttribute{
+					Description: "Enter inputs using either JSON or YAML syntax. Refer to the documentation for example syntax.",
+					Computed:    true,
+				},
+				"kind": dschema.StringAttribute{
+					Description: "The credential type",
+					Computed:    true,
+				},
+				"managed": dschema.BoolAttribute{
+					Description: "Is the resource managed",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this credential type.",
+					Computed:    true,
+				},
+				"namespace": dschema.StringAttribute{
+					Description: "The namespace to which the resource belongs to",
+					Computed:    true,
+				},
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "CredentialType",
+		},
+	}
+}
@@ -156,8 +156,16 @@ func NewExecutionEnvironmentResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *executionEnvironmentTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *executionEnvironmentTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+				}},
+			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "ExecutionEnvironment",
 		},
@@ -225,8 +233,8 @@ func NewExecutionEnvironmentDataSource() datasource.DataSource {
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
-				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
-					{Name: "name", Type: "string", URLEscape: true},
+				{Name: "by_named_url", URLSuffix: "%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
 				}},
 			},
 			ApiVersion:   ApiVersion,
@@ -234,3 +242,51 @@ func NewExecutionEnvironmentDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type executionEnvironmentListDataSource = framework.GenericListDataSource[executionEnvironmentTerraformModel, *executionEnvironmentTerraformModel]
+
+// NewExecutionEnvironmentListDataSource is a helper function to instantiate the ExecutionEnvironment list data source.
+func NewExecutionEnvironmentListDataSource() datasource.DataSource {
+	return &executionEnvironmentListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "execution_environments", Endpoint: "/api/v2/execution_environments/"}},
+		Cfg: framework.ListDataSourceCfg[executionEnvironmentTerraformModel]{
+			Description: "Lists every ExecutionEnvironment matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"credential": dschema.Int64Attribute{
+					Description: "Credential",
+					Computed:    true,
+				},
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this execution environment.",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this execution environment.",
+					Computed:    true,
+				},
+				"image": dschema.StringAttribute{
+					Description: "The full image location, including the container registry, image name, and version tag.",
+					Computed:    true,
+				},
+				"managed": dschema.BoolAttribute{
+					Description: "Managed",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this execution environment.",
+					Computed:    true,
+				},
+				"organization": dschema.Int64Attribute{
+					Description: "The organization used to determine access to this execution environment.",
+					Computed:    true,
+				},
+				"pull": dschema.StringAttribute{
+					Description: "Pull image before running?",
+					Computed:    true,
+				},
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "ExecutionEnvironment",
+		},
+	}
+}
@@ -112,8 +112,18 @@ func NewGroupResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *groupTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *groupTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "inventory_name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
+			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "Group",
 		},
@@ -138,8 +148,10 @@ func NewGroupDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.Int64{
-							int64validator.ExactlyOneOf(
-								path.MatchRoot("id"),
+							int64validator.ConflictsWith(
+								path.MatchRoot("name"),
+								path.MatchRoot("inventory_name"),
+								path.MatchRoot("organization_name"),
 							),
 						},
 					},
@@ -149,18 +161,95 @@ func NewGroupDataSource() datasource.DataSource {
 					},
 					"name": dschema.StringAttribute{
 						Description: "Name of this group.",
+						Optional:    true,
 						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("inventory_name"),
+								path.MatchRoot("organization_name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
 					},
 					"variables": dschema.StringAttribute{
 						Description: "Group variables in JSON or YAML format.",
 						Computed:    true,
 					},
+					"inventory_name": dschema.StringAttribute{
+						Description: "Search only, looks the Group up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+								path.MatchRoot("organization_name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
+					"organization_name": dschema.StringAttribute{
+						Description: "Search only, looks the Group up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+								path.MatchRoot("inventory_name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
 				},
 			},
 			SearchGroups: []framework.SearchGroup{
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "inventory_name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "Group",
+		},
+	}
+}
+
+type groupListDataSource = framework.GenericListDataSource[groupTerraformModel, *groupTerraformModel]
+
+// NewGroupListDataSource is a helper function to instantiate the Group list data source.
+func NewGroupListDataSource() datasource.DataSource {
+	return &groupListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "groups", Endpoint: "/api/v2/groups/"}},
+		Cfg: framework.ListDataSourceCfg[groupTerraformModel]{
+			Description: "Lists every Group matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this group.",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this group.",
+					Computed:    true,
+				},
+				"inventory": dschema.Int64Attribute{
+					Description: "Inventory",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this group.",
+					Computed:    true,
+				},
+				"variables": dschema.StringAttribute{
+					Description: "Group variables in JSON or YAML format.",
+					Computed:    true,
+				},
 			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "Group",
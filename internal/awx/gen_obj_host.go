@@ -159,8 +159,21 @@ func NewHostResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *hostTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *hostTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "inventory_name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
+				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+				}},
+			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "Host",
 		},
@@ -189,9 +202,10 @@ func NewHostDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.Int64{
-							int64validator.ExactlyOneOf(
-								path.MatchRoot("id"),
+							int64validator.ConflictsWith(
 								path.MatchRoot("name"),
+								path.MatchRoot("inventory_name"),
+								path.MatchRoot("organization_name"),
 							),
 						},
 					},
@@ -216,9 +230,8 @@ func NewHostDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.String{
-							stringvalidator.ExactlyOneOf(
+							stringvalidator.ConflictsWith(
 								path.MatchRoot("id"),
-								path.MatchRoot("name"),
 							),
 						},
 					},
@@ -226,12 +239,43 @@ func NewHostDataSource() datasource.DataSource {
 						Description: "Host variables in JSON or YAML format.",
 						Computed:    true,
 					},
+					"inventory_name": dschema.StringAttribute{
+						Description: "Search only, looks the Host up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+								path.MatchRoot("organization_name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
+					"organization_name": dschema.StringAttribute{
+						Description: "Search only, looks the Host up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+								path.MatchRoot("inventory_name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
 				},
 			},
 			SearchGroups: []framework.SearchGroup{
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "inventory_name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
 				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
 					{Name: "name", Type: "string", URLEscape: true},
 				}},
@@ -241,3 +285,55 @@ func NewHostDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type hostListDataSource = framework.GenericListDataSource[hostTerraformModel, *hostTerraformModel]
+
+// NewHostListDataSource is a helper function to instantiate the Host list data source.
+func NewHostListDataSource() datasource.DataSource {
+	return &hostListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "hosts", Endpoint: "/api/v2/hosts/"}},
+		Cfg: framework.ListDataSourceCfg[hostTerraformModel]{
+			Description: "Lists every Host matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this host.",
+					Computed:    true,
+				},
+				"enabled": dschema.BoolAttribute{
+					Description: "Is this host online and available for running jobs?",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this host.",
+					Computed:    true,
+				},
+				"instance_id": dschema.StringAttribute{
+					Description: "The value used by the remote inventory source to uniquely identify the host",
+					Computed:    true,
+				},
+				"inventory": dschema.Int64Attribute{
+					Description: "Inventory",
+					Computed:    true,
+				},
+				"last_job": dschema.Int64Attribute{
+					Description: "Last job",
+					Computed:    true,
+				},
+				"last_job_host_summary": dschema.Int64Attribute{
+					Description: "Last job host summary",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this host.",
+					Computed:    true,
+				},
+				"variables": dschema.StringAttribute{
+					Description: "Host variables in JSON or YAML format.",
+					Computed:    true,
+				},
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "Host",
+		},
+	}
+}
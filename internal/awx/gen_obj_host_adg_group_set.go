@@ -0,0 +1,22 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewHostGroupsResource returns the authoritative Host ↔ Group association resource.
+func NewHostGroupsResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "host_groups",
+		Endpoint:      "/api/v2/hosts/%d/groups/",
+		ParentName:    "Host",
+		ParentIDAttr:  "host_id",
+		ChildName:     "Group",
+		ChildIDsAttr:  "group_ids",
+		AssociateType: "",
+		Ordered:       false,
+		Deprecated:    false,
+	})
+}
@@ -239,8 +239,16 @@ func NewInstanceGroupResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *instanceGroupTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *instanceGroupTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+				}},
+			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "InstanceGroup",
 		},
@@ -340,8 +348,8 @@ func NewInstanceGroupDataSource() datasource.DataSource {
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
-				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
-					{Name: "name", Type: "string", URLEscape: true},
+				{Name: "by_named_url", URLSuffix: "%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
 				}},
 			},
 			ApiVersion:   ApiVersion,
@@ -349,3 +357,83 @@ func NewInstanceGroupDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type instanceGroupListDataSource = framework.GenericListDataSource[instanceGroupTerraformModel, *instanceGroupTerraformModel]
+
+// NewInstanceGroupListDataSource is a helper function to instantiate the InstanceGroup list data source.
+func NewInstanceGroupListDataSource() datasource.DataSource {
+	return &instanceGroupListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "instance_groups", Endpoint: "/api/v2/instance_groups/"}},
+		Cfg: framework.ListDataSourceCfg[instanceGroupTerraformModel]{
+			Description: "Lists every InstanceGroup matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"capacity": dschema.Int64Attribute{
+					Description: "Capacity",
+					Computed:    true,
+				},
+				"consumed_capacity": dschema.Float64Attribute{
+					Description: "Consumed capacity",
+					Computed:    true,
+				},
+				"credential": dschema.Int64Attribute{
+					Description: "Credential",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this instance group.",
+					Computed:    true,
+				},
+				"instances": dschema.Int64Attribute{
+					Description: "Instances",
+					Computed:    true,
+				},
+				"is_container_group": dschema.BoolAttribute{
+					Description: "Indicates whether instances in this group are containerized.Containerized groups have a designated Openshift or Kubernetes cluster.",
+					Computed:    true,
+				},
+				"jobs_running": dschema.Int64Attribute{
+					Description: "Jobs running",
+					Computed:    true,
+				},
+				"jobs_total": dschema.Int64Attribute{
+					Description: "Count of all jobs that target this instance group",
+					Computed:    true,
+				},
+				"max_concurrent_jobs": dschema.Int64Attribute{
+					Description: "Maximum number of concurrent jobs to run on a group. When set to zero, no maximum is enforced.",
+					Computed:    true,
+				},
+				"max_forks": dschema.Int64Attribute{
+					Description: "Maximum number of forks to execute concurrently on a group. When set to zero, no maximum is enforced.",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this instance group.",
+					Computed:    true,
+				},
+				"percent_capacity_remaining": dschema.Float64Attribute{
+					Description: "Percent capacity remaining",
+					Computed:    true,
+				},
+				"pod_spec_override": dschema.StringAttribute{
+					Description: "Pod spec override",
+					Computed:    true,
+				},
+				"policy_instance_list": dschema.StringAttribute{
+					Description: "List of exact-match Instances that will be assigned to this group",
+					Computed:    true,
+				},
+				"policy_instance_minimum": dschema.Int64Attribute{
+					Description: "Static minimum number of Instances that will be automatically assign to this group when new instances come online.",
+					Computed:    true,
+				},
+				"policy_instance_percentage": dschema.Int64Attribute{
+					Description: "Minimum percentage of all instances that will be automatically assigned to this group when new instances come online.",
+					Computed:    true,
+				},
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "InstanceGroup",
+		},
+	}
+}
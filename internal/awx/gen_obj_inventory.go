@@ -234,8 +234,20 @@ func NewInventoryResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *inventoryTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *inventoryTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
+				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+				}},
+			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "Inventory",
 		},
@@ -279,9 +291,9 @@ func NewInventoryDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.Int64{
-							int64validator.ExactlyOneOf(
-								path.MatchRoot("id"),
+							int64validator.ConflictsWith(
 								path.MatchRoot("name"),
+								path.MatchRoot("organization_name"),
 							),
 						},
 					},
@@ -298,9 +310,8 @@ func NewInventoryDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.String{
-							stringvalidator.ExactlyOneOf(
+							stringvalidator.ConflictsWith(
 								path.MatchRoot("id"),
-								path.MatchRoot("name"),
 							),
 						},
 					},
@@ -334,12 +345,28 @@ func NewInventoryDataSource() datasource.DataSource {
 						Description: "Inventory variables in JSON format",
 						Computed:    true,
 					},
+					"organization_name": dschema.StringAttribute{
+						Description: "Search only, looks the Inventory up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
 				},
 			},
 			SearchGroups: []framework.SearchGroup{
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
 				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
 					{Name: "name", Type: "string", URLEscape: true},
 				}},
@@ -349,3 +376,88 @@ func NewInventoryDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type inventoryListDataSource = framework.GenericListDataSource[inventoryTerraformModel, *inventoryTerraformModel]
+
+// NewInventoryListDataSource is a helper function to instantiate the Inventory list data source.
+func NewInventoryListDataSource() datasource.DataSource {
+	return &inventoryListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "inventories", Endpoint: "/api/v2/inventories/"}},
+		Cfg: framework.ListDataSourceCfg[inventoryTerraformModel]{
+			Description: "Lists every Inventory matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this inventory.",
+					Computed:    true,
+				},
+				"has_active_failures": dschema.BoolAttribute{
+					DeprecationMessage: "This field is deprecated and will be removed in a future release.",
+					Description:        "Flag indicating whether any hosts in this inventory have failed.",
+					Computed:           true,
+				},
+				"has_inventory_sources": dschema.BoolAttribute{
+					DeprecationMessage: "This field is deprecated and will be removed in a future release.",
+					Description:        "Flag indicating whether this inventory has any external inventory sources.",
+					Computed:           true,
+				},
+				"host_filter": dschema.StringAttribute{
+					Description: "Filter that will be applied to the hosts of this inventory.",
+					Computed:    true,
+				},
+				"hosts_with_active_failures": dschema.Int64Attribute{
+					DeprecationMessage: "This field is deprecated and will be removed in a future release.",
+					Description:        "Number of hosts in this inventory with active failures.",
+					Computed:           true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this inventory.",
+					Computed:    true,
+				},
+				"inventory_sources_with_failures": dschema.Int64Attribute{
+					Description: "Number of external inventory sources in this inventory with failures.",
+					Computed:    true,
+				},
+				"kind": dschema.StringAttribute{
+					Description: "Kind of inventory being represented.",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this inventory.",
+					Computed:    true,
+				},
+				"organization": dschema.Int64Attribute{
+					Description: "Organization containing this inventory.",
+					Computed:    true,
+				},
+				"pending_deletion": dschema.BoolAttribute{
+					Description: "Flag indicating the inventory is being deleted.",
+					Computed:    true,
+				},
+				"prevent_instance_group_fallback": dschema.BoolAttribute{
+					Description: "If enabled, the inventory will prevent adding any organization instance groups to the list of preferred instances groups to run associated job templates on.If this setting is enabled and you provided an empty list, the global instance groups will be applied.",
+					Computed:    true,
+				},
+				"total_groups": dschema.Int64Attribute{
+					DeprecationMessage: "This field is deprecated and will be removed in a future release.",
+					Description:        "Total number of groups in this inventory.",
+					Computed:           true,
+				},
+				"total_hosts": dschema.Int64Attribute{
+					DeprecationMessage: "This field is deprecated and will be removed in a future release.",
+					Description:        "Total number of hosts in this inventory.",
+					Computed:           true,
+				},
+				"total_inventory_sources": dschema.Int64Attribute{
+					Description: "Total number of external inventory sources configured within this inventory.",
+					Computed:    true,
+				},
+				"variables": dschema.StringAttribute{
+					Description: "Inventory variables in JSON format",
+					Computed:    true,
+				},
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "Inventory",
+		},
+	}
+}
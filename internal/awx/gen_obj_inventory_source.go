@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -49,6 +52,13 @@ type inventorySourceTerraformModel struct {
 	UpdateCacheTimeout   types.Int64  `tfsdk:"update_cache_timeout" json:"update_cache_timeout"`
 	UpdateOnLaunch       types.Bool   `tfsdk:"update_on_launch" json:"update_on_launch"`
 	Verbosity            types.String `tfsdk:"verbosity" json:"verbosity"`
+	// UpdateOnApply is a Terraform-only toggle, not synced to the AWX API.
+	UpdateOnApply types.Bool     `tfsdk:"update_on_apply" json:"-"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts" json:"-"`
+	// LastUpdateId and LastUpdateStatus record the last launched job, not synced to the AWX API.
+	LastUpdateId        types.Int64  `tfsdk:"last_update_id" json:"-"`
+	LastUpdateStatus    types.String `tfsdk:"last_update_status" json:"-"`
+	LastUpdateHostCount types.Int64  `tfsdk:"last_update_host_count" json:"-"`
 }
 
 func (o *inventorySourceTerraformModel) Clone() inventorySourceTerraformModel {
@@ -353,13 +363,124 @@ func NewInventorySourceResource() resource.Resource {
 							int64planmodifier.UseStateForUnknown(),
 						},
 					},
+					"update_on_apply": schema.BoolAttribute{
+						Description: "If true, update the inventory source after it has been created or updated, wait for the update to finish, and fail when it does not succeed. Configure the maximum wait via the timeouts block.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"last_update_id": schema.Int64Attribute{
+						Description: "ID of the last inventory update launched by update_on_apply.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"last_update_status": schema.StringAttribute{
+						Description: "Status of the last inventory update launched by update_on_apply.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"last_update_host_count": schema.Int64Attribute{
+						Description: "Number of hosts of the inventory source after the last inventory update launched by update_on_apply.",
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
 				},
 			},
 			IDAccessor: func(m *inventorySourceTerraformModel) any { return m.ID.ValueInt64() },
 			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "inventory_name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
+				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+				}},
+			},
 			Hook: func(ctx context.Context, apiVersion string, source hooks.Source, callee hooks.Callee, orig, state *inventorySourceTerraformModel) error {
 				return hooks.RequireResourceStateOrOrig(ctx, apiVersion, source, callee, orig, state)
 			},
+			EmitTimeouts: true,
+			CopyExtraAttributes: func(plan, state *inventorySourceTerraformModel) {
+				state.UpdateOnApply = plan.UpdateOnApply
+				state.Timeouts = plan.Timeouts
+				if !plan.LastUpdateId.IsUnknown() {
+					state.LastUpdateId = plan.LastUpdateId
+				}
+				if !plan.LastUpdateStatus.IsUnknown() {
+					state.LastUpdateStatus = plan.LastUpdateStatus
+				}
+				if !plan.LastUpdateHostCount.IsUnknown() {
+					state.LastUpdateHostCount = plan.LastUpdateHostCount
+				}
+			},
+			WaitLifecycle: &framework.WaitLifecycleCfg[inventorySourceTerraformModel]{
+				ShouldWait: func(plan *inventorySourceTerraformModel) bool {
+					return !plan.UpdateOnApply.IsNull() && plan.UpdateOnApply.ValueBool()
+				},
+				EndpointForModel: func(m *inventorySourceTerraformModel) string {
+					if m.ID.IsNull() || m.ID.IsUnknown() {
+						return ""
+					}
+					if m.ID.ValueInt64() == 0 {
+						return ""
+					}
+					return framework.EndpointWithID("/api/v2/inventory_sources/", m.ID.ValueInt64())
+				},
+				Field:          "status",
+				SuccessValues:  []string{"successful"},
+				FailureValues:  []string{"failed", "error", "canceled"},
+				PollInterval:   5 * time.Second,
+				DefaultTimeout: 30 * time.Minute,
+				ResolveTimeout: func(ctx context.Context, plan *inventorySourceTerraformModel, callee hooks.Callee) (time.Duration, diag.Diagnostics) {
+					if callee == hooks.CalleeUpdate {
+						return plan.Timeouts.Update(ctx, 30*time.Minute)
+					}
+					return plan.Timeouts.Create(ctx, 30*time.Minute)
+				},
+				Launch: &framework.WaitLaunchCfg[inventorySourceTerraformModel]{
+					EndpointForModel: func(m *inventorySourceTerraformModel) string {
+						return fmt.Sprintf("/api/v2/inventory_sources/%d/update/", m.ID.ValueInt64())
+					},
+					IDField:     "inventory_update",
+					JobEndpoint: "/api/v2/inventory_updates/",
+					SetJob: func(state *inventorySourceTerraformModel, id int64, status string) {
+						state.LastUpdateId = types.Int64Value(id)
+						state.LastUpdateStatus = types.StringValue(status)
+					},
+					Counts: []framework.WaitCountCfg[inventorySourceTerraformModel]{
+						{
+							EndpointForModel: func(m *inventorySourceTerraformModel) string {
+								return fmt.Sprintf("/api/v2/inventory_sources/%d/hosts/", m.ID.ValueInt64())
+							},
+							SetCount: func(state *inventorySourceTerraformModel, count int64) {
+								state.LastUpdateHostCount = types.Int64Value(count)
+							},
+						},
+					},
+				},
+				MarkUnknown: func(plan *inventorySourceTerraformModel, waiting bool) {
+					plan.LastUpdateId = types.Int64Unknown()
+					plan.LastUpdateStatus = types.StringUnknown()
+					if !waiting {
+						return
+					}
+					plan.LastUpdateHostCount = types.Int64Unknown()
+				},
+			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "InventorySource",
 		},
@@ -405,9 +526,10 @@ func NewInventorySourceDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.Int64{
-							int64validator.ExactlyOneOf(
-								path.MatchRoot("id"),
+							int64validator.ConflictsWith(
 								path.MatchRoot("name"),
+								path.MatchRoot("inventory_name"),
+								path.MatchRoot("organization_name"),
 							),
 						},
 					},
@@ -424,9 +546,8 @@ func NewInventorySourceDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.String{
-							stringvalidator.ExactlyOneOf(
+							stringvalidator.ConflictsWith(
 								path.MatchRoot("id"),
-								path.MatchRoot("name"),
 							),
 						},
 					},
@@ -474,12 +595,43 @@ func NewInventorySourceDataSource() datasource.DataSource {
 						Description: "Verbosity",
 						Computed:    true,
 					},
+					"inventory_name": dschema.StringAttribute{
+						Description: "Search only, looks the InventorySource up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+								path.MatchRoot("organization_name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
+					"organization_name": dschema.StringAttribute{
+						Description: "Search only, looks the InventorySource up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+								path.MatchRoot("inventory_name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
 				},
 			},
 			SearchGroups: []framework.SearchGroup{
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "inventory_name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
 				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
 					{Name: "name", Type: "string", URLEscape: true},
 				}},
@@ -492,3 +644,107 @@ func NewInventorySourceDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type inventorySourceListDataSource = framework.GenericListDataSource[inventorySourceTerraformModel, *inventorySourceTerraformModel]
+
+// NewInventorySourceListDataSource is a helper function to instantiate the InventorySource list data source.
+func NewInventorySourceListDataSource() datasource.DataSource {
+	return &inventorySourceListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "inventory_sources", Endpoint: "/api/v2/inventory_sources/"}},
+		Cfg: framework.ListDataSourceCfg[inventorySourceTerraformModel]{
+			Description: "Lists every InventorySource matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"credential": dschema.Int64Attribute{
+					Description: "Cloud credential to use for inventory updates.",
+					Computed:    true,
+				},
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this inventory source.",
+					Computed:    true,
+				},
+				"enabled_value": dschema.StringAttribute{
+					Description: "Only used when enabled_var is set. Value when the host is considered enabled. For example if enabled_var=\"status.power_state\"and enabled_value=\"powered_on\" with host variables:{   \"status\": {     \"power_state\": \"powered_on\",     \"created\": \"2020-08-04T18:13:04+00:00\",     \"healthy\": true    },    \"name\": \"foobar\",    \"ip_address\": \"192.168.2.1\"}The host would be marked enabled. If power_state where any value other than powered_on then the host would be disabled when imported. If the key is not found then the host will be enabled",
+					Computed:    true,
+				},
+				"enabled_var": dschema.StringAttribute{
+					Description: "Retrieve the enabled state from the given dict of host variables. The enabled variable may be specified as \"foo.bar\", in which case the lookup will traverse into nested dicts, equivalent to: from_dict.get(\"foo\", {}).get(\"bar\", default)",
+					Computed:    true,
+				},
+				"execution_environment": dschema.Int64Attribute{
+					Description: "The container image to be used for execution.",
+					Computed:    true,
+				},
+				"host_filter": dschema.StringAttribute{
+					DeprecationMessage: "This field is deprecated and will be removed in a future release.",
+					Description:        "Regex where only matching hosts will be imported.",
+					Computed:           true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this inventory source.",
+					Computed:    true,
+				},
+				"inventory": dschema.Int64Attribute{
+					Description: "Inventory",
+					Computed:    true,
+				},
+				"limit": dschema.StringAttribute{
+					Description: "Enter host, group or pattern match",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this inventory source.",
+					Computed:    true,
+				},
+				"overwrite": dschema.BoolAttribute{
+					Description: "Overwrite local groups and hosts from remote inventory source.",
+					Computed:    true,
+				},
+				"overwrite_vars": dschema.BoolAttribute{
+					Description: "Overwrite local variables from remote inventory source.",
+					Computed:    true,
+				},
+				"scm_branch": dschema.StringAttribute{
+					Description: "Inventory source SCM branch. Project default used if blank. Only allowed if project allow_override field is set to true.",
+					Computed:    true,
+				},
+				"source": dschema.StringAttribute{
+					Description: "Source",
+					Computed:    true,
+				},
+				"source_path": dschema.StringAttribute{
+					Description: "Source path",
+					Computed:    true,
+				},
+				"source_project": dschema.Int64Attribute{
+					Description: "Project containing inventory file used as source.",
+					Computed:    true,
+				},
+				"source_vars": dschema.StringAttribute{
+					Description: "Inventory source variables in YAML or JSON format.",
+					Computed:    true,
+				},
+				"timeout": dschema.Int64Attribute{
+					Description: "The amount of time (in seconds) to run before the task is canceled.",
+					Computed:    true,
+				},
+				"update_cache_timeout": dschema.Int64Attribute{
+					Description: "Update cache timeout",
+					Computed:    true,
+				},
+				"update_on_launch": dschema.BoolAttribute{
+					Description: "Update on launch",
+					Computed:    true,
+				},
+				"verbosity": dschema.StringAttribute{
+					Description: "Verbosity",
+					Computed:    true,
+				},
+			},
+			Hook: func(ctx context.Context, apiVersion string, source hooks.Source, callee hooks.Callee, orig, state *inventorySourceTerraformModel) error {
+				return hooks.RequireResourceStateOrOrig(ctx, apiVersion, source, callee, orig, state)
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "InventorySource",
+		},
+	}
+}
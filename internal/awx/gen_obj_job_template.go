@@ -659,6 +659,18 @@ func NewJobTemplateResource() resource.Resource {
 			},
 			IDAccessor: func(m *jobTemplateTerraformModel) any { return m.ID.ValueInt64() },
 			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
+				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+				}},
+			},
 			Hook: func(ctx context.Context, apiVersion string, source hooks.Source, callee hooks.Callee, orig, state *jobTemplateTerraformModel) error {
 				return hooks.RequireResourceStateOrOrig(ctx, apiVersion, source, callee, orig, state)
 			},
@@ -782,9 +794,9 @@ func NewJobTemplateDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.Int64{
-							int64validator.ExactlyOneOf(
-								path.MatchRoot("id"),
+							int64validator.ConflictsWith(
 								path.MatchRoot("name"),
+								path.MatchRoot("organization_name"),
 							),
 						},
 					},
@@ -813,9 +825,8 @@ func NewJobTemplateDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.String{
-							stringvalidator.ExactlyOneOf(
+							stringvalidator.ConflictsWith(
 								path.MatchRoot("id"),
-								path.MatchRoot("name"),
 							),
 						},
 					},
@@ -871,12 +882,28 @@ func NewJobTemplateDataSource() datasource.DataSource {
 						Description: "Service that webhook requests will be accepted from",
 						Computed:    true,
 					},
+					"organization_name": dschema.StringAttribute{
+						Description: "Search only, looks the JobTemplate up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
 				},
 			},
 			SearchGroups: []framework.SearchGroup{
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
 				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
 					{Name: "name", Type: "string", URLEscape: true},
 				}},
@@ -889,3 +916,202 @@ func NewJobTemplateDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type jobTemplateListDataSource = framework.GenericListDataSource[jobTemplateTerraformModel, *jobTemplateTerraformModel]
+
+// NewJobTemplateListDataSource is a helper function to instantiate the JobTemplate list data source.
+func NewJobTemplateListDataSource() datasource.DataSource {
+	return &jobTemplateListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "job_templates", Endpoint: "/api/v2/job_templates/"}},
+		Cfg: framework.ListDataSourceCfg[jobTemplateTerraformModel]{
+			Description: "Lists every JobTemplate matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"allow_simultaneous": dschema.BoolAttribute{
+					Description: "Allow simultaneous",
+					Computed:    true,
+				},
+				"ask_credential_on_launch": dschema.BoolAttribute{
+					Description: "Ask credential on launch",
+					Computed:    true,
+				},
+				"ask_diff_mode_on_launch": dschema.BoolAttribute{
+					Description: "Ask diff mode on launch",
+					Computed:    true,
+				},
+				"ask_execution_environment_on_launch": dschema.BoolAttribute{
+					Description: "Ask execution environment on launch",
+					Computed:    true,
+				},
+				"ask_forks_on_launch": dschema.BoolAttribute{
+					Description: "Ask forks on launch",
+					Computed:    true,
+				},
+				"ask_instance_groups_on_launch": dschema.BoolAttribute{
+					Description: "Ask instance groups on launch",
+					Computed:    true,
+				},
+				"ask_inventory_on_launch": dschema.BoolAttribute{
+					Description: "Ask inventory on launch",
+					Computed:    true,
+				},
+				"ask_job_slice_count_on_launch": dschema.BoolAttribute{
+					Description: "Ask job slice count on launch",
+					Computed:    true,
+				},
+				"ask_job_type_on_launch": dschema.BoolAttribute{
+					Description: "Ask job type on launch",
+					Computed:    true,
+				},
+				"ask_labels_on_launch": dschema.BoolAttribute{
+					Description: "Ask labels on launch",
+					Computed:    true,
+				},
+				"ask_limit_on_launch": dschema.BoolAttribute{
+					Description: "Ask limit on launch",
+					Computed:    true,
+				},
+				"ask_scm_branch_on_launch": dschema.BoolAttribute{
+					Description: "Ask scm branch on launch",
+					Computed:    true,
+				},
+				"ask_skip_tags_on_launch": dschema.BoolAttribute{
+					Description: "Ask skip tags on launch",
+					Computed:    true,
+				},
+				"ask_tags_on_launch": dschema.BoolAttribute{
+					Description: "Ask tags on launch",
+					Computed:    true,
+				},
+				"ask_timeout_on_launch": dschema.BoolAttribute{
+					Description: "Ask timeout on launch",
+					Computed:    true,
+				},
+				"ask_variables_on_launch": dschema.BoolAttribute{
+					Description: "Ask variables on launch",
+					Computed:    true,
+				},
+				"ask_verbosity_on_launch": dschema.BoolAttribute{
+					Description: "Ask verbosity on launch",
+					Computed:    true,
+				},
+				"become_enabled": dschema.BoolAttribute{
+					Description: "Become enabled",
+					Computed:    true,
+				},
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this job template.",
+					Computed:    true,
+				},
+				"diff_mode": dschema.BoolAttribute{
+					Description: "If enabled, textual changes made to any templated files on the host are shown in the standard output",
+					Computed:    true,
+				},
+				"execution_environment": dschema.Int64Attribute{
+					Description: "The container image to be used for execution.",
+					Computed:    true,
+				},
+				"extra_vars": dschema.StringAttribute{
+					Description: "Extra vars",
+					Computed:    true,
+				},
+				"force_handlers": dschema.BoolAttribute{
+					Description: "Force handlers",
+					Computed:    true,
+				},
+				"forks": dschema.Int64Attribute{
+					Description: "Forks",
+					Computed:    true,
+				},
+				"host_config_key": dschema.StringAttribute{
+					Description: "Host config key",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this job template.",
+					Computed:    true,
+				},
+				"inventory": dschema.Int64Attribute{
+					Description: "Inventory",
+					Computed:    true,
+				},
+				"job_slice_count": dschema.Int64Attribute{
+					Description: "The number of jobs to slice into at runtime. Will cause the Job Template to launch a workflow if value is greater than 1.",
+					Computed:    true,
+				},
+				"job_tags": dschema.StringAttribute{
+					Description: "Job tags",
+					Computed:    true,
+				},
+				"job_type": dschema.StringAttribute{
+					Description: "Job type",
+					Computed:    true,
+				},
+				"limit": dschema.StringAttribute{
+					Description: "Limit",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this job template.",
+					Computed:    true,
+				},
+				"organization": dschema.Int64Attribute{
+					Description: "The organization used to determine access to this template.",
+					Computed:    true,
+				},
+				"playbook": dschema.StringAttribute{
+					Description: "Playbook",
+					Computed:    true,
+				},
+				"prevent_instance_group_fallback": dschema.BoolAttribute{
+					Description: "If enabled, the job template will prevent adding any inventory or organization instance groups to the list of preferred instances groups to run on.If this setting is enabled and you provided an empty list, the global instance groups will be applied.",
+					Computed:    true,
+				},
+				"project": dschema.Int64Attribute{
+					Description: "Project",
+					Computed:    true,
+				},
+				"scm_branch": dschema.StringAttribute{
+					Description: "Branch to use in job run. Project default used if blank. Only allowed if project allow_override field is set to true.",
+					Computed:    true,
+				},
+				"skip_tags": dschema.StringAttribute{
+					Description: "Skip tags",
+					Computed:    true,
+				},
+				"start_at_task": dschema.StringAttribute{
+					Description: "Start at task",
+					Computed:    true,
+				},
+				"survey_enabled": dschema.BoolAttribute{
+					Description: "Survey enabled",
+					Computed:    true,
+				},
+				"timeout": dschema.Int64Attribute{
+					Description: "The amount of time (in seconds) to run before the task is canceled.",
+					Computed:    true,
+				},
+				"use_fact_cache": dschema.BoolAttribute{
+					Description: "If enabled, the service will act as an Ansible Fact Cache Plugin; persisting facts at the end of a playbook run to the database and caching facts for use by Ansible.",
+					Computed:    true,
+				},
+				"verbosity": dschema.StringAttribute{
+					Description: "Verbosity",
+					Computed:    true,
+				},
+				"webhook_credential": dschema.Int64Attribute{
+					Description: "Personal Access Token for posting back the status to the service API",
+					Computed:    true,
+				},
+				"webhook_service": dschema.StringAttribute{
+					Description: "Service that webhook requests will be accepted from",
+					Computed:    true,
+				},
+			},
+			Hook: func(ctx context.Context, apiVersion string, source hooks.Source, callee hooks.Callee, orig, state *jobTemplateTerraformModel) error {
+				return hooks.RequireResourceStateOrOrig(ctx, apiVersion, source, callee, orig, state)
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "JobTemplate",
+		},
+	}
+}
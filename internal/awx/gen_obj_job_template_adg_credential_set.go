@@ -0,0 +1,22 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewJobTemplateCredentialsResource returns the authoritative JobTemplate ↔ Credential association resource.
+func NewJobTemplateCredentialsResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "job_template_credentials",
+		Endpoint:      "/api/v2/job_templates/%d/credentials/",
+		ParentName:    "JobTemplate",
+		ParentIDAttr:  "job_template_id",
+		ChildName:     "Credential",
+		ChildIDsAttr:  "credential_ids",
+		AssociateType: "",
+		Ordered:       false,
+		Deprecated:    false,
+	})
+}
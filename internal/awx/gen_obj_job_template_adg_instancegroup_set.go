@@ -0,0 +1,22 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewJobTemplateInstanceGroupsResource returns the authoritative JobTemplate ↔ InstanceGroup association resource.
+func NewJobTemplateInstanceGroupsResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "job_template_instance_groups",
+		Endpoint:      "/api/v2/job_templates/%d/instance_groups/",
+		ParentName:    "JobTemplate",
+		ParentIDAttr:  "job_template_id",
+		ChildName:     "InstanceGroup",
+		ChildIDsAttr:  "instance_group_ids",
+		AssociateType: "",
+		Ordered:       false,
+		Deprecated:    false,
+	})
+}
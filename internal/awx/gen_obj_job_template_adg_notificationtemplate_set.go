@@ -0,0 +1,22 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewJobTemplateNotificationTemplatesResource returns the authoritative JobTemplate ↔ NotificationTemplate association resource.
+func NewJobTemplateNotificationTemplatesResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "job_template_notification_templates",
+		Endpoint:      "/api/v2/job_templates/%d/notification_templates_%s/",
+		ParentName:    "JobTemplate",
+		ParentIDAttr:  "job_template_id",
+		ChildName:     "NotificationTemplate",
+		ChildIDsAttr:  "notification_template_ids",
+		AssociateType: "notification_job_template",
+		Ordered:       false,
+		Deprecated:    false,
+	})
+}
@@ -0,0 +1,16 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewJobTemplateLaunchResource returns the resource launching a JobTemplate.
+func NewJobTemplateLaunchResource() resource.Resource {
+	return framework.NewJobLaunchResource(
+		"job_launch",
+		"/api/v2/job_templates/",
+		"/api/v2/jobs/",
+	)
+}
@@ -113,10 +113,14 @@ func (o *jobTemplateSurvey) Read(ctx context.Context, request resource.ReadReque
 	}
 
 	endpoint := o.endpointFor(state.JobTemplateID.ValueInt64())
-	data, d := framework.ReadRequest(ctx, o.Client, endpoint, "JobTemplate/Survey")
+	data, found, d := framework.ReadRequestAllowNotFound(ctx, o.Client, endpoint, "JobTemplate/Survey")
 	if framework.DiagnosticsHasError(&response.Diagnostics, d...) {
 		return
 	}
+	if !found {
+		response.State.RemoveResource(ctx)
+		return
+	}
 
 	if val, ok := data["spec"]; ok {
 		dg, _ := helpers.AttrValueSetJsonString(&state.Spec, val, false)
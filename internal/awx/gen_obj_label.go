@@ -83,8 +83,21 @@ func NewLabelResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *labelTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *labelTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
+				{Name: "by_name_organization", URLSuffix: "?name__exact=%s&organization=%d", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+					{Name: "organization", Type: "int64", URLEscape: false},
+				}},
+			},
 			UnDeletable:  true,
 			ApiVersion:   ApiVersion,
 			ResourceName: "Label",
@@ -108,6 +121,7 @@ func NewLabelDataSource() datasource.DataSource {
 						Validators: []validator.Int64{
 							int64validator.ConflictsWith(
 								path.MatchRoot("name"),
+								path.MatchRoot("organization_name"),
 								path.MatchRoot("organization"),
 							),
 						},
@@ -117,9 +131,6 @@ func NewLabelDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.String{
-							stringvalidator.AlsoRequires(
-								path.MatchRoot("organization"),
-							),
 							stringvalidator.ConflictsWith(
 								path.MatchRoot("id"),
 							),
@@ -135,6 +146,20 @@ func NewLabelDataSource() datasource.DataSource {
 							),
 							int64validator.ConflictsWith(
 								path.MatchRoot("id"),
+								path.MatchRoot("organization_name"),
+							),
+						},
+					},
+					"organization_name": dschema.StringAttribute{
+						Description: "Search only, looks the Label up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+								path.MatchRoot("organization"),
 							),
 						},
 					},
@@ -144,6 +169,10 @@ func NewLabelDataSource() datasource.DataSource {
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
 				{Name: "by_name_organization", URLSuffix: "?name__exact=%s&organization=%d", Fields: []framework.SearchField{
 					{Name: "name", Type: "string", URLEscape: true},
 					{Name: "organization", Type: "int64", URLEscape: false},
@@ -154,3 +183,31 @@ func NewLabelDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type labelListDataSource = framework.GenericListDataSource[labelTerraformModel, *labelTerraformModel]
+
+// NewLabelListDataSource is a helper function to instantiate the Label list data source.
+func NewLabelListDataSource() datasource.DataSource {
+	return &labelListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "labels", Endpoint: "/api/v2/labels/"}},
+		Cfg: framework.ListDataSourceCfg[labelTerraformModel]{
+			Description: "Lists every Label matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this label.",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this label.",
+					Computed:    true,
+				},
+				"organization": dschema.Int64Attribute{
+					Description: "Organization this label belongs to.",
+					Computed:    true,
+				},
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "Label",
+		},
+	}
+}
@@ -147,8 +147,20 @@ func NewNotificationTemplateResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *notificationTemplateTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *notificationTemplateTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
+				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+				}},
+			},
 			Hook:         hookNotificationTemplate,
 			ApiVersion:   ApiVersion,
 			ResourceName: "NotificationTemplate",
@@ -174,9 +186,9 @@ func NewNotificationTemplateDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.Int64{
-							int64validator.ExactlyOneOf(
-								path.MatchRoot("id"),
+							int64validator.ConflictsWith(
 								path.MatchRoot("name"),
+								path.MatchRoot("organization_name"),
 							),
 						},
 					},
@@ -189,9 +201,8 @@ func NewNotificationTemplateDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.String{
-							stringvalidator.ExactlyOneOf(
+							stringvalidator.ConflictsWith(
 								path.MatchRoot("id"),
-								path.MatchRoot("name"),
 							),
 						},
 					},
@@ -207,12 +218,28 @@ func NewNotificationTemplateDataSource() datasource.DataSource {
 						Description: "Organization",
 						Computed:    true,
 					},
+					"organization_name": dschema.StringAttribute{
+						Description: "Search only, looks the NotificationTemplate up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
 				},
 			},
 			SearchGroups: []framework.SearchGroup{
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
 				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
 					{Name: "name", Type: "string", URLEscape: true},
 				}},
@@ -223,3 +250,48 @@ func NewNotificationTemplateDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type notificationTemplateListDataSource = framework.GenericListDataSource[notificationTemplateTerraformModel, *notificationTemplateTerraformModel]
+
+// NewNotificationTemplateListDataSource is a helper function to instantiate the NotificationTemplate list data source.
+func NewNotificationTemplateListDataSource() datasource.DataSource {
+	return &notificationTemplateListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "notification_templates", Endpoint: "/api/v2/notification_templates/"}},
+		Cfg: framework.ListDataSourceCfg[notificationTemplateTerraformModel]{
+			Description: "Lists every NotificationTemplate matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this notification template.",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this notification template.",
+					Computed:    true,
+				},
+				"messages": dschema.StringAttribute{
+					Description: "Optional custom messages for notification template.",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this notification template.",
+					Computed:    true,
+				},
+				"notification_configuration": dschema.StringAttribute{
+					Description: "Notification configuration",
+					Computed:    true,
+				},
+				"notification_type": dschema.StringAttribute{
+					Description: "Notification type",
+					Computed:    true,
+				},
+				"organization": dschema.Int64Attribute{
+					Description: "Organization",
+					Computed:    true,
+				},
+			},
+			Hook:         hookNotificationTemplate,
+			ApiVersion:   ApiVersion,
+			ResourceName: "NotificationTemplate",
+		},
+	}
+}
@@ -119,8 +119,16 @@ func NewOrganizationResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *organizationTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *organizationTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+				}},
+			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "Organization",
 		},
@@ -176,8 +184,8 @@ func NewOrganizationDataSource() datasource.DataSource {
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
-				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
-					{Name: "name", Type: "string", URLEscape: true},
+				{Name: "by_named_url", URLSuffix: "%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
 				}},
 			},
 			ApiVersion:   ApiVersion,
@@ -185,3 +193,39 @@ func NewOrganizationDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type organizationListDataSource = framework.GenericListDataSource[organizationTerraformModel, *organizationTerraformModel]
+
+// NewOrganizationListDataSource is a helper function to instantiate the Organization list data source.
+func NewOrganizationListDataSource() datasource.DataSource {
+	return &organizationListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "organizations", Endpoint: "/api/v2/organizations/"}},
+		Cfg: framework.ListDataSourceCfg[organizationTerraformModel]{
+			Description: "Lists every Organization matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"default_environment": dschema.Int64Attribute{
+					Description: "The default execution environment for jobs run by this organization.",
+					Computed:    true,
+				},
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this organization.",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this organization.",
+					Computed:    true,
+				},
+				"max_hosts": dschema.Int64Attribute{
+					Description: "Maximum number of hosts allowed to be managed by this organization.",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this organization.",
+					Computed:    true,
+				},
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "Organization",
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewOrganizationGalaxyCredentialsResource returns the authoritative Organization ↔ GalaxyCredential association resource.
+func NewOrganizationGalaxyCredentialsResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "organization_galaxy_credentials",
+		Endpoint:      "/api/v2/organizations/%d/galaxy_credentials/",
+		ParentName:    "Organization",
+		ParentIDAttr:  "organization_id",
+		ChildName:     "GalaxyCredential",
+		ChildIDsAttr:  "credential_ids",
+		AssociateType: "",
+		Ordered:       true,
+		Deprecated:    false,
+	})
+}
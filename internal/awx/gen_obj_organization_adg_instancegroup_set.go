@@ -0,0 +1,22 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewOrganizationInstanceGroupsResource returns the authoritative Organization ↔ InstanceGroup association resource.
+func NewOrganizationInstanceGroupsResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "organization_instance_groups",
+		Endpoint:      "/api/v2/organizations/%d/instance_groups/",
+		ParentName:    "Organization",
+		ParentIDAttr:  "organization_id",
+		ChildName:     "InstanceGroup",
+		ChildIDsAttr:  "instance_group_ids",
+		AssociateType: "",
+		Ordered:       false,
+		Deprecated:    false,
+	})
+}
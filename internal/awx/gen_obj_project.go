@@ -42,6 +42,7 @@ type projectTerraformModel struct {
 	ScmClean                      types.Bool   `tfsdk:"scm_clean" json:"scm_clean"`
 	ScmDeleteOnUpdate             types.Bool   `tfsdk:"scm_delete_on_update" json:"scm_delete_on_update"`
 	ScmRefspec                    types.String `tfsdk:"scm_refspec" json:"scm_refspec"`
+	ScmRevision                   types.String `tfsdk:"scm_revision" json:"scm_revision"`
 	ScmTrackSubmodules            types.Bool   `tfsdk:"scm_track_submodules" json:"scm_track_submodules"`
 	ScmType                       types.String `tfsdk:"scm_type" json:"scm_type"`
 	ScmUpdateCacheTimeout         types.Int64  `tfsdk:"scm_update_cache_timeout" json:"scm_update_cache_timeout"`
@@ -52,6 +53,8 @@ type projectTerraformModel struct {
 	// WaitForSync is a Terraform-only toggle, not synced to the AWX API.
 	WaitForSync types.Bool     `tfsdk:"wait_for_sync" json:"-"`
 	Timeouts    timeouts.Value `tfsdk:"timeouts" json:"-"`
+	// SyncTriggers is a Terraform-only map, a change launches a job.
+	SyncTriggers types.Map `tfsdk:"sync_triggers" json:"-"`
 }
 
 func (o *projectTerraformModel) Clone() projectTerraformModel {
@@ -99,6 +102,7 @@ func (o *projectTerraformModel) UpdateFromApiData(data map[string]any) (diags di
 	collect(helpers.AttrValueSetBool(&o.ScmClean, data["scm_clean"]))
 	collect(helpers.AttrValueSetBool(&o.ScmDeleteOnUpdate, data["scm_delete_on_update"]))
 	collect(helpers.AttrValueSetString(&o.ScmRefspec, data["scm_refspec"], false))
+	collect(helpers.AttrValueSetString(&o.ScmRevision, data["scm_revision"], false))
 	collect(helpers.AttrValueSetBool(&o.ScmTrackSubmodules, data["scm_track_submodules"]))
 	collect(helpers.AttrValueSetString(&o.ScmType, data["scm_type"], false))
 	collect(helpers.AttrValueSetInt64(&o.ScmUpdateCacheTimeout, data["scm_update_cache_timeout"]))
@@ -323,6 +327,13 @@ func NewProjectResource() resource.Resource {
 							int64planmodifier.UseStateForUnknown(),
 						},
 					},
+					"scm_revision": schema.StringAttribute{
+						Description: "The last revision fetched by a project update",
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
 					"wait_for_sync": schema.BoolAttribute{
 						Description: "If true, wait for AWX to finish the SCM update kicked off on create or update before returning. Configure the maximum wait via the timeouts block.",
 						Optional:    true,
@@ -332,14 +343,29 @@ func NewProjectResource() resource.Resource {
 							boolplanmodifier.UseStateForUnknown(),
 						},
 					},
+					"sync_triggers": schema.MapAttribute{
+						Description: "Arbitrary map of values, e.g. a git commit SHA, that forces an SCM update of the project when it changes. The update is waited for when wait_for_sync is set, otherwise scm_revision keeps the revision from before the update until the next refresh.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
 				},
 			},
-			IDAccessor:   func(m *projectTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *projectTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
+			},
 			EmitTimeouts: true,
 			CopyExtraAttributes: func(plan, state *projectTerraformModel) {
 				state.WaitForSync = plan.WaitForSync
 				state.Timeouts = plan.Timeouts
+				state.SyncTriggers = plan.SyncTriggers
 			},
 			WaitLifecycle: &framework.WaitLifecycleCfg[projectTerraformModel]{
 				ShouldWait: func(plan *projectTerraformModel) bool {
@@ -365,6 +391,23 @@ func NewProjectResource() resource.Resource {
 					}
 					return plan.Timeouts.Create(ctx, 5*time.Minute)
 				},
+				RefreshAfterWait: true,
+				Launch: &framework.WaitLaunchCfg[projectTerraformModel]{
+					ShouldLaunch: func(plan, prior *projectTerraformModel) bool {
+						return prior != nil && !plan.SyncTriggers.Equal(prior.SyncTriggers)
+					},
+					EndpointForModel: func(m *projectTerraformModel) string {
+						return fmt.Sprintf("/api/v2/projects/%d/update/", m.ID.ValueInt64())
+					},
+					IDField:     "project_update",
+					JobEndpoint: "/api/v2/project_updates/",
+				},
+				MarkUnknown: func(plan *projectTerraformModel, waiting bool) {
+					if !waiting {
+						return
+					}
+					plan.ScmRevision = types.StringUnknown()
+				},
 			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "Project",
@@ -402,8 +445,9 @@ func NewProjectDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.Int64{
-							int64validator.ExactlyOneOf(
-								path.MatchRoot("id"),
+							int64validator.ConflictsWith(
+								path.MatchRoot("name"),
+								path.MatchRoot("organization_name"),
 							),
 						},
 					},
@@ -413,7 +457,16 @@ func NewProjectDataSource() datasource.DataSource {
 					},
 					"name": dschema.StringAttribute{
 						Description: "Name of this project.",
+						Optional:    true,
 						Computed:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("organization_name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
 					},
 					"organization": dschema.Int64Attribute{
 						Description: "The organization used to determine access to this template.",
@@ -435,6 +488,10 @@ func NewProjectDataSource() datasource.DataSource {
 						Description: "For git projects, an additional refspec to fetch.",
 						Computed:    true,
 					},
+					"scm_revision": dschema.StringAttribute{
+						Description: "The last revision fetched by a project update",
+						Computed:    true,
+					},
 					"scm_track_submodules": dschema.BoolAttribute{
 						Description: "Track submodules latest commits on defined branch.",
 						Computed:    true,
@@ -463,12 +520,124 @@ func NewProjectDataSource() datasource.DataSource {
 						Description: "The amount of time (in seconds) to run before the task is canceled.",
 						Computed:    true,
 					},
+					"organization_name": dschema.StringAttribute{
+						Description: "Search only, looks the Project up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
 				},
 			},
 			SearchGroups: []framework.SearchGroup{
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "Project",
+		},
+	}
+}
+
+type projectListDataSource = framework.GenericListDataSource[projectTerraformModel, *projectTerraformModel]
+
+// NewProjectListDataSource is a helper function to instantiate the Project list data source.
+func NewProjectListDataSource() datasource.DataSource {
+	return &projectListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "projects", Endpoint: "/api/v2/projects/"}},
+		Cfg: framework.ListDataSourceCfg[projectTerraformModel]{
+			Description: "Lists every Project matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"allow_override": dschema.BoolAttribute{
+					Description: "Allow changing the SCM branch or revision in a job template that uses this project.",
+					Computed:    true,
+				},
+				"credential": dschema.Int64Attribute{
+					Description: "Credential",
+					Computed:    true,
+				},
+				"default_environment": dschema.Int64Attribute{
+					Description: "The default execution environment for jobs run using this project.",
+					Computed:    true,
+				},
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this project.",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this project.",
+					Computed:    true,
+				},
+				"local_path": dschema.StringAttribute{
+					Description: "Local path (relative to PROJECTS_ROOT) containing playbooks and related files for this project.",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this project.",
+					Computed:    true,
+				},
+				"organization": dschema.Int64Attribute{
+					Description: "The organization used to determine access to this template.",
+					Computed:    true,
+				},
+				"scm_branch": dschema.StringAttribute{
+					Description: "Specific branch, tag or commit to checkout.",
+					Computed:    true,
+				},
+				"scm_clean": dschema.BoolAttribute{
+					Description: "Discard any local changes before syncing the project.",
+					Computed:    true,
+				},
+				"scm_delete_on_update": dschema.BoolAttribute{
+					Description: "Delete the project before syncing.",
+					Computed:    true,
+				},
+				"scm_refspec": dschema.StringAttribute{
+					Description: "For git projects, an additional refspec to fetch.",
+					Computed:    true,
+				},
+				"scm_revision": dschema.StringAttribute{
+					Description: "The last revision fetched by a project update",
+					Computed:    true,
+				},
+				"scm_track_submodules": dschema.BoolAttribute{
+					Description: "Track submodules latest commits on defined branch.",
+					Computed:    true,
+				},
+				"scm_type": dschema.StringAttribute{
+					Description: "Specifies the source control system used to store the project.",
+					Computed:    true,
+				},
+				"scm_update_cache_timeout": dschema.Int64Attribute{
+					Description: "The number of seconds after the last project update ran that a new project update will be launched as a job dependency.",
+					Computed:    true,
+				},
+				"scm_update_on_launch": dschema.BoolAttribute{
+					Description: "Update the project when a job is launched that uses the project.",
+					Computed:    true,
+				},
+				"scm_url": dschema.StringAttribute{
+					Description: "The location where the project is stored.",
+					Computed:    true,
+				},
+				"signature_validation_credential": dschema.Int64Attribute{
+					Description: "An optional credential used for validating files in the project against unexpected changes.",
+					Computed:    true,
+				},
+				"timeout": dschema.Int64Attribute{
+					Description: "The amount of time (in seconds) to run before the task is canceled.",
+					Computed:    true,
+				},
 			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "Project",
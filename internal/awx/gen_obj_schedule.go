@@ -339,8 +339,13 @@ func NewScheduleResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *scheduleTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *scheduleTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "Schedule",
 		},
@@ -470,3 +475,115 @@ func NewScheduleDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type scheduleListDataSource = framework.GenericListDataSource[scheduleTerraformModel, *scheduleTerraformModel]
+
+// NewScheduleListDataSource is a helper function to instantiate the Schedule list data source.
+func NewScheduleListDataSource() datasource.DataSource {
+	return &scheduleListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "schedules", Endpoint: "/api/v2/schedules/"}},
+		Cfg: framework.ListDataSourceCfg[scheduleTerraformModel]{
+			Description: "Lists every Schedule matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this schedule.",
+					Computed:    true,
+				},
+				"diff_mode": dschema.BoolAttribute{
+					Description: "Diff mode",
+					Computed:    true,
+				},
+				"dtend": dschema.StringAttribute{
+					Description: "The last occurrence of the schedule occurs before this time, aftewards the schedule expires.",
+					Computed:    true,
+				},
+				"dtstart": dschema.StringAttribute{
+					Description: "The first occurrence of the schedule occurs on or after this time.",
+					Computed:    true,
+				},
+				"enabled": dschema.BoolAttribute{
+					Description: "Enables processing of this schedule.",
+					Computed:    true,
+				},
+				"execution_environment": dschema.Int64Attribute{
+					Description: "The container image to be used for execution.",
+					Computed:    true,
+				},
+				"extra_data": dschema.StringAttribute{
+					Description: "Extra data",
+					Computed:    true,
+				},
+				"forks": dschema.Int64Attribute{
+					Description: "Forks",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this schedule.",
+					Computed:    true,
+				},
+				"inventory": dschema.Int64Attribute{
+					Description: "Inventory applied as a prompt, assuming job template prompts for inventory",
+					Computed:    true,
+				},
+				"job_slice_count": dschema.Int64Attribute{
+					Description: "Job slice count",
+					Computed:    true,
+				},
+				"job_tags": dschema.StringAttribute{
+					Description: "Job tags",
+					Computed:    true,
+				},
+				"job_type": dschema.StringAttribute{
+					Description: "Job type",
+					Computed:    true,
+				},
+				"limit": dschema.StringAttribute{
+					Description: "Limit",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this schedule.",
+					Computed:    true,
+				},
+				"next_run": dschema.StringAttribute{
+					Description: "The next time that the scheduled action will run.",
+					Computed:    true,
+				},
+				"rrule": dschema.StringAttribute{
+					Description: "A value representing the schedules iCal recurrence rule.",
+					Computed:    true,
+				},
+				"scm_branch": dschema.StringAttribute{
+					Description: "Scm branch",
+					Computed:    true,
+				},
+				"skip_tags": dschema.StringAttribute{
+					Description: "Skip tags",
+					Computed:    true,
+				},
+				"timeout": dschema.Int64Attribute{
+					Description: "Timeout",
+					Computed:    true,
+				},
+				"timezone": dschema.StringAttribute{
+					Description: "The timezone this schedule runs in. This field is extracted from the RRULE. If the timezone in the RRULE is a link to another timezone, the link will be reflected in this field.",
+					Computed:    true,
+				},
+				"unified_job_template": dschema.Int64Attribute{
+					Description: "Unified job template",
+					Computed:    true,
+				},
+				"until": dschema.StringAttribute{
+					Description: "The date this schedule will end. This field is computed from the RRULE. If the schedule does not end an empty string will be returned",
+					Computed:    true,
+				},
+				"verbosity": dschema.StringAttribute{
+					Description: "Verbosity",
+					Computed:    true,
+				},
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "Schedule",
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewTeamRolesResource returns the authoritative Team ↔ Role association resource.
+func NewTeamRolesResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "team_roles",
+		Endpoint:      "/api/v2/teams/%d/roles/",
+		ParentName:    "Team",
+		ParentIDAttr:  "team_id",
+		ChildName:     "Role",
+		ChildIDsAttr:  "role_ids",
+		AssociateType: "",
+		Ordered:       false,
+		Deprecated:    true,
+	})
+}
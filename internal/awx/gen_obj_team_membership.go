@@ -0,0 +1,17 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewTeamMembershipResource returns the authoritative member and admin users resource of a Team.
+func NewTeamMembershipResource() resource.Resource {
+	return framework.NewMembershipResource(
+		"team_membership",
+		"/api/v2/teams/",
+		"/api/v2/roles/",
+		"Team",
+	)
+}
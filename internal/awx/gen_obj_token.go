@@ -139,8 +139,13 @@ func NewTokensResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *tokensTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *tokensTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+			},
 			ApiVersion:   ApiVersion,
 			ResourceName: "Tokens",
 		},
@@ -206,3 +211,51 @@ func NewTokensDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type tokensListDataSource = framework.GenericListDataSource[tokensTerraformModel, *tokensTerraformModel]
+
+// NewTokensListDataSource is a helper function to instantiate the Tokens list data source.
+func NewTokensListDataSource() datasource.DataSource {
+	return &tokensListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "tokens", Endpoint: "/api/v2/tokens/"}},
+		Cfg: framework.ListDataSourceCfg[tokensTerraformModel]{
+			Description: "Lists every Tokens matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"application": dschema.Int64Attribute{
+					Description: "Application",
+					Computed:    true,
+				},
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this access token.",
+					Computed:    true,
+				},
+				"expires": dschema.StringAttribute{
+					Description: "Expires",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this access token.",
+					Computed:    true,
+				},
+				"refresh_token": dschema.StringAttribute{
+					Description: "Refresh token",
+					Computed:    true,
+				},
+				"scope": dschema.StringAttribute{
+					Description: "Allowed scopes, further restricts user's permissions. Must be a simple space-separated string with allowed scopes ['read', 'write'].",
+					Computed:    true,
+				},
+				"token": dschema.StringAttribute{
+					Description: "Token",
+					Computed:    true,
+				},
+				"user": dschema.Int64Attribute{
+					Description: "The user representing the token owner",
+					Computed:    true,
+				},
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "Tokens",
+		},
+	}
+}
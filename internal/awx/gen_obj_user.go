@@ -188,8 +188,16 @@ func NewUserResource() resource.Resource {
 					},
 				},
 			},
-			IDAccessor:   func(m *userTerraformModel) any { return m.ID.ValueInt64() },
-			IDKey:        "id",
+			IDAccessor: func(m *userTerraformModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "username", Type: "string", URLEscape: false},
+				}},
+			},
 			Hook:         hookUser,
 			ApiVersion:   ApiVersion,
 			ResourceName: "User",
@@ -271,8 +279,8 @@ func NewUserDataSource() datasource.DataSource {
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
-				{Name: "by_username", URLSuffix: "?username__exact=%s", Fields: []framework.SearchField{
-					{Name: "username", Type: "string", URLEscape: true},
+				{Name: "by_named_url", URLSuffix: "%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "username", Type: "string", URLEscape: false},
 				}},
 			},
 			Hook:         hookUser,
@@ -281,3 +289,65 @@ func NewUserDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type userListDataSource = framework.GenericListDataSource[userTerraformModel, *userTerraformModel]
+
+// NewUserListDataSource is a helper function to instantiate the User list data source.
+func NewUserListDataSource() datasource.DataSource {
+	return &userListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "users", Endpoint: "/api/v2/users/"}},
+		Cfg: framework.ListDataSourceCfg[userTerraformModel]{
+			Description: "Lists every User matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"email": dschema.StringAttribute{
+					Description: "Email address",
+					Computed:    true,
+				},
+				"external_account": dschema.StringAttribute{
+					Description: "Set if the account is managed by an external service",
+					Computed:    true,
+				},
+				"first_name": dschema.StringAttribute{
+					Description: "First name",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this user.",
+					Computed:    true,
+				},
+				"is_superuser": dschema.BoolAttribute{
+					Description: "Designates that this user has all permissions without explicitly assigning them.",
+					Computed:    true,
+				},
+				"is_system_auditor": dschema.BoolAttribute{
+					Description: "Is system auditor",
+					Computed:    true,
+				},
+				"last_login": dschema.StringAttribute{
+					Description: "Last login",
+					Computed:    true,
+				},
+				"last_name": dschema.StringAttribute{
+					Description: "Last name",
+					Computed:    true,
+				},
+				"ldap_dn": dschema.StringAttribute{
+					Description: "Ldap dn",
+					Computed:    true,
+				},
+				"password": dschema.StringAttribute{
+					Description: "Field used to change the password.",
+					Sensitive:   true,
+					Computed:    true,
+				},
+				"username": dschema.StringAttribute{
+					Description: "Required. 150 characters or fewer. Letters, digits and @/./+/-/_ only.",
+					Computed:    true,
+				},
+			},
+			Hook:         hookUser,
+			ApiVersion:   ApiVersion,
+			ResourceName: "User",
+		},
+	}
+}
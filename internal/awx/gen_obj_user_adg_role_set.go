@@ -0,0 +1,22 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewUserRolesResource returns the authoritative User ↔ Role association resource.
+func NewUserRolesResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "user_roles",
+		Endpoint:      "/api/v2/users/%d/roles/",
+		ParentName:    "User",
+		ParentIDAttr:  "user_id",
+		ChildName:     "Role",
+		ChildIDsAttr:  "role_ids",
+		AssociateType: "",
+		Ordered:       false,
+		Deprecated:    false,
+	})
+}
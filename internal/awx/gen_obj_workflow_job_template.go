@@ -321,6 +321,18 @@ func NewWorkflowJobTemplateResource() resource.Resource {
 			},
 			IDAccessor: func(m *workflowJobTemplateTerraformModel) any { return m.ID.ValueInt64() },
 			IDKey:      "id",
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+					{Name: "id", Type: "int64", URLEscape: false},
+				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
+				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: true},
+				}},
+			},
 			Hook: func(ctx context.Context, apiVersion string, source hooks.Source, callee hooks.Callee, orig, state *workflowJobTemplateTerraformModel) error {
 				return hooks.RequireResourceStateOrOrig(ctx, apiVersion, source, callee, orig, state)
 			},
@@ -384,9 +396,9 @@ func NewWorkflowJobTemplateDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.Int64{
-							int64validator.ExactlyOneOf(
-								path.MatchRoot("id"),
+							int64validator.ConflictsWith(
 								path.MatchRoot("name"),
+								path.MatchRoot("organization_name"),
 							),
 						},
 					},
@@ -407,9 +419,8 @@ func NewWorkflowJobTemplateDataSource() datasource.DataSource {
 						Optional:    true,
 						Computed:    true,
 						Validators: []validator.String{
-							stringvalidator.ExactlyOneOf(
+							stringvalidator.ConflictsWith(
 								path.MatchRoot("id"),
-								path.MatchRoot("name"),
 							),
 						},
 					},
@@ -437,12 +448,28 @@ func NewWorkflowJobTemplateDataSource() datasource.DataSource {
 						Description: "Service that webhook requests will be accepted from",
 						Computed:    true,
 					},
+					"organization_name": dschema.StringAttribute{
+						Description: "Search only, looks the WorkflowJobTemplate up by its AWX named URL together with the other fields of the named URL.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(
+								path.MatchRoot("name"),
+							),
+							stringvalidator.ConflictsWith(
+								path.MatchRoot("id"),
+							),
+						},
+					},
 				},
 			},
 			SearchGroups: []framework.SearchGroup{
 				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
 					{Name: "id", Type: "int64", URLEscape: false},
 				}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string", URLEscape: false},
+					{Name: "organization_name", Type: "string", URLEscape: false},
+				}},
 				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
 					{Name: "name", Type: "string", URLEscape: true},
 				}},
@@ -455,3 +482,106 @@ func NewWorkflowJobTemplateDataSource() datasource.DataSource {
 		},
 	}
 }
+
+type workflowJobTemplateListDataSource = framework.GenericListDataSource[workflowJobTemplateTerraformModel, *workflowJobTemplateTerraformModel]
+
+// NewWorkflowJobTemplateListDataSource is a helper function to instantiate the WorkflowJobTemplate list data source.
+func NewWorkflowJobTemplateListDataSource() datasource.DataSource {
+	return &workflowJobTemplateListDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "workflow_job_templates", Endpoint: "/api/v2/workflow_job_templates/"}},
+		Cfg: framework.ListDataSourceCfg[workflowJobTemplateTerraformModel]{
+			Description: "Lists every WorkflowJobTemplate matching the filters.",
+			ItemAttributes: map[string]dschema.Attribute{
+				"allow_simultaneous": dschema.BoolAttribute{
+					Description: "Allow simultaneous",
+					Computed:    true,
+				},
+				"ask_inventory_on_launch": dschema.BoolAttribute{
+					Description: "Ask inventory on launch",
+					Computed:    true,
+				},
+				"ask_labels_on_launch": dschema.BoolAttribute{
+					Description: "Ask labels on launch",
+					Computed:    true,
+				},
+				"ask_limit_on_launch": dschema.BoolAttribute{
+					Description: "Ask limit on launch",
+					Computed:    true,
+				},
+				"ask_scm_branch_on_launch": dschema.BoolAttribute{
+					Description: "Ask scm branch on launch",
+					Computed:    true,
+				},
+				"ask_skip_tags_on_launch": dschema.BoolAttribute{
+					Description: "Ask skip tags on launch",
+					Computed:    true,
+				},
+				"ask_tags_on_launch": dschema.BoolAttribute{
+					Description: "Ask tags on launch",
+					Computed:    true,
+				},
+				"ask_variables_on_launch": dschema.BoolAttribute{
+					Description: "Ask variables on launch",
+					Computed:    true,
+				},
+				"description": dschema.StringAttribute{
+					Description: "Optional description of this workflow job template.",
+					Computed:    true,
+				},
+				"extra_vars": dschema.StringAttribute{
+					Description: "Extra vars",
+					Computed:    true,
+				},
+				"id": dschema.Int64Attribute{
+					Description: "Database ID for this workflow job template.",
+					Computed:    true,
+				},
+				"inventory": dschema.Int64Attribute{
+					Description: "Inventory applied as a prompt, assuming job template prompts for inventory",
+					Computed:    true,
+				},
+				"job_tags": dschema.StringAttribute{
+					Description: "Job tags",
+					Computed:    true,
+				},
+				"limit": dschema.StringAttribute{
+					Description: "Limit",
+					Computed:    true,
+				},
+				"name": dschema.StringAttribute{
+					Description: "Name of this workflow job template.",
+					Computed:    true,
+				},
+				"organization": dschema.Int64Attribute{
+					Description: "The organization used to determine access to this template.",
+					Computed:    true,
+				},
+				"scm_branch": dschema.StringAttribute{
+					Description: "Scm branch",
+					Computed:    true,
+				},
+				"skip_tags": dschema.StringAttribute{
+					Description: "Skip tags",
+					Computed:    true,
+				},
+				"survey_enabled": dschema.BoolAttribute{
+					Description: "Survey enabled",
+					Computed:    true,
+				},
+				"webhook_credential": dschema.Int64Attribute{
+					Description: "Personal Access Token for posting back the status to the service API",
+					Computed:    true,
+				},
+				"webhook_service": dschema.StringAttribute{
+					Description: "Service that webhook requests will be accepted from",
+					Computed:    true,
+				},
+			},
+			Hook: func(ctx context.Context, apiVersion string, source hooks.Source, callee hooks.Callee, orig, state *workflowJobTemplateTerraformModel) error {
+				return hooks.RequireResourceStateOrOrig(ctx, apiVersion, source, callee, orig, state)
+			},
+			ApiVersion:   ApiVersion,
+			ResourceName: "WorkflowJobTemplate",
+		},
+	}
+}
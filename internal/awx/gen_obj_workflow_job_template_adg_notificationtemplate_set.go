@@ -0,0 +1,22 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewWorkflowJobTemplateNotificationTemplatesResource returns the authoritative WorkflowJobTemplate ↔ NotificationTemplate association resource.
+func NewWorkflowJobTemplateNotificationTemplatesResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "workflow_job_template_notification_templates",
+		Endpoint:      "/api/v2/workflow_job_templates/%d/notification_templates_%s/",
+		ParentName:    "WorkflowJobTemplate",
+		ParentIDAttr:  "workflow_job_template_id",
+		ChildName:     "NotificationTemplate",
+		ChildIDsAttr:  "notification_template_ids",
+		AssociateType: "notification_job_workflow_template",
+		Ordered:       false,
+		Deprecated:    false,
+	})
+}
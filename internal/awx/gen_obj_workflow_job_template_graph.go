@@ -0,0 +1,17 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewWorkflowJobTemplateGraphResource returns the resource managing every node and edge of a WorkflowJobTemplate.
+func NewWorkflowJobTemplateGraphResource() resource.Resource {
+	return framework.NewWorkflowGraphResource(
+		"workflow_job_template_graph",
+		"/api/v2/workflow_job_templates/%d/workflow_nodes/",
+		"/api/v2/workflow_job_template_nodes/",
+		"/api/v2/workflow_approval_templates/",
+	)
+}
@@ -0,0 +1,16 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewWorkflowJobTemplateLaunchResource returns the resource launching a WorkflowJobTemplate.
+func NewWorkflowJobTemplateLaunchResource() resource.Resource {
+	return framework.NewWorkflowJobLaunchResource(
+		"workflow_job_launch",
+		"/api/v2/workflow_job_templates/",
+		"/api/v2/workflow_jobs/",
+	)
+}
@@ -0,0 +1,22 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewWorkflowJobTemplateNodeAlwaysNodesResource returns the authoritative WorkflowJobTemplateNode ↔ AlwaysNode association resource.
+func NewWorkflowJobTemplateNodeAlwaysNodesResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "workflow_job_template_node_always_nodes",
+		Endpoint:      "/api/v2/workflow_job_template_nodes/%d/always_nodes/",
+		ParentName:    "WorkflowJobTemplateNode",
+		ParentIDAttr:  "workflow_job_template_node_id",
+		ChildName:     "AlwaysNode",
+		ChildIDsAttr:  "always_node_ids",
+		AssociateType: "",
+		Ordered:       false,
+		Deprecated:    false,
+	})
+}
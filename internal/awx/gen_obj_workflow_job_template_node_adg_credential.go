@@ -0,0 +1,21 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewWorkflowJobTemplateNodeAssociateDisassociateCredentialResource returns the WorkflowJobTemplateNode ↔ Credential association resource.
+func NewWorkflowJobTemplateNodeAssociateDisassociateCredentialResource() resource.Resource {
+	return framework.NewAssociateDisassociateResource(framework.AssociateDisassociateConfig{
+		TypeName:      "workflow_job_template_node_associate_credential",
+		Endpoint:      "/api/v2/workflow_job_template_nodes/%d/credentials/",
+		ParentName:    "WorkflowJobTemplateNode",
+		ParentIDAttr:  "workflow_job_template_node_id",
+		ChildName:     "Credential",
+		ChildIDAttr:   "credential_id",
+		AssociateType: "",
+		Deprecated:    false,
+	})
+}
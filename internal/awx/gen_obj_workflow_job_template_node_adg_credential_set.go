@@ -0,0 +1,22 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewWorkflowJobTemplateNodeCredentialsResource returns the authoritative WorkflowJobTemplateNode ↔ Credential association resource.
+func NewWorkflowJobTemplateNodeCredentialsResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "workflow_job_template_node_credentials",
+		Endpoint:      "/api/v2/workflow_job_template_nodes/%d/credentials/",
+		ParentName:    "WorkflowJobTemplateNode",
+		ParentIDAttr:  "workflow_job_template_node_id",
+		ChildName:     "Credential",
+		ChildIDsAttr:  "credential_ids",
+		AssociateType: "",
+		Ordered:       false,
+		Deprecated:    false,
+	})
+}
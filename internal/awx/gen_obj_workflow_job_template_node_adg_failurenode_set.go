@@ -0,0 +1,22 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewWorkflowJobTemplateNodeFailureNodesResource returns the authoritative WorkflowJobTemplateNode ↔ FailureNode association resource.
+func NewWorkflowJobTemplateNodeFailureNodesResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "workflow_job_template_node_failure_nodes",
+		Endpoint:      "/api/v2/workflow_job_template_nodes/%d/failure_nodes/",
+		ParentName:    "WorkflowJobTemplateNode",
+		ParentIDAttr:  "workflow_job_template_node_id",
+		ChildName:     "FailureNode",
+		ChildIDsAttr:  "failure_node_ids",
+		AssociateType: "",
+		Ordered:       false,
+		Deprecated:    false,
+	})
+}
@@ -0,0 +1,22 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewWorkflowJobTemplateNodeSuccessNodesResource returns the authoritative WorkflowJobTemplateNode ↔ SuccessNode association resource.
+func NewWorkflowJobTemplateNodeSuccessNodesResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "workflow_job_template_node_success_nodes",
+		Endpoint:      "/api/v2/workflow_job_template_nodes/%d/success_nodes/",
+		ParentName:    "WorkflowJobTemplateNode",
+		ParentIDAttr:  "workflow_job_template_node_id",
+		ChildName:     "SuccessNode",
+		ChildIDsAttr:  "success_node_ids",
+		AssociateType: "",
+		Ordered:       false,
+		Deprecated:    false,
+	})
+}
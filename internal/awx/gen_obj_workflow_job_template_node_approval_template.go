@@ -0,0 +1,16 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewWorkflowJobTemplateNodeApprovalTemplateResource returns the resource managing the approval template of a WorkflowJobTemplateNode.
+func NewWorkflowJobTemplateNodeApprovalTemplateResource() resource.Resource {
+	return framework.NewApprovalTemplateResource(
+		"workflow_job_template_node_approval_template",
+		"/api/v2/workflow_job_template_nodes/",
+		"/api/v2/workflow_approval_templates/",
+	)
+}
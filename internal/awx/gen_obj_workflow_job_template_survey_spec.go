@@ -113,10 +113,14 @@ func (o *workflowJobTemplateSurvey) Read(ctx context.Context, request resource.R
 	}
 
 	endpoint := o.endpointFor(state.WorkflowJobTemplateID.ValueInt64())
-	data, d := framework.ReadRequest(ctx, o.Client, endpoint, "WorkflowJobTemplate/Survey")
+	data, found, d := framework.ReadRequestAllowNotFound(ctx, o.Client, endpoint, "WorkflowJobTemplate/Survey")
 	if framework.DiagnosticsHasError(&response.Diagnostics, d...) {
 		return
 	}
+	if !found {
+		response.State.RemoveResource(ctx)
+		return
+	}
 
 	if val, ok := data["spec"]; ok {
 		dg, _ := helpers.AttrValueSetJsonString(&state.Spec, val, false)
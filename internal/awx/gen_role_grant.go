@@ -0,0 +1,30 @@
+package awx
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewRoleGrantResource returns the resource granting a user or a team a role of an object by name.
+func NewRoleGrantResource() resource.Resource {
+	return framework.NewRoleGrantResource(framework.RoleGrantConfig{
+		TypeName: "role_grant",
+		Principals: map[string]string{
+			"team": "/api/v2/teams/%d/roles/",
+			"user": "/api/v2/users/%d/roles/",
+		},
+		ObjectRoles: map[string]string{
+			"constructed_inventory": "/api/v2/constructed_inventories/%d/object_roles/",
+			"credential":            "/api/v2/credentials/%d/object_roles/",
+			"host":                  "/api/v2/hosts/%d/object_roles/",
+			"instance_group":        "/api/v2/instance_groups/%d/object_roles/",
+			"inventory":             "/api/v2/inventories/%d/object_roles/",
+			"job_template":          "/api/v2/job_templates/%d/object_roles/",
+			"organization":          "/api/v2/organizations/%d/object_roles/",
+			"project":               "/api/v2/projects/%d/object_roles/",
+			"team":                  "/api/v2/teams/%d/object_roles/",
+			"workflow_job_template": "/api/v2/workflow_job_templates/%d/object_roles/",
+		},
+	})
+}
@@ -13,33 +13,85 @@ const (
 func DataSources() []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAdHocCommandDataSource,
+		NewAdHocCommandListDataSource,
 		NewApplicationDataSource,
+		NewApplicationListDataSource,
 		NewConstructedInventoriesDataSource,
+		NewConstructedInventoriesListDataSource,
 		NewConstructedInventoriesObjectRolesDataSource,
 		NewCredentialDataSource,
+		NewCredentialAimDataSource,
 		NewCredentialAwsDataSource,
+		NewCredentialAwsSecretsmanagerDataSource,
+		NewCredentialAzureKvDataSource,
+		NewCredentialAzureRmDataSource,
+		NewCredentialBitbucketDcTokenDataSource,
+		NewCredentialConjurDataSource,
+		NewCredentialControllerDataSource,
+		NewCredentialGalaxyApiTokenDataSource,
+		NewCredentialGcpDataSource,
+		NewCredentialGithubTokenDataSource,
+		NewCredentialGitlabTokenDataSource,
+		NewCredentialGpgPublicKeyDataSource,
+		NewCredentialHashivaultKvDataSource,
+		NewCredentialHashivaultSshDataSource,
 		NewCredentialInputSourceDataSource,
+		NewCredentialInputSourceListDataSource,
+		NewCredentialInsightsDataSource,
+		NewCredentialKubernetesBearerTokenDataSource,
+		NewCredentialListDataSource,
+		NewCredentialMachineDataSource,
+		NewCredentialNetDataSource,
 		NewCredentialObjectRolesDataSource,
+		NewCredentialOpenstackDataSource,
+		NewCredentialRegistryDataSource,
+		NewCredentialRhvDataSource,
+		NewCredentialSatellite6DataSource,
+		NewCredentialScmDataSource,
+		NewCredentialTerraformDataSource,
+		NewCredentialThycoticDsvDataSource,
+		NewCredentialThycoticTssDataSource,
 		NewCredentialTypeDataSource,
+		NewCredentialTypeListDataSource,
+		NewCredentialVaultDataSource,
+		NewCredentialVmwareDataSource,
 		NewExecutionEnvironmentDataSource,
+		NewExecutionEnvironmentListDataSource,
 		NewGroupDataSource,
+		NewGroupListDataSource,
 		NewHostDataSource,
+		NewHostListDataSource,
 		NewHostObjectRolesDataSource,
 		NewInstanceGroupDataSource,
+		NewInstanceGroupListDataSource,
 		NewInstanceGroupObjectRolesDataSource,
 		NewInventoryDataSource,
+		NewInventoryListDataSource,
 		NewInventoryObjectRolesDataSource,
 		NewInventorySourceDataSource,
+		NewInventorySourceListDataSource,
 		NewJobTemplateDataSource,
+		NewJobTemplateListDataSource,
 		NewJobTemplateObjectRolesDataSource,
 		NewLabelDataSource,
+		NewLabelListDataSource,
 		NewMeDataSource,
 		NewNotificationTemplateDataSource,
+		NewNotificationTemplateListDataSource,
 		NewOrganizationDataSource,
+		NewOrganizationListDataSource,
 		NewOrganizationObjectRolesDataSource,
 		NewProjectDataSource,
+		NewProjectListDataSource,
 		NewProjectObjectRolesDataSource,
+		NewRoleDefinitionDataSource,
+		NewRoleDefinitionListDataSource,
+		NewRoleTeamAssignmentDataSource,
+		NewRoleTeamAssignmentListDataSource,
+		NewRoleUserAssignmentDataSource,
+		NewRoleUserAssignmentListDataSource,
 		NewScheduleDataSource,
+		NewScheduleListDataSource,
 		NewSettingsAuthAzureADOauth2DataSource,
 		NewSettingsAuthGithubDataSource,
 		NewSettingsAuthGithubEnterpriseDataSource,
@@ -57,10 +109,16 @@ func DataSources() []func() datasource.DataSource {
 		NewSettingsOpenIDConnectDataSource,
 		NewSettingsUIDataSource,
 		NewTeamDataSource,
+		NewTeamListDataSource,
 		NewTeamObjectRolesDataSource,
 		NewTokensDataSource,
+		NewTokensListDataSource,
 		NewUserDataSource,
+		NewUserListDataSource,
 		NewWorkflowJobTemplateDataSource,
+		NewWorkflowJobTemplateListDataSource,
+		NewWorkflowJobTemplateNodeDataSource,
+		NewWorkflowJobTemplateNodeListDataSource,
 		NewWorkflowJobTemplateObjectRolesDataSource,
 	}
 }
@@ -72,13 +130,42 @@ func Resources() []func() resource.Resource {
 		NewApplicationResource,
 		NewConstructedInventoriesResource,
 		NewCredentialResource,
+		NewCredentialAimResource,
 		NewCredentialAwsResource,
+		NewCredentialAwsSecretsmanagerResource,
+		NewCredentialAzureKvResource,
+		NewCredentialAzureRmResource,
+		NewCredentialBitbucketDcTokenResource,
+		NewCredentialConjurResource,
+		NewCredentialControllerResource,
+		NewCredentialGalaxyApiTokenResource,
+		NewCredentialGcpResource,
+		NewCredentialGithubTokenResource,
+		NewCredentialGitlabTokenResource,
+		NewCredentialGpgPublicKeyResource,
+		NewCredentialHashivaultKvResource,
+		NewCredentialHashivaultSshResource,
 		NewCredentialInputSourceResource,
+		NewCredentialInsightsResource,
+		NewCredentialKubernetesBearerTokenResource,
+		NewCredentialMachineResource,
+		NewCredentialNetResource,
+		NewCredentialOpenstackResource,
+		NewCredentialRegistryResource,
+		NewCredentialRhvResource,
+		NewCredentialSatellite6Resource,
+		NewCredentialScmResource,
+		NewCredentialTerraformResource,
+		NewCredentialThycoticDsvResource,
+		NewCredentialThycoticTssResource,
 		NewCredentialTypeResource,
+		NewCredentialVaultResource,
+		NewCredentialVmwareResource,
 		NewExecutionEnvironmentResource,
 		NewGroupResource,
 		NewHostResource,
 		NewHostAssociateDisassociateGroupResource,
+		NewHostGroupsResource,
 		NewInstanceGroupResource,
 		NewInventoryResource,
 		NewInventorySourceResource,
@@ -86,13 +173,23 @@ func Resources() []func() resource.Resource {
 		NewJobTemplateAssociateDisassociateCredentialResource,
 		NewJobTemplateAssociateDisassociateInstanceGroupResource,
 		NewJobTemplateAssociateDisassociateNotificationTemplateResource,
+		NewJobTemplateCredentialsResource,
+		NewJobTemplateInstanceGroupsResource,
+		NewJobTemplateLaunchResource,
+		NewJobTemplateNotificationTemplatesResource,
 		NewJobTemplateSurveyResource,
 		NewLabelResource,
 		NewNotificationTemplateResource,
 		NewOrganizationResource,
 		NewOrganizationAssociateDisassociateGalaxyCredentialResource,
 		NewOrganizationAssociateDisassociateInstanceGroupResource,
+		NewOrganizationGalaxyCredentialsResource,
+		NewOrganizationInstanceGroupsResource,
 		NewProjectResource,
+		NewRoleDefinitionResource,
+		NewRoleGrantResource,
+		NewRoleTeamAssignmentResource,
+		NewRoleUserAssignmentResource,
 		NewScheduleResource,
 		NewSettingsAuthAzureADOauth2Resource,
 		NewSettingsAuthGithubResource,
@@ -112,11 +209,27 @@ func Resources() []func() resource.Resource {
 		NewSettingsUIResource,
 		NewTeamResource,
 		NewTeamAssociateDisassociateRoleResource,
+		NewTeamMembershipResource,
+		NewTeamRolesResource,
 		NewTokensResource,
 		NewUserResource,
 		NewUserAssociateDisassociateRoleResource,
+		NewUserRolesResource,
 		NewWorkflowJobTemplateResource,
 		NewWorkflowJobTemplateAssociateDisassociateNotificationTemplateResource,
+		NewWorkflowJobTemplateGraphResource,
+		NewWorkflowJobTemplateLaunchResource,
+		NewWorkflowJobTemplateNodeResource,
+		NewWorkflowJobTemplateNodeAlwaysNodesResource,
+		NewWorkflowJobTemplateNodeApprovalTemplateResource,
+		NewWorkflowJobTemplateNodeAssociateDisassociateAlwaysNodeResource,
+		NewWorkflowJobTemplateNodeAssociateDisassociateCredentialResource,
+		NewWorkflowJobTemplateNodeAssociateDisassociateFailureNodeResource,
+		NewWorkflowJobTemplateNodeAssociateDisassociateSuccessNodeResource,
+		NewWorkflowJobTemplateNodeCredentialsResource,
+		NewWorkflowJobTemplateNodeFailureNodesResource,
+		NewWorkflowJobTemplateNodeSuccessNodesResource,
+		NewWorkflowJobTemplateNotificationTemplatesResource,
 		NewWorkflowJobTemplateSurveyResource,
 	}
 }
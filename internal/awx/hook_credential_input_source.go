@@ -0,0 +1,25 @@
+package awx
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+	"github.com/ilijamt/terraform-provider-awx/internal/hooks"
+)
+
+// validateCredentialInputSourceMetadata checks the planned metadata against
+// the inputs.metadata of the type of the source credential.
+func validateCredentialInputSourceMetadata(ctx context.Context, client framework.Requester, plan *credentialInputSourceTerraformModel) diag.Diagnostics {
+	return framework.ValidateInputSourceMetadata(ctx, client, plan.SourceCredential, plan.Metadata, plan.MetadataJson)
+}
+
+// hookCredentialInputSource puts the metadata AWX returns back into the
+// block of metadata, or the metadata_json, it was written in.
+func hookCredentialInputSource(ctx context.Context, _ string, source hooks.Source, _ hooks.Callee, orig, state *credentialInputSourceTerraformModel) error {
+	if source != hooks.SourceResource || orig == nil || state == nil {
+		return nil
+	}
+	return framework.ReconcileInputSourceMetadata(ctx, orig.Metadata, orig.MetadataJson, &state.Metadata, &state.MetadataJson)
+}
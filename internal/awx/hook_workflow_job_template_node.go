@@ -0,0 +1,15 @@
+package awx
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// validateWorkflowJobTemplateNodeUnifiedJobTemplate stops a planned
+// unified_job_template on a node that runs an approval template.
+func validateWorkflowJobTemplateNodeUnifiedJobTemplate(ctx context.Context, client framework.Requester, plan *workflowJobTemplateNodeTerraformModel) diag.Diagnostics {
+	return framework.ValidateNodeUnifiedJobTemplate(ctx, client, "/api/v2/workflow_job_template_nodes/", plan.ID, plan.UnifiedJobTemplate)
+}
@@ -11,11 +11,19 @@ import (
 var (
 	ErrInvalidStatusCode = errors.New("invalid status code")
 	ErrJsonDecode        = errors.New("json decode")
+	// ErrNotFound is returned instead of ErrInvalidStatusCode when AWX answers
+	// 404, so callers can tell a deleted object apart from a failed request.
+	ErrNotFound = errors.New("not found")
 )
 
 type Client interface {
 	NewRequest(ctx context.Context, method string, endpoint string, body io.Reader) (*http.Request, error)
 	Do(ctx context.Context, req *http.Request) (data map[string]any, err error)
+	// DoRaw is Do for the endpoints that do not answer JSON, the body is
+	// returned undecoded along with its content type and status code.
+	DoRaw(ctx context.Context, req *http.Request) (*RawResponse, error)
+	// DoRawLimit is DoRaw that reads at most limit bytes of the body.
+	DoRawLimit(ctx context.Context, req *http.Request, limit int64) (*RawResponse, error)
 }
 
 // preserveMethodOnRedirect follows redirects but restores the original method,
@@ -52,7 +60,7 @@ func preserveMethodOnRedirect(req *http.Request, via []*http.Request) error {
 	return nil
 }
 
-func defaultClient(client *http.Client, insecureSkipVerify bool) *http.Client {
+func defaultClient(client *http.Client, insecureSkipVerify bool, retry RetryConfig) *http.Client {
 	tr := http.DefaultTransport.(*http.Transport).Clone()
 	tr.TLSClientConfig = &tls.Config{
 		InsecureSkipVerify: insecureSkipVerify,
@@ -67,5 +75,13 @@ func defaultClient(client *http.Client, insecureSkipVerify bool) *http.Client {
 		client.CheckRedirect = preserveMethodOnRedirect
 	}
 
+	if retry.MaxRetries > 0 {
+		// Shallow copy so an injected client isn't wrapped again every time
+		// the provider is configured with it.
+		wrapped := *client
+		wrapped.Transport = newRetryTransport(client.Transport, retry)
+		client = &wrapped
+	}
+
 	return client
 }
@@ -17,9 +17,9 @@ type clientWithBasicAuth struct {
 
 var _ Client = &clientWithBasicAuth{}
 
-func NewClientWithBasicAuth(username, password, hostname string, version string, insecureSkipVerify bool, httpClient *http.Client) Client {
+func NewClientWithBasicAuth(username, password, hostname string, version string, insecureSkipVerify bool, httpClient *http.Client, retry RetryConfig) Client {
 	return &clientWithBasicAuth{
-		client:   defaultClient(httpClient, insecureSkipVerify),
+		client:   defaultClient(httpClient, insecureSkipVerify, retry),
 		hostname: hostname,
 		username: username,
 		password: password,
@@ -41,3 +41,11 @@ func (c *clientWithBasicAuth) NewRequest(ctx context.Context, method string, end
 func (c *clientWithBasicAuth) Do(ctx context.Context, req *http.Request) (data map[string]any, err error) {
 	return doRequest(c.client, ctx, req)
 }
+
+func (c *clientWithBasicAuth) DoRaw(ctx context.Context, req *http.Request) (*RawResponse, error) {
+	return doRawRequest(c.client, ctx, req)
+}
+
+func (c *clientWithBasicAuth) DoRawLimit(ctx context.Context, req *http.Request, limit int64) (*RawResponse, error) {
+	return doRawRequestLimit(c.client, ctx, req, limit)
+}
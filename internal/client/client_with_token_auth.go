@@ -17,9 +17,9 @@ type clientWithTokenAuth struct {
 
 var _ Client = &clientWithTokenAuth{}
 
-func NewClientWithTokenAuth(token, hostname string, version string, insecureSkipVerify bool, httpClient *http.Client) Client {
+func NewClientWithTokenAuth(token, hostname string, version string, insecureSkipVerify bool, httpClient *http.Client, retry RetryConfig) Client {
 	return &clientWithTokenAuth{
-		client:   defaultClient(httpClient, insecureSkipVerify),
+		client:   defaultClient(httpClient, insecureSkipVerify, retry),
 		hostname: hostname,
 		token:    token,
 		version:  version,
@@ -40,3 +40,11 @@ func (c *clientWithTokenAuth) NewRequest(ctx context.Context, method string, end
 func (c *clientWithTokenAuth) Do(ctx context.Context, req *http.Request) (data map[string]any, err error) {
 	return doRequest(c.client, ctx, req)
 }
+
+func (c *clientWithTokenAuth) DoRaw(ctx context.Context, req *http.Request) (*RawResponse, error) {
+	return doRawRequest(c.client, ctx, req)
+}
+
+func (c *clientWithTokenAuth) DoRawLimit(ctx context.Context, req *http.Request, limit int64) (*RawResponse, error) {
+	return doRawRequestLimit(c.client, ctx, req, limit)
+}
@@ -30,7 +30,7 @@ func TestNewClientWithTokenAuth(t *testing.T) {
 		{method: http.MethodPatch, err: client.ErrInvalidStatusCode},
 	}
 
-	c := client.NewClientWithTokenAuth("token", server.URL, "test", true, nil)
+	c := client.NewClientWithTokenAuth("token", server.URL, "test", true, nil, client.RetryConfig{})
 
 	for _, tst := range tests {
 		t.Run(tst.method, func(t *testing.T) {
@@ -65,7 +65,7 @@ func TestNewClientWithTokenAuthBody(t *testing.T) {
 		}
 	}))
 
-	c := client.NewClientWithTokenAuth("token", server.URL, "test", true, nil)
+	c := client.NewClientWithTokenAuth("token", server.URL, "test", true, nil, client.RetryConfig{})
 	for _, tst := range tests {
 		t.Run(fmt.Sprintf("%s - %s", tst.name, tst.method), func(t *testing.T) {
 			req, err := c.NewRequest(t.Context(), http.MethodGet, "/api/v2/request", nil)
@@ -79,3 +79,32 @@ func TestNewClientWithTokenAuthBody(t *testing.T) {
 	}
 
 }
+
+func TestNewClientWithTokenAuthRaw(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Header.Get("Authorization") != "Bearer token" {
+			rw.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if req.URL.Path == "/api/v2/metrics" {
+			http.Redirect(rw, req, "/api/v2/metrics/", http.StatusMovedPermanently)
+			return
+		}
+		rw.Header().Set("Content-Type", "text/plain")
+		_, _ = rw.Write([]byte("awx_system_info 1\n"))
+	}))
+	defer server.Close()
+
+	c := client.NewClientWithTokenAuth("token", server.URL, "test", true, nil, client.RetryConfig{})
+	req, err := c.NewRequest(t.Context(), http.MethodGet, "/api/v2/metrics", nil)
+	require.NoError(t, err)
+	resp, err := c.DoRaw(t.Context(), req)
+	require.NoError(t, err)
+	require.Equal(t, &client.RawResponse{
+		Body:        []byte("awx_system_info 1\n"),
+		ContentType: "text/plain",
+		StatusCode:  http.StatusOK,
+	}, resp)
+}
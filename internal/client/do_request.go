@@ -12,41 +12,96 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-func doRequest(client *http.Client, ctx context.Context, req *http.Request) (data map[string]any, err error) {
+// RawResponse is an AWX response with its body left undecoded.
+type RawResponse struct {
+	Body        []byte
+	ContentType string
+	StatusCode  int
+}
+
+// send performs the request and reads the body, at most limit bytes of it
+// when limit is positive. Redirects and retries are handled by the
+// http.Client, so Do and DoRaw behave the same way.
+func send(client *http.Client, ctx context.Context, req *http.Request, limit int64) (*RawResponse, error) {
 	if client == nil {
-		return data, fmt.Errorf("nil http clientWithBasicAuth")
+		return nil, fmt.Errorf("nil http clientWithBasicAuth")
 	}
 
-	var resp *http.Response
-	if resp, err = client.Do(req.WithContext(ctx)); err != nil {
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
 		return nil, fmt.Errorf("%w: failed to do request", err)
 	}
 	defer resp.Body.Close()
 
-	var payload []byte
-
-	if payload, err = io.ReadAll(resp.Body); err != nil {
-		return data, err
+	var body io.Reader = resp.Body
+	if limit > 0 {
+		body = io.LimitReader(resp.Body, limit)
+	}
+	payload, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
 	}
 
 	tflog.Trace(ctx, "HTTP response", map[string]any{
 		"method":           req.Method,
-		"url":              req.URL.String(),
+		"url":              req.URL.Redacted(),
 		"status":           resp.StatusCode,
+		"content_type":     resp.Header.Get("Content-Type"),
 		"final_method":     resp.Request.Method,
-		"final_url":        resp.Request.URL.String(),
+		"final_url":        resp.Request.URL.Redacted(),
 		"redirect_applied": req.URL.String() != resp.Request.URL.String() || req.Method != resp.Request.Method,
 	})
 
-	dec := json.NewDecoder(bytes.NewReader(payload))
+	return &RawResponse{Body: payload, ContentType: resp.Header.Get("Content-Type"), StatusCode: resp.StatusCode}, nil
+}
+
+// statusError reports the responses AWX answered with a failure status.
+func statusError(req *http.Request, resp *RawResponse) error {
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("%w: %d, on %s with %s", ErrNotFound, resp.StatusCode, req.URL.RequestURI(), string(resp.Body))
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%w: %d, on %s with %s", ErrInvalidStatusCode, resp.StatusCode, req.URL.RequestURI(), string(resp.Body))
+	}
+	return nil
+}
+
+func doRequest(client *http.Client, ctx context.Context, req *http.Request) (data map[string]any, err error) {
+	resp, err := send(client, ctx, req, 0)
+	if err != nil {
+		return data, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(resp.Body))
 	dec.UseNumber()
 	if err = dec.Decode(&data); err != nil && !errors.Is(err, io.EOF) {
 		return data, fmt.Errorf("%w: failed to decode data", err)
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return data, fmt.Errorf("%w: %d, on %s with %s", ErrInvalidStatusCode, resp.StatusCode, req.URL.RequestURI(), string(payload))
+	if resp.StatusCode == http.StatusBadRequest {
+		if verr := parseValidationError(resp.StatusCode, req.URL.RequestURI(), resp.Body); verr != nil {
+			return data, verr
+		}
 	}
 
-	return data, nil
+	return data, statusError(req, resp)
+}
+
+// doRawRequest sends the request and returns the body undecoded, for the AWX
+// endpoints that do not answer JSON, e.g. job stdout with ?format=txt_download or the
+// metrics endpoint. A failure status is reported like doRequest does, with
+// the response returned alongside the error.
+func doRawRequest(client *http.Client, ctx context.Context, req *http.Request) (*RawResponse, error) {
+	return doRawRequestLimit(client, ctx, req, 0)
+}
+
+// doRawRequestLimit is doRawRequest that reads at most limit bytes of the
+// body, so a large job stdout is not held in memory only to be truncated. A
+// limit of zero or less reads the whole body.
+func doRawRequestLimit(client *http.Client, ctx context.Context, req *http.Request, limit int64) (*RawResponse, error) {
+	resp, err := send(client, ctx, req, limit)
+	if err != nil {
+		return nil, err
+	}
+	return resp, statusError(req, resp)
 }
@@ -1,13 +1,16 @@
 package client
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 	"testing/iotest"
 
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
 	"github.com/stretchr/testify/require"
 )
 
@@ -73,4 +76,138 @@ func TestDoRequest(t *testing.T) {
 		require.ErrorContains(t, err, "unexpected EOF")
 		require.Empty(t, data)
 	})
+
+	t.Run("not found is distinct from invalid status code", func(t *testing.T) {
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write(json.RawMessage(`{"detail": "Not found."}`))
+		}))
+		defer svr.Close()
+
+		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+		require.NoError(t, err)
+		require.NotNil(t, req)
+
+		data, err := doRequest(http.DefaultClient, t.Context(), req)
+		require.ErrorIs(t, err, ErrNotFound)
+		require.NotErrorIs(t, err, ErrInvalidStatusCode)
+		require.ErrorContains(t, err, "Not found.")
+		require.Equal(t, "Not found.", data["detail"])
+	})
+
+	t.Run("credentials in the url are not logged", func(t *testing.T) {
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write(json.RawMessage(`{}`))
+		}))
+		defer svr.Close()
+
+		u, err := url.Parse(svr.URL)
+		require.NoError(t, err)
+		u.User = url.UserPassword("admin", "url-s3cret")
+		req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+		require.NoError(t, err)
+
+		var logs bytes.Buffer
+		_, err = doRequest(http.DefaultClient, tflogtest.RootLogger(t.Context(), &logs), req)
+		require.NoError(t, err)
+		require.Contains(t, logs.String(), "HTTP response")
+		require.NotContains(t, logs.String(), "url-s3cret")
+	})
+}
+
+func TestDoRawRequest(t *testing.T) {
+	t.Run("nil clientWithBasicAuth should error out", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "url", nil)
+		require.NoError(t, err)
+		resp, err := doRawRequest(nil, t.Context(), req)
+		require.ErrorContains(t, err, "nil http clientWithBasicAuth")
+		require.Nil(t, resp)
+	})
+
+	t.Run("plain text body is returned as is", func(t *testing.T) {
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			_, _ = w.Write([]byte("web01 | SUCCESS => pong\n"))
+		}))
+		defer svr.Close()
+
+		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+		require.NoError(t, err)
+		resp, err := doRawRequest(http.DefaultClient, t.Context(), req)
+		require.NoError(t, err)
+		require.Equal(t, &RawResponse{
+			Body:        []byte("web01 | SUCCESS => pong\n"),
+			ContentType: "text/plain; charset=utf-8",
+			StatusCode:  http.StatusOK,
+		}, resp)
+	})
+
+	t.Run("not found is distinct from invalid status code", func(t *testing.T) {
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer svr.Close()
+
+		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+		require.NoError(t, err)
+		_, err = doRawRequest(http.DefaultClient, t.Context(), req)
+		require.ErrorIs(t, err, ErrNotFound)
+		require.NotErrorIs(t, err, ErrInvalidStatusCode)
+	})
+
+	t.Run("invalid status code returns the response too", func(t *testing.T) {
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte("boom"))
+		}))
+		defer svr.Close()
+
+		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+		require.NoError(t, err)
+		resp, err := doRawRequest(http.DefaultClient, t.Context(), req)
+		require.ErrorIs(t, err, ErrInvalidStatusCode)
+		require.ErrorContains(t, err, "boom")
+		require.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+		require.Equal(t, "boom", string(resp.Body))
+	})
+
+	t.Run("binary body is not decoded", func(t *testing.T) {
+		bundle := []byte{0x1f, 0x8b, 0x08, 0x00}
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/gzip")
+			_, _ = w.Write(bundle)
+		}))
+		defer svr.Close()
+
+		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+		require.NoError(t, err)
+		resp, err := doRawRequest(http.DefaultClient, t.Context(), req)
+		require.NoError(t, err)
+		require.Equal(t, bundle, resp.Body)
+		require.Equal(t, "application/gzip", resp.ContentType)
+	})
+}
+
+func TestDoRawRequestLimit(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain")
+		_, _ = w.Write(bytes.Repeat([]byte("x"), 1<<20))
+	}))
+	defer svr.Close()
+
+	t.Run("reads at most limit bytes", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+		require.NoError(t, err)
+		resp, err := doRawRequestLimit(http.DefaultClient, t.Context(), req, 10)
+		require.NoError(t, err)
+		require.Equal(t, []byte("xxxxxxxxxx"), resp.Body)
+	})
+
+	t.Run("zero limit reads the whole body", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, svr.URL, nil)
+		require.NoError(t, err)
+		resp, err := doRawRequestLimit(http.DefaultClient, t.Context(), req, 0)
+		require.NoError(t, err)
+		require.Len(t, resp.Body, 1<<20)
+	})
 }
@@ -0,0 +1,196 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
+// RetryConfig controls how transient AWX failures (429, 502, 503, 504 and
+// network errors) are retried. The zero value disables retries.
+type RetryConfig struct {
+	// MaxRetries is the number of additional attempts after the first one.
+	MaxRetries int
+	// WaitMin is the base backoff; it doubles on every attempt.
+	WaitMin time.Duration
+	// WaitMax caps every wait. A Retry-After header from AWX takes precedence
+	// over the computed backoff but is still capped at WaitMax.
+	WaitMax time.Duration
+}
+
+// retryTransport wraps an http.RoundTripper and retries transient failures
+// with exponential backoff and jitter. Idempotent requests are retried on any
+// network error or retryable status code. Non-idempotent requests (POST,
+// PATCH) are only retried on 429, which AWX answers without acting on the
+// request, or when the connection could not be established, since in every
+// other case AWX may already have acted on them.
+type retryTransport struct {
+	next http.RoundTripper
+	cfg  RetryConfig
+}
+
+func newRetryTransport(next http.RoundTripper, cfg RetryConfig) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if cfg.WaitMin <= 0 {
+		cfg.WaitMin = DefaultRetryWaitMin
+	}
+	if cfg.WaitMax < cfg.WaitMin {
+		cfg.WaitMax = cfg.WaitMin
+	}
+	return &retryTransport{next: next, cfg: cfg}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.cfg.MaxRetries || !shouldRetry(req, resp, err) || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		tflog.Debug(ctx, "Retrying AWX request", map[string]any{
+			"method":  req.Method,
+			"url":     req.URL.Redacted(),
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+			"status":  statusOf(resp),
+			"error":   errorOf(err),
+		})
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rewindRequest returns the request to send for the given attempt. The first
+// attempt uses req as is; later attempts get a fresh body from GetBody.
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = body
+	return clone, nil
+}
+
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		if isIdempotent(req.Method) {
+			return true
+		}
+		return neverReachedServer(err)
+	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if !isIdempotent(req.Method) {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodPost, http.MethodPatch:
+		return false
+	}
+	return true
+}
+
+// neverReachedServer reports whether err happened while establishing the
+// connection, i.e. before any part of the request was written.
+func neverReachedServer(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// backoff returns how long to wait before the next attempt: the Retry-After
+// header when AWX sends one, otherwise WaitMin*2^attempt with jitter over the
+// upper half of the interval. Either way the wait is capped at WaitMax.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if d, ok := retryAfter(resp); ok {
+		return min(d, t.cfg.WaitMax)
+	}
+	wait := t.cfg.WaitMax
+	if attempt < 32 {
+		if exp := t.cfg.WaitMin << attempt; exp > 0 && exp < t.cfg.WaitMax {
+			wait = exp
+		}
+	}
+	half := wait / 2
+	return half + rand.N(half+1)
+}
+
+// retryAfter parses the Retry-After header in either delay-seconds or
+// HTTP-date form.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+func statusOf(resp *http.Response) int {
+	if resp == nil {
+		return 0
+	}
+	return resp.StatusCode
+}
+
+func errorOf(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
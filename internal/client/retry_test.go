@@ -0,0 +1,252 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetryConfig = RetryConfig{MaxRetries: 3, WaitMin: time.Millisecond, WaitMax: 5 * time.Millisecond}
+
+func TestRetryTransport(t *testing.T) {
+	flaky := func(failures int32, status int, calls *atomic.Int32, bodies *[]string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if bodies != nil {
+				b, _ := io.ReadAll(r.Body)
+				*bodies = append(*bodies, string(b))
+			}
+			if calls.Add(1) <= failures {
+				w.WriteHeader(status)
+				return
+			}
+			_, _ = w.Write([]byte(`{"id":1}`))
+		}
+	}
+
+	t.Run("idempotent requests are retried on retryable status codes", func(t *testing.T) {
+		for _, status := range []int{http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+			t.Run(http.StatusText(status), func(t *testing.T) {
+				var calls atomic.Int32
+				svr := httptest.NewServer(flaky(2, status, &calls, nil))
+				defer svr.Close()
+
+				c := NewClientWithBasicAuth("user", "pass", svr.URL, "test", true, nil, testRetryConfig)
+				req, err := c.NewRequest(t.Context(), http.MethodGet, "/api/v2/me/", nil)
+				require.NoError(t, err)
+				data, err := c.Do(t.Context(), req)
+				require.NoError(t, err)
+				assert.EqualValues(t, "1", data["id"].(json.Number).String())
+				assert.EqualValues(t, 3, calls.Load())
+			})
+		}
+	})
+
+	t.Run("body is rewound for every attempt", func(t *testing.T) {
+		var calls atomic.Int32
+		var bodies []string
+		svr := httptest.NewServer(flaky(1, http.StatusServiceUnavailable, &calls, &bodies))
+		defer svr.Close()
+
+		c := NewClientWithBasicAuth("user", "pass", svr.URL, "test", true, nil, testRetryConfig)
+		req, err := c.NewRequest(t.Context(), http.MethodPut, "/api/v2/hosts/1/", bytes.NewReader([]byte(`{"name":"host"}`)))
+		require.NoError(t, err)
+		_, err = c.Do(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, []string{`{"name":"host"}`, `{"name":"host"}`}, bodies)
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		var calls atomic.Int32
+		svr := httptest.NewServer(flaky(100, http.StatusServiceUnavailable, &calls, nil))
+		defer svr.Close()
+
+		c := NewClientWithBasicAuth("user", "pass", svr.URL, "test", true, nil, testRetryConfig)
+		req, err := c.NewRequest(t.Context(), http.MethodGet, "/api/v2/me/", nil)
+		require.NoError(t, err)
+		_, err = c.Do(t.Context(), req)
+		require.ErrorIs(t, err, ErrInvalidStatusCode)
+		assert.EqualValues(t, 4, calls.Load())
+	})
+
+	t.Run("non idempotent requests are not retried once sent", func(t *testing.T) {
+		for _, method := range []string{http.MethodPost, http.MethodPatch} {
+			t.Run(method, func(t *testing.T) {
+				var calls atomic.Int32
+				svr := httptest.NewServer(flaky(1, http.StatusServiceUnavailable, &calls, nil))
+				defer svr.Close()
+
+				c := NewClientWithBasicAuth("user", "pass", svr.URL, "test", true, nil, testRetryConfig)
+				req, err := c.NewRequest(t.Context(), method, "/api/v2/job_templates/1/launch/", bytes.NewReader([]byte(`{}`)))
+				require.NoError(t, err)
+				_, err = c.Do(t.Context(), req)
+				require.ErrorIs(t, err, ErrInvalidStatusCode)
+				assert.EqualValues(t, 1, calls.Load())
+			})
+		}
+	})
+
+	t.Run("non idempotent requests are retried on too many requests", func(t *testing.T) {
+		for _, method := range []string{http.MethodPost, http.MethodPatch} {
+			t.Run(method, func(t *testing.T) {
+				var calls atomic.Int32
+				var bodies []string
+				svr := httptest.NewServer(flaky(2, http.StatusTooManyRequests, &calls, &bodies))
+				defer svr.Close()
+
+				c := NewClientWithBasicAuth("user", "pass", svr.URL, "test", true, nil, testRetryConfig)
+				req, err := c.NewRequest(t.Context(), method, "/api/v2/hosts/", bytes.NewReader([]byte(`{"name":"host"}`)))
+				require.NoError(t, err)
+				_, err = c.Do(t.Context(), req)
+				require.NoError(t, err)
+				assert.EqualValues(t, 3, calls.Load())
+				assert.Equal(t, []string{`{"name":"host"}`, `{"name":"host"}`, `{"name":"host"}`}, bodies)
+			})
+		}
+	})
+
+	t.Run("raw requests are retried like json requests", func(t *testing.T) {
+		var calls atomic.Int32
+		svr := httptest.NewServer(flaky(2, http.StatusServiceUnavailable, &calls, nil))
+		defer svr.Close()
+
+		c := NewClientWithBasicAuth("user", "pass", svr.URL, "test", true, nil, testRetryConfig)
+		req, err := c.NewRequest(t.Context(), http.MethodGet, "/api/v2/metrics/", nil)
+		require.NoError(t, err)
+		resp, err := c.DoRaw(t.Context(), req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.EqualValues(t, 3, calls.Load())
+	})
+
+	t.Run("zero value disables retries", func(t *testing.T) {
+		var calls atomic.Int32
+		svr := httptest.NewServer(flaky(1, http.StatusServiceUnavailable, &calls, nil))
+		defer svr.Close()
+
+		c := NewClientWithBasicAuth("user", "pass", svr.URL, "test", true, nil, RetryConfig{})
+		req, err := c.NewRequest(t.Context(), http.MethodGet, "/api/v2/me/", nil)
+		require.NoError(t, err)
+		_, err = c.Do(t.Context(), req)
+		require.ErrorIs(t, err, ErrInvalidStatusCode)
+		assert.EqualValues(t, 1, calls.Load())
+	})
+
+	t.Run("retry after header is honored", func(t *testing.T) {
+		var calls atomic.Int32
+		var first, second time.Time
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if calls.Add(1) == 1 {
+				first = time.Now()
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			second = time.Now()
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		defer svr.Close()
+
+		c := NewClientWithBasicAuth("user", "pass", svr.URL, "test", true, nil, RetryConfig{MaxRetries: 3, WaitMin: time.Millisecond, WaitMax: 2 * time.Second})
+		req, err := c.NewRequest(t.Context(), http.MethodGet, "/api/v2/me/", nil)
+		require.NoError(t, err)
+		_, err = c.Do(t.Context(), req)
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, second.Sub(first), time.Second)
+	})
+
+	t.Run("post is retried when the connection was refused", func(t *testing.T) {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := l.Addr().String()
+		require.NoError(t, l.Close())
+
+		var attempts atomic.Int32
+		rt := newRetryTransport(roundTripFunc(func(r *http.Request) (*http.Response, error) {
+			attempts.Add(1)
+			return http.DefaultTransport.RoundTrip(r)
+		}), testRetryConfig)
+		c := NewClientWithBasicAuth("user", "pass", "http://"+addr, "test", true, &http.Client{Transport: rt}, RetryConfig{})
+		req, err := c.NewRequest(t.Context(), http.MethodPost, "/api/v2/hosts/", bytes.NewReader([]byte(`{}`)))
+		require.NoError(t, err)
+		_, err = c.Do(t.Context(), req)
+		require.Error(t, err)
+		assert.EqualValues(t, 4, attempts.Load())
+	})
+
+	t.Run("injected client is not modified", func(t *testing.T) {
+		hc := &http.Client{}
+		c := NewClientWithBasicAuth("user", "pass", "http://localhost", "test", true, hc, testRetryConfig)
+		require.NotNil(t, c)
+		assert.Nil(t, hc.Transport)
+	})
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		ok     bool
+		min    time.Duration
+		max    time.Duration
+	}{
+		{name: "missing", header: "", ok: false},
+		{name: "seconds", header: "7", ok: true, min: 7 * time.Second, max: 7 * time.Second},
+		{name: "http date", header: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat), ok: true, min: 58 * time.Minute, max: time.Hour},
+		{name: "date in the past", header: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), ok: true},
+		{name: "garbage", header: "soon", ok: false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if test.header != "" {
+				resp.Header.Set("Retry-After", test.header)
+			}
+			d, ok := retryAfter(resp)
+			require.Equal(t, test.ok, ok)
+			assert.GreaterOrEqual(t, d, test.min)
+			assert.LessOrEqual(t, d, test.max)
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	rt := newRetryTransport(nil, RetryConfig{MaxRetries: 10, WaitMin: 100 * time.Millisecond, WaitMax: time.Second}).(*retryTransport)
+	for attempt, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		got := rt.backoff(attempt, nil)
+		assert.GreaterOrEqual(t, got, want/2, "attempt %d", attempt)
+		assert.LessOrEqual(t, got, want, "attempt %d", attempt)
+	}
+	got := rt.backoff(100, nil)
+	assert.GreaterOrEqual(t, got, 500*time.Millisecond, "large attempts must not overflow")
+	assert.LessOrEqual(t, got, time.Second)
+}
+
+func TestRetryBackoffRetryAfter(t *testing.T) {
+	rt := newRetryTransport(nil, RetryConfig{MaxRetries: 3, WaitMin: 100 * time.Millisecond, WaitMax: 10 * time.Second}).(*retryTransport)
+	for _, tc := range []struct {
+		name       string
+		retryAfter string
+		want       time.Duration
+	}{
+		{name: "below wait max", retryAfter: "2", want: 2 * time.Second},
+		{name: "capped at wait max", retryAfter: "3600", want: 10 * time.Second},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{tc.retryAfter}}}
+			assert.Equal(t, tc.want, rt.backoff(0, resp))
+		})
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// nonFieldKeys are the keys AWX (Django REST framework) uses for errors that
+// are not tied to a single field.
+var nonFieldKeys = []string{"non_field_errors", "__all__", "detail"}
+
+// FieldError is a single message from an AWX validation error body.
+type FieldError struct {
+	// Field is the path to the offending key, outermost first, e.g.
+	// ["inputs", "password"]. List positions are rendered as their index.
+	// Empty when the message is not tied to a field.
+	Field []string
+	// Message is the human readable reason AWX gave.
+	Message string
+}
+
+// ValidationError is returned when AWX rejects a request with 400 and a JSON
+// body. It unwraps to ErrInvalidStatusCode so existing errors.Is checks keep
+// working, and carries the parsed per-field messages.
+type ValidationError struct {
+	StatusCode int
+	RequestURI string
+	Body       []byte
+	Errors     []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %d, on %s with %s", ErrInvalidStatusCode, e.StatusCode, e.RequestURI, string(e.Body))
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidStatusCode
+}
+
+// parseValidationError returns a ValidationError for payload, or nil when the
+// payload is not a JSON object.
+func parseValidationError(statusCode int, requestURI string, payload []byte) *ValidationError {
+	var body any
+	dec := json.NewDecoder(bytes.NewReader(payload))
+	dec.UseNumber()
+	if dec.Decode(&body) != nil {
+		return nil
+	}
+	if _, ok := body.(map[string]any); !ok {
+		return nil
+	}
+	return &ValidationError{
+		StatusCode: statusCode,
+		RequestURI: requestURI,
+		Body:       payload,
+		Errors:     collectFieldErrors(nil, body),
+	}
+}
+
+func collectFieldErrors(field []string, v any) (errs []FieldError) {
+	switch val := v.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		for _, k := range keys {
+			if slices.Contains(nonFieldKeys, k) {
+				errs = append(errs, collectFieldErrors(field, val[k])...)
+				continue
+			}
+			errs = append(errs, collectFieldErrors(append(slices.Clone(field), k), val[k])...)
+		}
+	case []any:
+		for i, item := range val {
+			switch item.(type) {
+			case map[string]any, []any:
+				errs = append(errs, collectFieldErrors(append(slices.Clone(field), strconv.Itoa(i)), item)...)
+			default:
+				errs = append(errs, collectFieldErrors(field, item)...)
+			}
+		}
+	case nil:
+	default:
+		errs = append(errs, FieldError{Field: field, Message: fmt.Sprint(val)})
+	}
+	return errs
+}
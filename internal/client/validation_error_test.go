@@ -0,0 +1,97 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseValidationError(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		want    []FieldError
+	}{
+		{
+			name:    "field errors",
+			payload: `{"playbook": ["Playbook not found for project."], "name": ["This field is required.", "Too short."]}`,
+			want: []FieldError{
+				{Field: []string{"name"}, Message: "This field is required."},
+				{Field: []string{"name"}, Message: "Too short."},
+				{Field: []string{"playbook"}, Message: "Playbook not found for project."},
+			},
+		},
+		{
+			name:    "non field errors",
+			payload: `{"non_field_errors": ["Cannot assign both."], "__all__": ["Conflict."], "detail": "Bad request."}`,
+			want: []FieldError{
+				{Message: "Conflict."},
+				{Message: "Bad request."},
+				{Message: "Cannot assign both."},
+			},
+		},
+		{
+			name:    "nested keys",
+			payload: `{"inputs": {"password": ["Required for this credential type."], "non_field_errors": ["Invalid inputs."]}}`,
+			want: []FieldError{
+				{Field: []string{"inputs"}, Message: "Invalid inputs."},
+				{Field: []string{"inputs", "password"}, Message: "Required for this credential type."},
+			},
+		},
+		{
+			name:    "list of objects",
+			payload: `{"spec": [{}, {"variable": ["Duplicate."]}]}`,
+			want: []FieldError{
+				{Field: []string{"spec", "1", "variable"}, Message: "Duplicate."},
+			},
+		},
+		{
+			name:    "scalar values",
+			payload: `{"verbosity": 5, "extra_vars": "Not valid YAML."}`,
+			want: []FieldError{
+				{Field: []string{"extra_vars"}, Message: "Not valid YAML."},
+				{Field: []string{"verbosity"}, Message: "5"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			verr := parseValidationError(http.StatusBadRequest, "/api/v2/job_templates/", []byte(test.payload))
+			require.NotNil(t, verr)
+			assert.Equal(t, test.want, verr.Errors)
+			assert.ErrorIs(t, verr, ErrInvalidStatusCode)
+			assert.Equal(t, "invalid status code: 400, on /api/v2/job_templates/ with "+test.payload, verr.Error())
+		})
+	}
+
+	t.Run("not a json object", func(t *testing.T) {
+		for _, payload := range []string{``, `<html>Bad Request</html>`, `["error"]`} {
+			assert.Nil(t, parseValidationError(http.StatusBadRequest, "/", []byte(payload)), payload)
+		}
+	})
+}
+
+func TestDoRequestValidationError(t *testing.T) {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write(json.RawMessage(`{"playbook": ["Playbook not found for project."]}`))
+	}))
+	defer svr.Close()
+
+	req, err := http.NewRequest(http.MethodPost, svr.URL+"/api/v2/job_templates/", nil)
+	require.NoError(t, err)
+
+	_, err = doRequest(http.DefaultClient, t.Context(), req)
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr))
+	assert.ErrorIs(t, err, ErrInvalidStatusCode)
+	assert.Equal(t, http.StatusBadRequest, verr.StatusCode)
+	assert.Equal(t, "/api/v2/job_templates/", verr.RequestURI)
+	assert.Equal(t, []FieldError{{Field: []string{"playbook"}, Message: "Playbook not found for project."}}, verr.Errors)
+}
@@ -0,0 +1,314 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	p "path"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*ApprovalTemplateResource)(nil)
+	_ resource.ResourceWithConfigure   = (*ApprovalTemplateResource)(nil)
+	_ resource.ResourceWithImportState = (*ApprovalTemplateResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*ApprovalTemplateResource)(nil)
+)
+
+// ApprovalTemplateModel is the state model of ApprovalTemplateResource.
+type ApprovalTemplateModel struct {
+	NodeID      types.Int64  `tfsdk:"workflow_job_template_node_id"`
+	ID          types.Int64  `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+}
+
+func (m ApprovalTemplateModel) body() map[string]any {
+	return map[string]any{
+		"name":        m.Name.ValueString(),
+		"description": m.Description.ValueString(),
+		"timeout":     m.Timeout.ValueInt64(),
+	}
+}
+
+// ApprovalTemplateResource manages the workflow approval template that turns
+// a workflow job template node into an approval node. AWX creates the
+// template through the node and makes it the node's unified job template, so
+// a node runs either an approval or a job, never both.
+type ApprovalTemplateResource struct {
+	ResourceBase
+	approvalEndpoint string
+}
+
+// NewApprovalTemplateResource constructs an ApprovalTemplateResource.
+// endpoint is the workflow job template nodes endpoint and approvalEndpoint
+// the workflow approval templates endpoint.
+func NewApprovalTemplateResource(typeName, endpoint, approvalEndpoint string) resource.Resource {
+	return &ApprovalTemplateResource{
+		ResourceBase: ResourceBase{
+			ProviderBase: ProviderBase{TypeName: typeName, Endpoint: endpoint},
+		},
+		approvalEndpoint: approvalEndpoint,
+	}
+}
+
+// Schema defines the schema for the resource.
+func (o *ApprovalTemplateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Makes a workflow job template node an approval node. The node must not have a unified_job_template.",
+		Attributes: map[string]schema.Attribute{
+			"workflow_job_template_node_id": schema.Int64Attribute{
+				Description: "Database ID for this WorkflowJobTemplateNode.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"id": schema.Int64Attribute{
+				Description: "Database ID of the workflow approval template.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the approval.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 512),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Optional description of the approval.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"timeout": schema.Int64Attribute{
+				Description: "The amount of time (in seconds) before the approval expires, 0 for no timeout.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+// ImportState imports the approval template of a node by the node ID.
+func (o *ApprovalTemplateResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(request.ID, 10, 64)
+	if err != nil {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("Unable to parse '%v' as an int64 number, please provide the workflow_job_template_node_id for the approval template.", request.ID),
+			err.Error(),
+		)
+		return
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("workflow_job_template_node_id"), types.Int64Value(id))...)
+}
+
+// Create adds an approval template to the node. An approval template that is
+// already attached to the node is updated in place instead.
+func (o *ApprovalTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan ApprovalTemplateModel
+	if DiagnosticsHasError(&response.Diagnostics, request.Plan.Get(ctx, &plan)...) {
+		return
+	}
+
+	node, d := ReadRequest(ctx, o.Client, EndpointWithID(o.Endpoint, plan.NodeID.ValueInt64()), "WorkflowJobTemplateNode")
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	approvalID, isApproval := nodeApprovalTemplateID(node)
+	if !nodeAcceptsApproval(node, plan.NodeID.ValueInt64(), &response.Diagnostics) {
+		return
+	}
+
+	method, endpoint, operation := http.MethodPost, o.createEndpoint(plan.NodeID.ValueInt64()), "create"
+	if isApproval {
+		method, endpoint, operation = http.MethodPatch, EndpointWithID(o.approvalEndpoint, approvalID), "update"
+	}
+	data, d := CreateUpdateRequest(ctx, o.Client, method, endpoint, plan.body(), "WorkflowApprovalTemplate", operation)
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	id, err := int64FromAPI(data["id"])
+	if err != nil {
+		response.Diagnostics.AddError("Unexpected response while creating WorkflowApprovalTemplate", err.Error())
+		return
+	}
+	plan.ID = types.Int64Value(id)
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+// ModifyPlan reports at plan time an approval template planned for a node
+// that already runs a unified job template. A node created in the same apply
+// is not known yet, Create checks it then.
+func (o *ApprovalTemplateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if o.Client == nil || request.Plan.Raw.IsNull() || !request.State.Raw.IsNull() {
+		return
+	}
+
+	var nodeID types.Int64
+	if DiagnosticsHasError(&response.Diagnostics, request.Plan.GetAttribute(ctx, path.Root("workflow_job_template_node_id"), &nodeID)...) {
+		return
+	}
+	if nodeID.IsUnknown() {
+		return
+	}
+	node, found, d := ReadRequestAllowNotFound(ctx, o.Client, EndpointWithID(o.Endpoint, nodeID.ValueInt64()), "WorkflowJobTemplateNode")
+	if DiagnosticsHasError(&response.Diagnostics, d...) || !found {
+		return
+	}
+	nodeAcceptsApproval(node, nodeID.ValueInt64(), &response.Diagnostics)
+}
+
+// Read refreshes the approval template, and drops the resource from state
+// when the node is gone or no longer runs an approval.
+func (o *ApprovalTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state ApprovalTemplateModel
+	if DiagnosticsHasError(&response.Diagnostics, request.State.Get(ctx, &state)...) {
+		return
+	}
+
+	node, found, d := ReadRequestAllowNotFound(ctx, o.Client, EndpointWithID(o.Endpoint, state.NodeID.ValueInt64()), "WorkflowJobTemplateNode")
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	approvalID, isApproval := nodeApprovalTemplateID(node)
+	if !found || !isApproval {
+		tflog.Debug(ctx, "[WorkflowApprovalTemplate/read] Node no longer runs an approval", map[string]any{
+			"workflow_job_template_node_id": state.NodeID.ValueInt64(),
+		})
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	data, found, d := ReadRequestAllowNotFound(ctx, o.Client, EndpointWithID(o.approvalEndpoint, approvalID), "WorkflowApprovalTemplate")
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	if !found {
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.Int64Value(approvalID)
+	state.Name = types.StringValue(fmt.Sprint(data["name"]))
+	description, _ := data["description"].(string)
+	state.Description = types.StringValue(description)
+	timeout, err := int64FromAPI(data["timeout"])
+	if err != nil {
+		timeout = 0
+	}
+	state.Timeout = types.Int64Value(timeout)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+// Update changes the approval template in place.
+func (o *ApprovalTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state ApprovalTemplateModel
+	if DiagnosticsHasError(&response.Diagnostics, request.Plan.Get(ctx, &plan)...) {
+		return
+	}
+	if DiagnosticsHasError(&response.Diagnostics, request.State.Get(ctx, &state)...) {
+		return
+	}
+
+	_, d := CreateUpdateRequest(ctx, o.Client, http.MethodPatch, EndpointWithID(o.approvalEndpoint, state.ID.ValueInt64()), plan.body(), "WorkflowApprovalTemplate", "update")
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	plan.ID = state.ID
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+// Delete deletes the approval template, AWX clears the node's unified job
+// template with it.
+func (o *ApprovalTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state ApprovalTemplateModel
+	if DiagnosticsHasError(&response.Diagnostics, request.State.Get(ctx, &state)...) {
+		return
+	}
+	response.Diagnostics.Append(DeleteRequest(ctx, o.Client, EndpointWithID(o.approvalEndpoint, state.ID.ValueInt64()), "WorkflowApprovalTemplate")...)
+}
+
+func (o *ApprovalTemplateResource) createEndpoint(nodeID int64) string {
+	return p.Clean(fmt.Sprintf("%s/%d/create_approval_template", o.Endpoint, nodeID)) + "/"
+}
+
+// nodeAcceptsApproval reports whether an approval template can be added to
+// the node, that is the node runs no unified job template or already runs an
+// approval.
+func nodeAcceptsApproval(node map[string]any, nodeID int64, diags *diag.Diagnostics) bool {
+	if _, isApproval := nodeApprovalTemplateID(node); isApproval || node["unified_job_template"] == nil {
+		return true
+	}
+	diags.AddAttributeError(path.Root("workflow_job_template_node_id"), "Workflow node already runs a unified job template",
+		fmt.Sprintf("Node %d runs unified job template %v. A node runs either an approval or a unified job template, unset unified_job_template on the node first.",
+			nodeID, node["unified_job_template"]))
+	return false
+}
+
+// ValidateNodeUnifiedJobTemplate reports at plan time a unified job template
+// planned for a workflow node that runs an approval. The unified job template
+// of an approval node is its approval template, so any other value conflicts
+// with it. New nodes and unknown values pass.
+func ValidateNodeUnifiedJobTemplate(ctx context.Context, client Requester, endpoint string, nodeID, unifiedJobTemplate types.Int64) (diags diag.Diagnostics) {
+	if nodeID.IsNull() || nodeID.IsUnknown() || unifiedJobTemplate.IsNull() || unifiedJobTemplate.IsUnknown() {
+		return diags
+	}
+	node, found, d := ReadRequestAllowNotFound(ctx, client, EndpointWithID(endpoint, nodeID.ValueInt64()), "WorkflowJobTemplateNode")
+	if DiagnosticsHasError(&diags, d...) || !found {
+		return diags
+	}
+	approvalID, isApproval := nodeApprovalTemplateID(node)
+	if !isApproval || approvalID == unifiedJobTemplate.ValueInt64() {
+		return diags
+	}
+	diags.AddAttributeError(path.Root("unified_job_template"), "Workflow node runs an approval",
+		fmt.Sprintf("Node %d runs approval template %d. A node runs either an approval or a unified job template, remove the approval template of the node first.",
+			nodeID.ValueInt64(), approvalID))
+	return diags
+}
+
+// nodeApprovalTemplateID returns the ID of the approval template a workflow
+// node runs, and whether the node runs one at all.
+func nodeApprovalTemplateID(node map[string]any) (int64, bool) {
+	summary, _ := node["summary_fields"].(map[string]any)
+	ujt, _ := summary["unified_job_template"].(map[string]any)
+	if ujt["unified_job_type"] != "workflow_approval" {
+		return 0, false
+	}
+	id, err := int64FromAPI(node["unified_job_template"])
+	return id, err == nil
+}
+
+// nodeApprovalTemplate returns the approval template summary of a workflow
+// node, nil when the node does not run an approval.
+func nodeApprovalTemplate(node map[string]any) map[string]any {
+	if _, ok := nodeApprovalTemplateID(node); !ok {
+		return nil
+	}
+	summary, _ := node["summary_fields"].(map[string]any)
+	ujt, _ := summary["unified_job_template"].(map[string]any)
+	return ujt
+}
@@ -0,0 +1,250 @@
+package framework_test
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+func newApprovalTemplateResource(t *testing.T, f *workflowAWX) (*framework.ApprovalTemplateResource, schema.Schema) {
+	t.Helper()
+	r := framework.NewApprovalTemplateResource("workflow_job_template_node_approval_template", "/api/v2/workflow_job_template_nodes/", "/api/v2/workflow_approval_templates/").(*framework.ApprovalTemplateResource)
+	r.Client = f.requester()
+	return r, resourceSchema(t, r)
+}
+
+func approvalTemplateValue(t *testing.T, s schema.Schema, model framework.ApprovalTemplateModel) tftypes.Value {
+	t.Helper()
+	state := tfsdk.State{Schema: s}
+	require.False(t, state.Set(context.Background(), &model).HasError())
+	return state.Raw
+}
+
+func approvalTemplateModel(id int64, name string, timeout int64) framework.ApprovalTemplateModel {
+	m := framework.ApprovalTemplateModel{
+		NodeID:      types.Int64Value(1),
+		ID:          types.Int64Unknown(),
+		Name:        types.StringValue(name),
+		Description: types.StringValue(""),
+		Timeout:     types.Int64Value(timeout),
+	}
+	if id != 0 {
+		m.ID = types.Int64Value(id)
+	}
+	return m
+}
+
+func TestApprovalTemplateResource_Create(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		node      map[string]any
+		approvals map[int64]map[string]any
+		wantCalls []string
+		wantID    int64
+		wantError string
+	}{
+		{
+			name:      "node without a unified job template",
+			node:      map[string]any{"id": 1, "identifier": "approve"},
+			wantCalls: []string{"approve approval Go live?"},
+			wantID:    100,
+		},
+		{
+			name:      "node that already runs an approval",
+			node:      map[string]any{"id": 1, "identifier": "approve", "unified_job_template": 50},
+			approvals: map[int64]map[string]any{50: {"id": 50, "name": "Old", "timeout": 0}},
+			wantCalls: []string{"update approval 50"},
+			wantID:    50,
+		},
+		{
+			name:      "node that runs a job",
+			node:      map[string]any{"id": 1, "identifier": "deploy", "unified_job_template": 12},
+			wantError: "Workflow node already runs a unified job template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newWorkflowAWX(t, tt.node)
+			maps.Copy(fake.approvals, tt.approvals)
+			r, s := newApprovalTemplateResource(t, fake)
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: nullValue(s)}}
+			r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: approvalTemplateValue(t, s, approvalTemplateModel(0, "Go live?", 600))}}, resp)
+			if tt.wantError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+				assert.Empty(t, fake.calls)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.Equal(t, tt.wantCalls, fake.calls)
+
+			var got framework.ApprovalTemplateModel
+			require.False(t, resp.State.Get(ctx, &got).HasError())
+			assert.Equal(t, approvalTemplateModel(tt.wantID, "Go live?", 600), got)
+			assert.Equal(t, "Go live?", fake.approvals[tt.wantID]["name"])
+			assert.EqualValues(t, 600, fake.approvals[tt.wantID]["timeout"])
+		})
+	}
+}
+
+func TestApprovalTemplateResource_Read(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("refreshes the approval template", func(t *testing.T) {
+		fake := newWorkflowAWX(t, map[string]any{"id": 1, "identifier": "approve", "unified_job_template": 50})
+		fake.approvals[50] = map[string]any{"id": 50, "name": "Changed", "description": "by hand", "timeout": 60}
+		r, s := newApprovalTemplateResource(t, fake)
+
+		state := tfsdk.State{Schema: s, Raw: approvalTemplateValue(t, s, approvalTemplateModel(50, "Go live?", 0))}
+		resp := &resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, resp)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+		var got framework.ApprovalTemplateModel
+		require.False(t, resp.State.Get(ctx, &got).HasError())
+		want := approvalTemplateModel(50, "Changed", 60)
+		want.Description = types.StringValue("by hand")
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("node no longer runs an approval", func(t *testing.T) {
+		fake := newWorkflowAWX(t, map[string]any{"id": 1, "identifier": "approve", "unified_job_template": 12})
+		r, s := newApprovalTemplateResource(t, fake)
+
+		state := tfsdk.State{Schema: s, Raw: approvalTemplateValue(t, s, approvalTemplateModel(50, "Go live?", 0))}
+		resp := &resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, resp)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.True(t, resp.State.Raw.IsNull())
+	})
+
+	t.Run("node was deleted", func(t *testing.T) {
+		r, s := newApprovalTemplateResource(t, newWorkflowAWX(t))
+
+		state := tfsdk.State{Schema: s, Raw: approvalTemplateValue(t, s, approvalTemplateModel(50, "Go live?", 0))}
+		resp := &resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, resp)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.True(t, resp.State.Raw.IsNull())
+	})
+}
+
+func TestApprovalTemplateResource_UpdateDelete(t *testing.T) {
+	ctx := context.Background()
+	fake := newWorkflowAWX(t, map[string]any{"id": 1, "identifier": "approve", "unified_job_template": 50})
+	fake.approvals[50] = map[string]any{"id": 50, "name": "Go live?", "timeout": 0}
+	r, s := newApprovalTemplateResource(t, fake)
+
+	state := tfsdk.State{Schema: s, Raw: approvalTemplateValue(t, s, approvalTemplateModel(50, "Go live?", 0))}
+	plan := tfsdk.Plan{Schema: s, Raw: approvalTemplateValue(t, s, approvalTemplateModel(0, "Ship it?", 300))}
+	updateResp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, updateResp)
+	require.False(t, updateResp.Diagnostics.HasError(), "%v", updateResp.Diagnostics)
+	assert.Equal(t, "Ship it?", fake.approvals[50]["name"])
+
+	var got framework.ApprovalTemplateModel
+	require.False(t, updateResp.State.Get(ctx, &got).HasError())
+	assert.Equal(t, approvalTemplateModel(50, "Ship it?", 300), got)
+
+	deleteResp := &resource.DeleteResponse{State: updateResp.State}
+	r.Delete(ctx, resource.DeleteRequest{State: updateResp.State}, deleteResp)
+	require.False(t, deleteResp.Diagnostics.HasError(), "%v", deleteResp.Diagnostics)
+	assert.Equal(t, []string{"update approval 50", "delete approval 50"}, fake.calls)
+	assert.Nil(t, fake.nodes[1]["unified_job_template"], "deleting the approval template clears the node")
+}
+
+func TestApprovalTemplateResource_ModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		node      map[string]any
+		nodeID    types.Int64
+		wantError string
+	}{
+		{
+			name:   "node without a unified job template",
+			node:   map[string]any{"id": 1, "identifier": "approve"},
+			nodeID: types.Int64Value(1),
+		},
+		{
+			name:      "node that runs a job",
+			node:      map[string]any{"id": 1, "identifier": "deploy", "unified_job_template": 12},
+			nodeID:    types.Int64Value(1),
+			wantError: "Workflow node already runs a unified job template",
+		},
+		{
+			name:   "node created in the same apply",
+			node:   map[string]any{"id": 1, "identifier": "deploy", "unified_job_template": 12},
+			nodeID: types.Int64Unknown(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newWorkflowAWX(t, tt.node)
+			r, s := newApprovalTemplateResource(t, fake)
+
+			model := approvalTemplateModel(0, "Go live?", 0)
+			model.NodeID = tt.nodeID
+			plan := tfsdk.Plan{Schema: s, Raw: approvalTemplateValue(t, s, model)}
+			state := tfsdk.State{Schema: s, Raw: nullValue(s)}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+			if tt.wantError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		})
+	}
+}
+
+func TestValidateNodeUnifiedJobTemplate(t *testing.T) {
+	ctx := context.Background()
+	fake := newWorkflowAWX(t,
+		map[string]any{"id": 1, "identifier": "approve", "unified_job_template": 50},
+		map[string]any{"id": 2, "identifier": "deploy", "unified_job_template": 12},
+	)
+	fake.approvals[50] = map[string]any{"id": 50, "name": "Go live?", "timeout": 0}
+	c := fake.requester()
+	endpoint := "/api/v2/workflow_job_template_nodes/"
+
+	tests := []struct {
+		name      string
+		nodeID    types.Int64
+		ujt       types.Int64
+		wantError bool
+	}{
+		{name: "approval node keeps its approval template", nodeID: types.Int64Value(1), ujt: types.Int64Value(50)},
+		{name: "approval node set to run a job", nodeID: types.Int64Value(1), ujt: types.Int64Value(12), wantError: true},
+		{name: "job node set to run another job", nodeID: types.Int64Value(2), ujt: types.Int64Value(13)},
+		{name: "new node", nodeID: types.Int64Unknown(), ujt: types.Int64Value(12)},
+		{name: "deleted node", nodeID: types.Int64Value(3), ujt: types.Int64Value(12)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diags := framework.ValidateNodeUnifiedJobTemplate(ctx, c, endpoint, tt.nodeID, tt.ujt)
+			if !tt.wantError {
+				require.False(t, diags.HasError(), "%v", diags)
+				return
+			}
+			require.True(t, diags.HasError())
+			assert.Equal(t, "Workflow node runs an approval", diags.Errors()[0].Summary())
+		})
+	}
+	assert.Empty(t, fake.calls)
+}
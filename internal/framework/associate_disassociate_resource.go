@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/ilijamt/terraform-provider-awx/internal/models"
 )
@@ -52,7 +53,18 @@ type AssociateDisassociateConfig struct {
 // optionValues returns the OneOf set for the option attribute, keyed by
 // AssociateType. Returns nil for non-notification flavors.
 func (c AssociateDisassociateConfig) optionValues() []string {
-	switch c.AssociateType {
+	return associateOptionValues(c.AssociateType)
+}
+
+func (c AssociateDisassociateConfig) hasOption() bool {
+	return associateOptionValues(c.AssociateType) != nil
+}
+
+// associateOptionValues returns the notification options accepted by the
+// notification_templates_%s endpoints of associateType, nil for the other
+// association types.
+func associateOptionValues(associateType string) []string {
+	switch associateType {
 	case "notification_job_template":
 		return []string{"started", "success", "error"}
 	case "notification_job_workflow_template":
@@ -62,8 +74,14 @@ func (c AssociateDisassociateConfig) optionValues() []string {
 	}
 }
 
-func (c AssociateDisassociateConfig) hasOption() bool {
-	return c.AssociateType == "notification_job_template" || c.AssociateType == "notification_job_workflow_template"
+// associateOptionPath returns the notification_templates_%s suffix of an
+// option. Workflow approval notifications live under
+// notification_templates_approvals, the other options are used as is.
+func associateOptionPath(option string) string {
+	if option == "approval" {
+		return "approvals"
+	}
+	return option
 }
 
 var (
@@ -214,8 +232,32 @@ func (o *AssociateDisassociateResource) Delete(ctx context.Context, request reso
 	o.sendAssoc(ctx, parentID, childID, option, true, &response.Diagnostics)
 }
 
-// Read is a no-op — these resources hold no AWX-side state worth refreshing.
-func (o *AssociateDisassociateResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+// Read lists the parent's sub-collection and drops the resource from state
+// when the child, or the parent itself, is no longer there, so Terraform
+// re-associates it on the next apply.
+func (o *AssociateDisassociateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	parentID, childID, option, ok := o.readIDs(ctx, &request.State, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	items, found, d := ListAllAllowNotFound(ctx, o.Client, o.endpoint(parentID, option), o.cfg.ParentName, 0)
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	if found {
+		for _, item := range items {
+			if id, err := int64FromAPI(item["id"]); err == nil && id == childID {
+				return
+			}
+		}
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("[%s/read] Association no longer exists", o.cfg.ParentName), map[string]any{
+		o.cfg.ParentIDAttr: parentID,
+		o.cfg.ChildIDAttr:  childID,
+	})
+	response.State.RemoveResource(ctx)
 }
 
 // Update is a no-op — every attribute uses RequiresReplace.
@@ -248,22 +290,34 @@ func (o *AssociateDisassociateResource) readIDs(ctx context.Context, src attribu
 	return parentID.ValueInt64(), childID.ValueInt64(), option, true
 }
 
-// sendAssoc builds and sends the associate/disassociate POST.
-func (o *AssociateDisassociateResource) sendAssoc(ctx context.Context, parentID, childID int64, option string, disassociate bool, diags *diag.Diagnostics) bool {
+// endpoint returns the parent's sub-collection, e.g.
+// /api/v2/job_templates/1/credentials/ or, for the notification flavors,
+// /api/v2/job_templates/1/notification_templates_success/.
+func (o *AssociateDisassociateResource) endpoint(parentID int64, option string) string {
 	args := []any{parentID}
 	if o.cfg.hasOption() {
-		args = append(args, option)
+		args = append(args, associateOptionPath(option))
 	}
-	endpoint := p.Clean(fmt.Sprintf(o.Endpoint, args...)) + "/"
+	return p.Clean(fmt.Sprintf(o.Endpoint, args...)) + "/"
+}
 
+// sendAssoc builds and sends the associate/disassociate POST.
+func (o *AssociateDisassociateResource) sendAssoc(ctx context.Context, parentID, childID int64, option string, disassociate bool, diags *diag.Diagnostics) bool {
+	d := sendAssociation(ctx, o.Client, o.endpoint(parentID, option), childID, disassociate, o.cfg.ParentName)
+	return !DiagnosticsHasError(diags, d...)
+}
+
+// sendAssociation POSTs {"id": childID} to a sub-collection endpoint, adding
+// "disassociate": true to detach the child instead.
+func sendAssociation(ctx context.Context, r Requester, endpoint string, childID int64, disassociate bool, resourceName string) diag.Diagnostics {
 	op := "associate"
 	if disassociate {
 		op = "disassociate"
 	}
 
 	body := models.AssociateDisassociateRequestModel{ID: childID, Disassociate: disassociate}
-	_, d := CreateUpdateRequest(ctx, o.Client, http.MethodPost, endpoint, body, o.cfg.ParentName, op)
-	return !DiagnosticsHasError(diags, d...)
+	_, d := CreateUpdateRequest(ctx, r, http.MethodPost, endpoint, body, resourceName, op)
+	return d
 }
 
 // Compile-time check that tfsdk.Plan and tfsdk.State satisfy our attributeReader.
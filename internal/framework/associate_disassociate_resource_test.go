@@ -2,12 +2,17 @@ package framework_test
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
 	"github.com/ilijamt/terraform-provider-awx/internal/framework"
 )
 
@@ -125,3 +130,134 @@ func TestAssociateDisassociateResource_Metadata(t *testing.T) {
 	mdProvider.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "awx"}, resp)
 	assert.Equal(t, "awx_host_associate_group", resp.TypeName)
 }
+
+func readAssociation(t *testing.T, cfg framework.AssociateDisassociateConfig, option string, handler http.HandlerFunc) *resource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+	svr := httptest.NewServer(handler)
+	t.Cleanup(svr.Close)
+
+	r := framework.NewAssociateDisassociateResource(cfg).(*framework.AssociateDisassociateResource)
+	r.Client = client.NewClientWithBasicAuth("admin", "admin", svr.URL, "test", true, nil, client.RetryConfig{})
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	values := map[string]tftypes.Value{
+		cfg.ParentIDAttr: tftypes.NewValue(tftypes.Number, 4),
+		cfg.ChildIDAttr:  tftypes.NewValue(tftypes.Number, 9),
+	}
+	if option != "" {
+		values["option"] = tftypes.NewValue(tftypes.String, option)
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), values)}
+
+	resp := &resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, resp)
+	return resp
+}
+
+func TestAssociateDisassociateResource_Read(t *testing.T) {
+	credentials := framework.AssociateDisassociateConfig{
+		TypeName: "job_template_associate_credential", Endpoint: "/api/v2/job_templates/%d/credentials/",
+		ParentName: "JobTemplate", ParentIDAttr: "job_template_id",
+		ChildName: "Credential", ChildIDAttr: "credential_id",
+	}
+	notifications := framework.AssociateDisassociateConfig{
+		TypeName: "job_template_associate_notification_template", Endpoint: "/api/v2/job_templates/%d/notification_templates_%s/",
+		ParentName: "JobTemplate", ParentIDAttr: "job_template_id",
+		ChildName: "NotificationTemplate", ChildIDAttr: "notification_template_id",
+		AssociateType: "notification_job_template",
+	}
+	workflowNotifications := framework.AssociateDisassociateConfig{
+		TypeName: "workflow_job_template_associate_notification_template", Endpoint: "/api/v2/workflow_job_templates/%d/notification_templates_%s/",
+		ParentName: "WorkflowJobTemplate", ParentIDAttr: "workflow_job_template_id",
+		ChildName: "NotificationTemplate", ChildIDAttr: "notification_template_id",
+		AssociateType: "notification_job_workflow_template",
+	}
+
+	tests := []struct {
+		name        string
+		cfg         framework.AssociateDisassociateConfig
+		option      string
+		pages       map[string]string
+		status      int
+		wantRemoved bool
+		wantError   bool
+		wantPaths   []string
+	}{
+		{
+			name: "child on a later page is kept",
+			cfg:  credentials,
+			pages: map[string]string{
+				"":  `{"next":"/api/v2/job_templates/4/credentials/?page=2&page_size=200","results":[{"id":1},{"id":2}]}`,
+				"2": `{"next":null,"results":[{"id":9}]}`,
+			},
+			wantPaths: []string{"/api/v2/job_templates/4/credentials/", "/api/v2/job_templates/4/credentials/"},
+		},
+		{
+			name:        "missing child is removed from state",
+			cfg:         credentials,
+			pages:       map[string]string{"": `{"next":null,"results":[{"id":1}]}`},
+			wantRemoved: true,
+			wantPaths:   []string{"/api/v2/job_templates/4/credentials/"},
+		},
+		{
+			name:        "deleted parent is removed from state",
+			cfg:         credentials,
+			status:      http.StatusNotFound,
+			wantRemoved: true,
+			wantPaths:   []string{"/api/v2/job_templates/4/credentials/"},
+		},
+		{
+			name:      "api errors fail the read",
+			cfg:       credentials,
+			status:    http.StatusInternalServerError,
+			wantError: true,
+			wantPaths: []string{"/api/v2/job_templates/4/credentials/"},
+		},
+		{
+			name:      "notification flavor lists the option collection",
+			cfg:       notifications,
+			option:    "success",
+			pages:     map[string]string{"": `{"next":null,"results":[{"id":9}]}`},
+			wantPaths: []string{"/api/v2/job_templates/4/notification_templates_success/"},
+		},
+		{
+			name:        "notification missing from the option collection",
+			cfg:         notifications,
+			option:      "error",
+			pages:       map[string]string{"": `{"next":null,"results":[]}`},
+			wantRemoved: true,
+			wantPaths:   []string{"/api/v2/job_templates/4/notification_templates_error/"},
+		},
+		{
+			name:      "approval notifications list the approvals collection",
+			cfg:       workflowNotifications,
+			option:    "approval",
+			pages:     map[string]string{"": `{"next":null,"results":[{"id":9}]}`},
+			wantPaths: []string{"/api/v2/workflow_job_templates/4/notification_templates_approvals/"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var paths []string
+			resp := readAssociation(t, tt.cfg, tt.option, func(w http.ResponseWriter, r *http.Request) {
+				paths = append(paths, r.URL.Path)
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+					return
+				}
+				_, _ = w.Write([]byte(tt.pages[r.URL.Query().Get("page")]))
+			})
+
+			assert.Equal(t, tt.wantPaths, paths)
+			if tt.wantError {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.Equal(t, tt.wantRemoved, resp.State.Raw.IsNull())
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	p "path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// AssociateSetConfig configures an AssociateSetResource. It describes the
+// same parent ↔ child sub-collection as AssociateDisassociateConfig, but the
+// resource owns the whole collection instead of a single pair.
+type AssociateSetConfig struct {
+	// TypeName is the Terraform type name suffix (e.g. "job_template_credentials").
+	TypeName string
+	// Endpoint is the API path with %d for the parent ID, plus optional %s
+	// for the option flavor (notification templates).
+	Endpoint string
+	// ParentName is the human-readable parent resource name (e.g. "JobTemplate").
+	ParentName string
+	// ParentIDAttr is the tfsdk attribute name for the parent ID (e.g. "job_template_id").
+	ParentIDAttr string
+	// ChildName is the human-readable child resource name (e.g. "Credential").
+	ChildName string
+	// ChildIDsAttr is the tfsdk attribute name for the set of child IDs (e.g. "credential_ids").
+	ChildIDsAttr string
+	// AssociateType is one of "" (default), "notification_job_template", or
+	// "notification_job_workflow_template", see AssociateDisassociateConfig.
+	AssociateType string
+	// Ordered makes the child IDs a list for sub-collections where AWX keeps
+	// the association order, e.g. the Galaxy credentials of an organization.
+	Ordered bool
+	// Deprecated marks the resource as deprecated.
+	Deprecated bool
+}
+
+func (c AssociateSetConfig) hasOption() bool {
+	return associateOptionValues(c.AssociateType) != nil
+}
+
+var (
+	_ resource.Resource                = (*AssociateSetResource)(nil)
+	_ resource.ResourceWithConfigure   = (*AssociateSetResource)(nil)
+	_ resource.ResourceWithImportState = (*AssociateSetResource)(nil)
+)
+
+// AssociateSetResource is the generic implementation backing the
+// authoritative association resources such as awx_job_template_credentials.
+// The configured set is the complete list of children: Read reports every
+// child attached in AWX, so children attached out of band show up as drift
+// and are disassociated on the next apply.
+type AssociateSetResource struct {
+	ResourceBase
+	cfg AssociateSetConfig
+}
+
+// NewAssociateSetResource constructs an AssociateSetResource.
+func NewAssociateSetResource(cfg AssociateSetConfig) resource.Resource {
+	return &AssociateSetResource{
+		ResourceBase: ResourceBase{
+			ProviderBase: ProviderBase{TypeName: cfg.TypeName, Endpoint: cfg.Endpoint},
+		},
+		cfg: cfg,
+	}
+}
+
+// Schema defines the schema for the resource.
+func (o *AssociateSetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attrs := map[string]schema.Attribute{
+		o.cfg.ParentIDAttr: schema.Int64Attribute{
+			Description: fmt.Sprintf("Database ID for this %s.", o.cfg.ParentName),
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+	}
+	description := fmt.Sprintf("Database IDs of every %s associated with the %s. Any other %s associated outside of Terraform is disassociated.",
+		strings.ToLower(o.cfg.ChildName), o.cfg.ParentName, strings.ToLower(o.cfg.ChildName))
+	if o.cfg.Ordered {
+		attrs[o.cfg.ChildIDsAttr] = schema.ListAttribute{
+			Description: description + " The order is kept, changing it re-associates the affected entries.",
+			ElementType: types.Int64Type,
+			Required:    true,
+			Validators: []validator.List{
+				listvalidator.UniqueValues(),
+			},
+		}
+	} else {
+		attrs[o.cfg.ChildIDsAttr] = schema.SetAttribute{
+			Description: description,
+			ElementType: types.Int64Type,
+			Required:    true,
+		}
+	}
+	if o.cfg.hasOption() {
+		attrs["option"] = schema.StringAttribute{
+			Description: "Notification Option",
+			Required:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+			Validators: []validator.String{
+				stringvalidator.OneOf(associateOptionValues(o.cfg.AssociateType)...),
+			},
+		}
+	}
+
+	s := schema.Schema{Attributes: attrs}
+	if o.cfg.Deprecated {
+		s.DeprecationMessage = "This resource has been deprecated and will be removed in a future release."
+	}
+	resp.Schema = s
+}
+
+// ImportState imports the children of a parent as <parent_id>, or as
+// <parent_id>/<option> for the notification flavors. Read fills in the IDs.
+func (o *AssociateSetResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts := strings.Split(request.ID, "/")
+	format := fmt.Sprintf("<%s>", o.cfg.ParentIDAttr)
+	if o.cfg.hasOption() {
+		format = fmt.Sprintf("<%s>/<option>", o.cfg.ParentIDAttr)
+	}
+	if (o.cfg.hasOption() && len(parts) != 2) || (!o.cfg.hasOption() && len(parts) != 1) {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("Unable to import state for %s associations, invalid format.", o.cfg.ParentName),
+			fmt.Sprintf("requires the identifier to be set to %s, currently set to %s", format, request.ID),
+		)
+		return
+	}
+
+	parentID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("Unable to parse '%v' as an int64 number, please provide the %s for the %s associations.", request.ID, o.cfg.ParentIDAttr, o.cfg.ParentName),
+			err.Error(),
+		)
+		return
+	}
+
+	if DiagnosticsHasError(&response.Diagnostics, response.State.SetAttribute(ctx, path.Root(o.cfg.ParentIDAttr), types.Int64Value(parentID))...) {
+		return
+	}
+	if o.cfg.hasOption() {
+		if !slices.Contains(associateOptionValues(o.cfg.AssociateType), parts[1]) {
+			response.Diagnostics.AddError(
+				fmt.Sprintf("Unable to import state for %s associations, invalid option.", o.cfg.ParentName),
+				fmt.Sprintf("option must be one of %s, currently set to %s", strings.Join(associateOptionValues(o.cfg.AssociateType), ", "), parts[1]),
+			)
+			return
+		}
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("option"), types.StringValue(parts[1]))...)
+	}
+}
+
+// Create makes the children of the parent match the plan. Children that are
+// already associated are left alone and any other child is disassociated.
+func (o *AssociateSetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	parentID, desired, option, ok := o.readIDs(ctx, &request.Plan, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	current, _, d := o.list(ctx, parentID, option)
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	if !o.reconcile(ctx, parentID, option, current, desired, &response.Diagnostics) {
+		return
+	}
+	o.setState(ctx, &response.State, parentID, option, desired, &response.Diagnostics)
+}
+
+// Read replaces the child IDs in state with the children associated in AWX,
+// and drops the resource from state when the parent no longer exists.
+func (o *AssociateSetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	parentID, _, option, ok := o.readIDs(ctx, &request.State, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	current, found, d := o.list(ctx, parentID, option)
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	if !found {
+		tflog.Debug(ctx, fmt.Sprintf("[%s/read] Parent no longer exists", o.cfg.ParentName), map[string]any{
+			o.cfg.ParentIDAttr: parentID,
+		})
+		response.State.RemoveResource(ctx)
+		return
+	}
+	o.setState(ctx, &response.State, parentID, option, current, &response.Diagnostics)
+}
+
+// Update associates and disassociates only the children that differ between
+// state and plan.
+func (o *AssociateSetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	parentID, desired, option, ok := o.readIDs(ctx, &request.Plan, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	_, current, _, ok := o.readIDs(ctx, &request.State, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	if !o.reconcile(ctx, parentID, option, current, desired, &response.Diagnostics) {
+		return
+	}
+	o.setState(ctx, &response.State, parentID, option, desired, &response.Diagnostics)
+}
+
+// Delete disassociates every child in state.
+func (o *AssociateSetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	parentID, current, option, ok := o.readIDs(ctx, &request.State, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	o.reconcile(ctx, parentID, option, current, nil, &response.Diagnostics)
+}
+
+// readIDs pulls the parent ID, child IDs (and option, when applicable) from a
+// plan or state. The child IDs are sorted unless the resource is Ordered.
+func (o *AssociateSetResource) readIDs(ctx context.Context, src attributeReader, diags *diag.Diagnostics) (int64, []int64, string, bool) {
+	var parentID types.Int64
+	if DiagnosticsHasError(diags, src.GetAttribute(ctx, path.Root(o.cfg.ParentIDAttr), &parentID)...) {
+		return 0, nil, "", false
+	}
+
+	// The IDs are null right after an import, Read fills them in.
+	var children attr.Value
+	if DiagnosticsHasError(diags, src.GetAttribute(ctx, path.Root(o.cfg.ChildIDsAttr), &children)...) {
+		return 0, nil, "", false
+	}
+	var childIDs []int64
+	if !children.IsNull() && !children.IsUnknown() {
+		var d diag.Diagnostics
+		switch v := children.(type) {
+		case types.List:
+			d = v.ElementsAs(ctx, &childIDs, false)
+		case types.Set:
+			d = v.ElementsAs(ctx, &childIDs, false)
+		}
+		if DiagnosticsHasError(diags, d...) {
+			return 0, nil, "", false
+		}
+	}
+	if !o.cfg.Ordered {
+		slices.Sort(childIDs)
+	}
+
+	var option string
+	if o.cfg.hasOption() {
+		var optionVal types.String
+		if DiagnosticsHasError(diags, src.GetAttribute(ctx, path.Root("option"), &optionVal)...) {
+			return 0, nil, "", false
+		}
+		option = optionVal.ValueString()
+	}
+	return parentID.ValueInt64(), childIDs, option, true
+}
+
+func (o *AssociateSetResource) setState(ctx context.Context, state attributeWriter, parentID int64, option string, childIDs []int64, diags *diag.Diagnostics) {
+	var children attr.Value
+	var d diag.Diagnostics
+	if o.cfg.Ordered {
+		children, d = types.ListValueFrom(ctx, types.Int64Type, childIDs)
+	} else {
+		children, d = types.SetValueFrom(ctx, types.Int64Type, childIDs)
+	}
+	if DiagnosticsHasError(diags, d...) {
+		return
+	}
+	if DiagnosticsHasError(diags, state.SetAttribute(ctx, path.Root(o.cfg.ParentIDAttr), types.Int64Value(parentID))...) {
+		return
+	}
+	if DiagnosticsHasError(diags, state.SetAttribute(ctx, path.Root(o.cfg.ChildIDsAttr), children)...) {
+		return
+	}
+	if o.cfg.hasOption() {
+		diags.Append(state.SetAttribute(ctx, path.Root("option"), types.StringValue(option))...)
+	}
+}
+
+// list returns the IDs of the children currently associated with the parent,
+// sorted, or in AWX order when the resource is Ordered. found is false when
+// the parent no longer exists.
+func (o *AssociateSetResource) list(ctx context.Context, parentID int64, option string) ([]int64, bool, diag.Diagnostics) {
+	items, found, diags := ListAllAllowNotFound(ctx, o.Client, o.endpoint(parentID, option), o.cfg.ParentName, 0)
+	if diags.HasError() || !found {
+		return nil, found, diags
+	}
+
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		id, err := int64FromAPI(item["id"])
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Unexpected response while listing %s", o.cfg.ParentName), err.Error())
+			return nil, false, diags
+		}
+		ids = append(ids, id)
+	}
+	if !o.cfg.Ordered {
+		slices.Sort(ids)
+	}
+	return ids, true, diags
+}
+
+// reconcile disassociates the children in current that are not in desired
+// and then associates the ones missing from current.
+//
+// AWX appends an associated child to the end of an ordered sub-collection, so
+// for Ordered resources everything after the longest common prefix of current
+// and desired is disassociated and then re-associated in the desired order.
+func (o *AssociateSetResource) reconcile(ctx context.Context, parentID int64, option string, current, desired []int64, diags *diag.Diagnostics) bool {
+	endpoint := o.endpoint(parentID, option)
+	if o.cfg.Ordered {
+		prefix := 0
+		for prefix < len(current) && prefix < len(desired) && current[prefix] == desired[prefix] {
+			prefix++
+		}
+		current, desired = current[prefix:], desired[prefix:]
+		for _, id := range current {
+			if DiagnosticsHasError(diags, sendAssociation(ctx, o.Client, endpoint, id, true, o.cfg.ParentName)...) {
+				return false
+			}
+		}
+		for _, id := range desired {
+			if DiagnosticsHasError(diags, sendAssociation(ctx, o.Client, endpoint, id, false, o.cfg.ParentName)...) {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, id := range current {
+		if slices.Contains(desired, id) {
+			continue
+		}
+		if DiagnosticsHasError(diags, sendAssociation(ctx, o.Client, endpoint, id, true, o.cfg.ParentName)...) {
+			return false
+		}
+	}
+	for _, id := range desired {
+		if slices.Contains(current, id) {
+			continue
+		}
+		if DiagnosticsHasError(diags, sendAssociation(ctx, o.Client, endpoint, id, false, o.cfg.ParentName)...) {
+			return false
+		}
+	}
+	return true
+}
+
+func (o *AssociateSetResource) endpoint(parentID int64, option string) string {
+	args := []any{parentID}
+	if o.cfg.hasOption() {
+		args = append(args, associateOptionPath(option))
+	}
+	return p.Clean(fmt.Sprintf(o.Endpoint, args...)) + "/"
+}
+
+// attributeWriter is the SetAttribute interface shared by the state of every
+// response type.
+type attributeWriter interface {
+	SetAttribute(ctx context.Context, p path.Path, val any) diag.Diagnostics
+}
@@ -0,0 +1,393 @@
+package framework_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+var jobTemplateCredentials = framework.AssociateSetConfig{
+	TypeName: "job_template_credentials", Endpoint: "/api/v2/job_templates/%d/credentials/",
+	ParentName: "JobTemplate", ParentIDAttr: "job_template_id",
+	ChildName: "Credential", ChildIDsAttr: "credential_ids",
+}
+
+var organizationGalaxyCredentials = framework.AssociateSetConfig{
+	TypeName: "organization_galaxy_credentials", Endpoint: "/api/v2/organizations/%d/galaxy_credentials/",
+	ParentName: "Organization", ParentIDAttr: "organization_id",
+	ChildName: "GalaxyCredential", ChildIDsAttr: "credential_ids",
+	Ordered: true,
+}
+
+var jobTemplateNotificationTemplates = framework.AssociateSetConfig{
+	TypeName: "job_template_notification_templates", Endpoint: "/api/v2/job_templates/%d/notification_templates_%s/",
+	ParentName: "JobTemplate", ParentIDAttr: "job_template_id",
+	ChildName: "NotificationTemplate", ChildIDsAttr: "notification_template_ids",
+	AssociateType: "notification_job_template",
+}
+
+// subCollectionAWX is a single AWX sub-collection that serves its children
+// one per page and records every association as "+<id>" or "-<id>".
+type subCollectionAWX struct {
+	*fakeAWX
+	children []int64
+}
+
+func newSubCollectionAWX(t *testing.T, path string, children ...int64) *subCollectionAWX {
+	f := &subCollectionAWX{fakeAWX: newFakeAWX(t), children: children}
+	f.handle("GET "+path, func(w http.ResponseWriter, r *http.Request) {
+		page := 1
+		_, _ = fmt.Sscan(r.URL.Query().Get("page"), &page)
+		out := map[string]any{"count": len(f.children), "next": nil, "results": []any{}}
+		if page <= len(f.children) {
+			out["results"] = []any{map[string]any{"id": f.children[page-1]}}
+		}
+		if page < len(f.children) {
+			out["next"] = fmt.Sprintf("%s?page=%d&page_size=200", path, page+1)
+		}
+		writeJSON(w, out)
+	})
+	f.handle("POST "+path, func(w http.ResponseWriter, r *http.Request) {
+		id, op := association(t, r)
+		f.record("%s%d", op, id)
+		if op == "-" {
+			f.children = slices.DeleteFunc(f.children, func(child int64) bool { return child == id })
+		} else {
+			f.children = append(f.children, id)
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return f
+}
+
+func newAssociateSetResource(t *testing.T, cfg framework.AssociateSetConfig, f *subCollectionAWX) (*framework.AssociateSetResource, schema.Schema) {
+	t.Helper()
+	r := framework.NewAssociateSetResource(cfg).(*framework.AssociateSetResource)
+	r.Client = f.requester()
+	return r, resourceSchema(t, r)
+}
+
+// associateSetValue builds the value for parent 4 with the given children,
+// null when ids is nil as after an import.
+func associateSetValue(t *testing.T, s schema.Schema, cfg framework.AssociateSetConfig, option string, ids []int64) tftypes.Value {
+	t.Helper()
+	elements := make([]attr.Value, 0, len(ids))
+	for _, id := range ids {
+		elements = append(elements, types.Int64Value(id))
+	}
+	var children attr.Value = types.SetValueMust(types.Int64Type, elements)
+	if cfg.Ordered {
+		children = types.ListValueMust(types.Int64Type, elements)
+	}
+	if ids == nil {
+		children = types.SetNull(types.Int64Type)
+		if cfg.Ordered {
+			children = types.ListNull(types.Int64Type)
+		}
+	}
+	values := map[string]attr.Value{
+		cfg.ParentIDAttr: types.Int64Value(4),
+		cfg.ChildIDsAttr: children,
+	}
+	if option != "" {
+		values["option"] = types.StringValue(option)
+	}
+	return objectValue(t, s, values)
+}
+
+func associateSetChildren(t *testing.T, state tfsdk.State, cfg framework.AssociateSetConfig) []int64 {
+	t.Helper()
+	var ids []int64
+	require.False(t, state.GetAttribute(context.Background(), path.Root(cfg.ChildIDsAttr), &ids).HasError())
+	if !cfg.Ordered {
+		slices.Sort(ids)
+	}
+	return ids
+}
+
+func TestAssociateSetResource_Schema(t *testing.T) {
+	_, s := newAssociateSetResource(t, jobTemplateCredentials, newSubCollectionAWX(t, "/"))
+	require.Contains(t, s.Attributes, "job_template_id")
+	ids, ok := s.Attributes["credential_ids"].(schema.SetAttribute)
+	require.True(t, ok, "credential_ids must be a set")
+	assert.True(t, ids.IsRequired())
+	assert.Equal(t, types.Int64Type, ids.ElementType)
+	assert.NotContains(t, s.Attributes, "option")
+
+	_, s = newAssociateSetResource(t, organizationGalaxyCredentials, newSubCollectionAWX(t, "/"))
+	ordered, ok := s.Attributes["credential_ids"].(schema.ListAttribute)
+	require.True(t, ok, "ordered credential_ids must be a list")
+	assert.True(t, ordered.IsRequired())
+	assert.NotEmpty(t, ordered.Validators)
+
+	_, s = newAssociateSetResource(t, jobTemplateNotificationTemplates, newSubCollectionAWX(t, "/"))
+	assert.Contains(t, s.Attributes, "notification_template_ids")
+	assert.Contains(t, s.Attributes, "option")
+}
+
+func TestAssociateSetResource_Read(t *testing.T) {
+	tests := []struct {
+		name        string
+		cfg         framework.AssociateSetConfig
+		path        string
+		children    []int64
+		missing     bool
+		option      string
+		prior       []int64
+		want        []int64
+		wantRemoved bool
+	}{
+		{
+			name:     "reports every child across pages",
+			cfg:      jobTemplateCredentials,
+			path:     "/api/v2/job_templates/4/credentials/",
+			children: []int64{7, 1, 3},
+			prior:    []int64{1, 2},
+			want:     []int64{1, 3, 7},
+		},
+		{
+			name:     "after import",
+			cfg:      jobTemplateCredentials,
+			path:     "/api/v2/job_templates/4/credentials/",
+			children: []int64{2},
+			want:     []int64{2},
+		},
+		{
+			name:     "ordered keeps the AWX order, reordering is drift",
+			cfg:      organizationGalaxyCredentials,
+			path:     "/api/v2/organizations/4/galaxy_credentials/",
+			children: []int64{3, 1, 2},
+			prior:    []int64{1, 2, 3},
+			want:     []int64{3, 1, 2},
+		},
+		{
+			name:        "deleted parent is removed from state",
+			cfg:         jobTemplateCredentials,
+			path:        "/api/v2/job_templates/4/credentials/",
+			missing:     true,
+			prior:       []int64{1},
+			wantRemoved: true,
+		},
+		{
+			name:     "notification flavor reads the option collection",
+			cfg:      jobTemplateNotificationTemplates,
+			path:     "/api/v2/job_templates/4/notification_templates_error/",
+			children: []int64{8},
+			option:   "error",
+			want:     []int64{8},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newSubCollectionAWX(t, tt.path, tt.children...)
+			f.missing = tt.missing
+			r, s := newAssociateSetResource(t, tt.cfg, f)
+
+			state, diags := readResource(r, s, associateSetValue(t, s, tt.cfg, tt.option, tt.prior))
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Empty(t, f.calls)
+			if tt.wantRemoved {
+				assert.True(t, state.Raw.IsNull())
+				return
+			}
+			assert.Equal(t, tt.want, associateSetChildren(t, state, tt.cfg))
+		})
+	}
+}
+
+func TestAssociateSetResource_Apply(t *testing.T) {
+	workflowApprovalNotifications := framework.AssociateSetConfig{
+		TypeName: "workflow_job_template_notification_templates", Endpoint: "/api/v2/workflow_job_templates/%d/notification_templates_%s/",
+		ParentName: "WorkflowJobTemplate", ParentIDAttr: "workflow_job_template_id",
+		ChildName: "NotificationTemplate", ChildIDsAttr: "notification_template_ids",
+		AssociateType: "notification_job_workflow_template",
+	}
+	const galaxyCredentials = "/api/v2/organizations/4/galaxy_credentials/"
+
+	tests := []struct {
+		name      string
+		cfg       framework.AssociateSetConfig
+		path      string
+		option    string
+		children  []int64
+		create    bool
+		prior     []int64
+		plan      []int64
+		wantCalls []string
+	}{
+		{
+			name:      "create keeps associated children and removes the ones attached out of band",
+			cfg:       jobTemplateCredentials,
+			path:      "/api/v2/job_templates/4/credentials/",
+			children:  []int64{1, 5},
+			create:    true,
+			plan:      []int64{1, 2},
+			wantCalls: []string{"-5", "+2"},
+		},
+		{
+			name:      "create approval notifications",
+			cfg:       workflowApprovalNotifications,
+			path:      "/api/v2/workflow_job_templates/4/notification_templates_approvals/",
+			option:    "approval",
+			create:    true,
+			plan:      []int64{3},
+			wantCalls: []string{"+3"},
+		},
+		{
+			name:      "update",
+			cfg:       jobTemplateCredentials,
+			path:      "/api/v2/job_templates/4/credentials/",
+			children:  []int64{1, 2},
+			prior:     []int64{1, 2},
+			plan:      []int64{2, 3, 4},
+			wantCalls: []string{"-1", "+3", "+4"},
+		},
+		{
+			name:     "ordered unchanged",
+			cfg:      organizationGalaxyCredentials,
+			path:     galaxyCredentials,
+			children: []int64{1, 2, 3},
+			prior:    []int64{1, 2, 3},
+			plan:     []int64{1, 2, 3},
+		},
+		{
+			name:      "ordered append",
+			cfg:       organizationGalaxyCredentials,
+			path:      galaxyCredentials,
+			children:  []int64{1, 2},
+			prior:     []int64{1, 2},
+			plan:      []int64{1, 2, 3},
+			wantCalls: []string{"+3"},
+		},
+		{
+			name:      "ordered swap the tail",
+			cfg:       organizationGalaxyCredentials,
+			path:      galaxyCredentials,
+			children:  []int64{1, 2, 3},
+			prior:     []int64{1, 2, 3},
+			plan:      []int64{1, 3, 2},
+			wantCalls: []string{"-2", "-3", "+3", "+2"},
+		},
+		{
+			name:      "ordered move to the front",
+			cfg:       organizationGalaxyCredentials,
+			path:      galaxyCredentials,
+			children:  []int64{1, 2, 3},
+			prior:     []int64{1, 2, 3},
+			plan:      []int64{3, 1, 2},
+			wantCalls: []string{"-1", "-2", "-3", "+3", "+1", "+2"},
+		},
+		{
+			name:      "ordered remove from the middle",
+			cfg:       organizationGalaxyCredentials,
+			path:      galaxyCredentials,
+			children:  []int64{1, 2, 3},
+			prior:     []int64{1, 2, 3},
+			plan:      []int64{1, 3},
+			wantCalls: []string{"-2", "-3", "+3"},
+		},
+		{
+			name:      "ordered empty",
+			cfg:       organizationGalaxyCredentials,
+			path:      galaxyCredentials,
+			children:  []int64{1, 2},
+			prior:     []int64{1, 2},
+			plan:      []int64{},
+			wantCalls: []string{"-1", "-2"},
+		},
+		{
+			name:      "delete",
+			cfg:       jobTemplateNotificationTemplates,
+			path:      "/api/v2/job_templates/4/notification_templates_success/",
+			option:    "success",
+			children:  []int64{2, 6},
+			prior:     []int64{2, 6},
+			wantCalls: []string{"-2", "-6"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newSubCollectionAWX(t, tt.path, slices.Clone(tt.children)...)
+			r, s := newAssociateSetResource(t, tt.cfg, f)
+
+			prior, plan := associateSetValue(t, s, tt.cfg, tt.option, tt.prior), associateSetValue(t, s, tt.cfg, tt.option, tt.plan)
+			if tt.create {
+				prior = nullValue(s)
+			}
+			if tt.plan == nil {
+				plan = nullValue(s)
+			}
+			state, diags := applyResource(r, s, prior, plan)
+			require.False(t, diags.HasError(), "%v", diags)
+
+			assert.Equal(t, tt.wantCalls, f.calls)
+			want := slices.Clone(tt.plan)
+			if !tt.cfg.Ordered {
+				slices.Sort(want)
+				slices.Sort(f.children)
+			}
+			assert.True(t, slices.Equal(want, f.children), "AWX ends up with the planned children in order, got %v", f.children)
+			if tt.plan == nil {
+				return
+			}
+			assert.True(t, slices.Equal(want, associateSetChildren(t, state, tt.cfg)))
+			if tt.option != "" {
+				assert.Equal(t, tt.option, stateAttribute[types.String](t, state, "option").ValueString())
+			}
+		})
+	}
+}
+
+func TestAssociateSetResource_ImportState(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		cfg        framework.AssociateSetConfig
+		id         string
+		wantError  bool
+		wantOption string
+	}{
+		{name: "parent id", cfg: jobTemplateCredentials, id: "4"},
+		{name: "option is not accepted", cfg: jobTemplateCredentials, id: "4/success", wantError: true},
+		{name: "not a number", cfg: jobTemplateCredentials, id: "four", wantError: true},
+		{name: "notification with option", cfg: jobTemplateNotificationTemplates, id: "4/started", wantOption: "started"},
+		{name: "notification without option", cfg: jobTemplateNotificationTemplates, id: "4", wantError: true},
+		{name: "notification with unknown option", cfg: jobTemplateNotificationTemplates, id: "4/approval", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, s := newAssociateSetResource(t, tt.cfg, newSubCollectionAWX(t, "/"))
+			resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: nullValue(s)}}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, resp)
+			if tt.wantError {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var parentID types.Int64
+			require.False(t, resp.State.GetAttribute(ctx, path.Root(tt.cfg.ParentIDAttr), &parentID).HasError())
+			assert.Equal(t, int64(4), parentID.ValueInt64())
+			if tt.wantOption != "" {
+				var option types.String
+				require.False(t, resp.State.GetAttribute(ctx, path.Root("option"), &option).HasError())
+				assert.Equal(t, tt.wantOption, option.ValueString())
+			}
+		})
+	}
+}
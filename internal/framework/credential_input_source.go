@@ -0,0 +1,237 @@
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
+)
+
+// credentialMetadataField is an entry of inputs.metadata of a credential type.
+type credentialMetadataField struct {
+	choices []string
+}
+
+// ValidateInputSourceMetadata checks the metadata of a credential input source
+// against the inputs.metadata of the type of its source credential. The
+// metadata is either the block of metadata named after the credential type or,
+// for types without a block, the JSON object of metadataJSON: every set key
+// must be defined by the type, the required ones must be set and values with
+// choices must be one of them. Unknown values pass, AWX checks them on apply,
+// and so does a source credential that is not known yet.
+func ValidateInputSourceMetadata(ctx context.Context, client Requester, sourceCredential types.Int64, metadata types.Object, metadataJSON types.String) (diags diag.Diagnostics) {
+	if sourceCredential.IsNull() || sourceCredential.IsUnknown() || metadata.IsUnknown() || metadataJSON.IsUnknown() {
+		return diags
+	}
+
+	blocks := map[string]types.Object{}
+	if !metadata.IsNull() {
+		for name, value := range metadata.Attributes() {
+			if block, ok := value.(types.Object); ok && !block.IsNull() {
+				blocks[name] = block
+			}
+		}
+	}
+	if len(blocks) > 1 {
+		diags.AddAttributeError(path.Root("metadata"), "Conflicting metadata",
+			fmt.Sprintf("Only one block of metadata can be set, got %s.", strings.Join(sortedKeys(blocks), ", ")))
+		return diags
+	}
+
+	credential, found, d := ReadRequestAllowNotFound(ctx, client, EndpointWithID("/api/v2/credentials/", sourceCredential.ValueInt64()), "CredentialInputSource")
+	if DiagnosticsHasError(&diags, d...) {
+		return diags
+	}
+	if !found {
+		diags.AddAttributeError(path.Root("source_credential"), "Invalid source credential",
+			fmt.Sprintf("Credential %d does not exist.", sourceCredential.ValueInt64()))
+		return diags
+	}
+	typeID, err := int64FromAPI(credential["credential_type"])
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to read the credential type of credential %d", sourceCredential.ValueInt64()), err.Error())
+		return diags
+	}
+
+	credentialType, d := ReadRequest(ctx, client, EndpointWithID("/api/v2/credential_types/", typeID), "CredentialInputSource")
+	if DiagnosticsHasError(&diags, d...) {
+		return diags
+	}
+	namespace, _ := credentialType["namespace"].(string)
+	if namespace == "" {
+		namespace, _ = credentialType["name"].(string)
+	}
+	if kind, _ := credentialType["kind"].(string); kind != "external" {
+		diags.AddAttributeError(path.Root("source_credential"), "Invalid source credential",
+			fmt.Sprintf("Credential %d is of the %q credential type, which cannot look up secrets. Use a credential of an external credential type.", sourceCredential.ValueInt64(), namespace))
+		return diags
+	}
+
+	// The attribute that should carry the metadata of this credential type.
+	blockType, typed := metadata.AttributeTypes(ctx)[namespace].(types.ObjectType)
+	want := path.Root("metadata_json")
+	if typed {
+		want = path.Root("metadata").AtName(namespace)
+	}
+	for name := range blocks {
+		if name != namespace {
+			diags.AddAttributeError(path.Root("metadata").AtName(name), "Mismatched metadata",
+				fmt.Sprintf("Credential %d is of the %q credential type, set its metadata in %s instead.", sourceCredential.ValueInt64(), namespace, want))
+			return diags
+		}
+	}
+
+	inputs, _ := credentialType["inputs"].(map[string]any)
+	defs := map[string]credentialMetadataField{}
+	if list, ok := inputs["metadata"].([]any); ok {
+		for _, v := range list {
+			m, _ := v.(map[string]any)
+			id, _ := m["id"].(string)
+			if id == "" {
+				continue
+			}
+			var field credentialMetadataField
+			if choices, ok := m["choices"].([]any); ok {
+				for _, c := range choices {
+					field.choices = append(field.choices, fmt.Sprint(c))
+				}
+			}
+			defs[id] = field
+		}
+	}
+
+	values := map[string]attr.Value{}
+	switch block, ok := blocks[namespace]; {
+	case ok:
+		if block.IsUnknown() {
+			return diags
+		}
+		values = block.Attributes()
+	case !metadataJSON.IsNull():
+		var data map[string]any
+		if err := json.Unmarshal([]byte(metadataJSON.ValueString()), &data); err != nil {
+			diags.AddAttributeError(want, "Invalid metadata", fmt.Sprintf("The metadata must be a JSON object: %s.", err))
+			return diags
+		}
+		for name, v := range data {
+			switch v := v.(type) {
+			case nil:
+				values[name] = types.StringNull()
+			case string:
+				values[name] = types.StringValue(v)
+			default:
+				values[name] = types.StringValue(fmt.Sprint(v))
+			}
+		}
+	case !typed:
+		// Nothing set for a type without a block, AWX checks it on apply.
+		return diags
+	}
+
+	set := map[string]bool{}
+	for _, name := range sortedKeys(values) {
+		value := values[name]
+		if value.IsNull() {
+			continue
+		}
+		set[name] = true
+		at := want
+		if typed {
+			at = at.AtName(name)
+		}
+		field, ok := defs[name]
+		if !ok {
+			diags.AddAttributeError(at, "Unsupported metadata",
+				fmt.Sprintf("The %q credential type has no %q metadata, supported: %s.", namespace, name, strings.Join(sortedKeys(defs), ", ")))
+			continue
+		}
+		s, ok := value.(types.String)
+		if !ok || s.IsUnknown() || len(field.choices) == 0 || slices.Contains(field.choices, s.ValueString()) {
+			continue
+		}
+		diags.AddAttributeError(at, "Invalid metadata",
+			fmt.Sprintf("The %q metadata of the %q credential type must be one of: %s, got %q.", name, namespace, strings.Join(field.choices, ", "), s.ValueString()))
+	}
+
+	if required, ok := inputs["required"].([]any); ok {
+		for _, v := range required {
+			id, _ := v.(string)
+			if _, ok := defs[id]; !ok || set[id] {
+				continue
+			}
+			at := want
+			if _, ok := blockType.AttrTypes[id]; ok {
+				at = at.AtName(id)
+			}
+			diags.AddAttributeError(at, "Missing required metadata",
+				fmt.Sprintf("The %q credential type requires the %q metadata.", namespace, id))
+		}
+	}
+	return diags
+}
+
+// ReconcileInputSourceMetadata moves the metadata AWX reported in
+// metadataJSON into the block of metadata that prior has set, AWX does not
+// tell which credential type the metadata is for. Without a block, the
+// metadata stays in metadataJSON, written as in priorJSON when it is the same
+// JSON object. An empty metadata keeps an empty prior as it was.
+func ReconcileInputSourceMetadata(ctx context.Context, prior types.Object, priorJSON types.String, metadata *types.Object, metadataJSON *types.String) error {
+	data := map[string]any{}
+	if !metadataJSON.IsNull() {
+		if err := json.Unmarshal([]byte(metadataJSON.ValueString()), &data); err != nil {
+			return fmt.Errorf("failed to decode the metadata: %w", err)
+		}
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		attrTypes := prior.AttributeTypes(ctx)
+		values := make(map[string]attr.Value, len(attrTypes))
+		set := ""
+		for name, t := range attrTypes {
+			values[name] = types.ObjectNull(t.(types.ObjectType).AttrTypes)
+			if v := prior.Attributes()[name]; v != nil && !v.IsNull() {
+				set = name
+			}
+		}
+		fits := set != ""
+		if fits {
+			blockTypes := attrTypes[set].(types.ObjectType).AttrTypes
+			for key := range data {
+				if _, ok := blockTypes[key]; !ok {
+					fits = false
+				}
+			}
+			if fits {
+				var block types.Object
+				if _, err := helpers.AttrValueSetObject(&block, data, blockTypes); err != nil {
+					return err
+				}
+				values[set] = block
+			}
+		}
+		if fits || (set == "" && len(data) == 0) {
+			obj, d := types.ObjectValue(attrTypes, values)
+			if d.HasError() {
+				return fmt.Errorf("failed to build the metadata")
+			}
+			*metadata, *metadataJSON = obj, types.StringNull()
+			return nil
+		}
+	}
+
+	if !priorJSON.IsNull() && !priorJSON.IsUnknown() {
+		var p map[string]any
+		if json.Unmarshal([]byte(priorJSON.ValueString()), &p) == nil && (reflect.DeepEqual(p, data) || len(p)+len(data) == 0) {
+			*metadataJSON = priorJSON
+		}
+	}
+	return nil
+}
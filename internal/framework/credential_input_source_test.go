@@ -0,0 +1,294 @@
+package framework_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+var inputMetadataAttrTypes = map[string]attr.Type{
+	"hashivault_kv": types.ObjectType{AttrTypes: map[string]attr.Type{"secret_path": types.StringType, "secret_key": types.StringType}},
+	"aim":           types.ObjectType{AttrTypes: map[string]attr.Type{"object_query": types.StringType, "object_query_format": types.StringType}},
+}
+
+// inputMetadata builds metadata with the blocks set to values, the other
+// blocks are null and so are the attributes not in values.
+func inputMetadata(blocks map[string]map[string]attr.Value) types.Object {
+	attrs := map[string]attr.Value{}
+	for name, t := range inputMetadataAttrTypes {
+		blockTypes := t.(types.ObjectType).AttrTypes
+		values, ok := blocks[name]
+		if !ok {
+			attrs[name] = types.ObjectNull(blockTypes)
+			continue
+		}
+		block := map[string]attr.Value{}
+		for attrName := range blockTypes {
+			block[attrName] = types.StringNull()
+		}
+		for attrName, v := range values {
+			block[attrName] = v
+		}
+		attrs[name] = types.ObjectValueMust(blockTypes, block)
+	}
+	return types.ObjectValueMust(inputMetadataAttrTypes, attrs)
+}
+
+// inputSourceAWX serves credential 3 of the hashivault_kv type, 4 of the aim
+// type, 5 of the ssh type and 6 of the centrify_vault_kv type, which has no
+// block in inputMetadataAttrTypes. Credential 9 does not exist.
+func inputSourceAWX(t *testing.T) *fakeAWX {
+	f := newFakeAWX(t)
+	for id, body := range map[string]string{
+		"/api/v2/credentials/3/":       `{"id":3,"credential_type":20}`,
+		"/api/v2/credentials/4/":       `{"id":4,"credential_type":22}`,
+		"/api/v2/credentials/5/":       `{"id":5,"credential_type":1}`,
+		"/api/v2/credentials/6/":       `{"id":6,"credential_type":23}`,
+		"/api/v2/credential_types/20/": `{"id":20,"namespace":"hashivault_kv","kind":"external","inputs":{"fields":[{"id":"url"}],"metadata":[{"id":"secret_path"},{"id":"secret_key"}],"required":["url","secret_path"]}}`,
+		"/api/v2/credential_types/22/": `{"id":22,"namespace":"aim","kind":"external","inputs":{"fields":[{"id":"url"}],"metadata":[{"id":"object_query"},{"id":"object_query_format","choices":["Exact","Regexp"]}],"required":["url","object_query"]}}`,
+		"/api/v2/credential_types/1/":  `{"id":1,"namespace":"ssh","kind":"ssh","inputs":{"fields":[{"id":"username"}]}}`,
+		"/api/v2/credential_types/23/": `{"id":23,"namespace":"centrify_vault_kv","kind":"external","inputs":{"fields":[{"id":"url"}],"metadata":[{"id":"account-name"},{"id":"system-name"}],"required":["url","account-name","system-name"]}}`,
+	} {
+		f.handle("GET "+id, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = io.WriteString(w, body)
+		})
+	}
+	f.handle("GET /api/v2/credentials/9/", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	return f
+}
+
+func TestValidateInputSourceMetadata(t *testing.T) {
+	kv := func(values map[string]attr.Value) types.Object {
+		return inputMetadata(map[string]map[string]attr.Value{"hashivault_kv": values})
+	}
+	aim := func(values map[string]attr.Value) types.Object {
+		return inputMetadata(map[string]map[string]attr.Value{"aim": values})
+	}
+	none := inputMetadata(nil)
+
+	tests := []struct {
+		name         string
+		source       types.Int64
+		metadata     types.Object
+		metadataJSON string
+		wantErrors   []path.Path
+	}{
+		{
+			name:     "valid metadata",
+			source:   types.Int64Value(3),
+			metadata: kv(map[string]attr.Value{"secret_path": types.StringValue("/kv/app"), "secret_key": types.StringValue("password")}),
+		},
+		{
+			name:       "block of another credential type",
+			source:     types.Int64Value(3),
+			metadata:   aim(map[string]attr.Value{"object_query": types.StringValue("Safe=A")}),
+			wantErrors: []path.Path{path.Root("metadata").AtName("aim")},
+		},
+		{
+			name: "two blocks",
+			metadata: inputMetadata(map[string]map[string]attr.Value{
+				"aim":           {"object_query": types.StringValue("Safe=A")},
+				"hashivault_kv": {"secret_path": types.StringValue("/kv/app")},
+			}),
+			source:     types.Int64Value(3),
+			wantErrors: []path.Path{path.Root("metadata")},
+		},
+		{
+			name:       "missing required metadata",
+			source:     types.Int64Value(3),
+			metadata:   kv(map[string]attr.Value{"secret_key": types.StringValue("password")}),
+			wantErrors: []path.Path{path.Root("metadata").AtName("hashivault_kv").AtName("secret_path")},
+		},
+		{
+			name:       "no metadata for a type with a block",
+			source:     types.Int64Value(3),
+			metadata:   none,
+			wantErrors: []path.Path{path.Root("metadata").AtName("hashivault_kv").AtName("secret_path")},
+		},
+		{
+			name:     "unknown required metadata passes",
+			source:   types.Int64Value(3),
+			metadata: kv(map[string]attr.Value{"secret_path": types.StringUnknown()}),
+		},
+		{
+			name:       "value not in the choices",
+			source:     types.Int64Value(4),
+			metadata:   aim(map[string]attr.Value{"object_query": types.StringValue("Safe=A"), "object_query_format": types.StringValue("Glob")}),
+			wantErrors: []path.Path{path.Root("metadata").AtName("aim").AtName("object_query_format")},
+		},
+		{
+			name:     "value in the choices",
+			source:   types.Int64Value(4),
+			metadata: aim(map[string]attr.Value{"object_query": types.StringValue("Safe=A"), "object_query_format": types.StringValue("Regexp")}),
+		},
+		{
+			name:         "JSON metadata of a type without a block",
+			source:       types.Int64Value(6),
+			metadata:     types.ObjectNull(inputMetadataAttrTypes),
+			metadataJSON: `{"account-name": "root", "system-name": "db01"}`,
+		},
+		{
+			name:         "JSON metadata missing required metadata",
+			source:       types.Int64Value(6),
+			metadata:     none,
+			metadataJSON: `{"account-name": "root"}`,
+			wantErrors:   []path.Path{path.Root("metadata_json")},
+		},
+		{
+			name:         "JSON metadata not of the type",
+			source:       types.Int64Value(6),
+			metadata:     none,
+			metadataJSON: `{"account-name": "root", "system-name": "db01", "secret_path": "/kv/app"}`,
+			wantErrors:   []path.Path{path.Root("metadata_json")},
+		},
+		{
+			name:         "JSON metadata is not an object",
+			source:       types.Int64Value(6),
+			metadata:     none,
+			metadataJSON: `["root"]`,
+			wantErrors:   []path.Path{path.Root("metadata_json")},
+		},
+		{
+			name:     "no metadata for a type without a block is left to AWX",
+			source:   types.Int64Value(6),
+			metadata: types.ObjectNull(inputMetadataAttrTypes),
+		},
+		{
+			name:       "source credential is not external",
+			source:     types.Int64Value(5),
+			metadata:   none,
+			wantErrors: []path.Path{path.Root("source_credential")},
+		},
+		{
+			name:       "source credential does not exist",
+			source:     types.Int64Value(9),
+			metadata:   none,
+			wantErrors: []path.Path{path.Root("source_credential")},
+		},
+		{
+			name:     "unknown source credential is not checked",
+			source:   types.Int64Unknown(),
+			metadata: aim(map[string]attr.Value{"object_query": types.StringValue("Safe=A")}),
+		},
+	}
+
+	c := inputSourceAWX(t).requester()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadataJSON := types.StringNull()
+			if tt.metadataJSON != "" {
+				metadataJSON = types.StringValue(tt.metadataJSON)
+			}
+			diags := framework.ValidateInputSourceMetadata(context.Background(), c, tt.source, tt.metadata, metadataJSON)
+			require.Len(t, diags, len(tt.wantErrors), "%v", diags)
+			for i, want := range tt.wantErrors {
+				d, ok := diags[i].(interface{ Path() path.Path })
+				require.True(t, ok, "%v", diags[i])
+				assert.Equal(t, want, d.Path())
+			}
+		})
+	}
+}
+
+func TestReconcileInputSourceMetadata(t *testing.T) {
+	kvPath := inputMetadata(map[string]map[string]attr.Value{"hashivault_kv": {"secret_path": types.StringValue("/kv/app")}})
+
+	tests := []struct {
+		name         string
+		prior        types.Object
+		priorJSON    types.String
+		reported     types.String
+		want         types.Object
+		wantJSON     types.String
+		wantErrorMsg string
+	}{
+		{
+			name:      "metadata goes back into the block it was written in",
+			prior:     inputMetadata(map[string]map[string]attr.Value{"hashivault_kv": {"secret_path": types.StringValue("/kv/old")}}),
+			priorJSON: types.StringNull(),
+			reported:  types.StringValue(`{"secret_path":"/kv/app"}`),
+			want:      kvPath,
+			wantJSON:  types.StringNull(),
+		},
+		{
+			name:      "metadata the block does not describe stays JSON",
+			prior:     kvPath,
+			priorJSON: types.StringNull(),
+			reported:  types.StringValue(`{"object_query":"Safe=A"}`),
+			want:      types.ObjectNull(inputMetadataAttrTypes),
+			wantJSON:  types.StringValue(`{"object_query":"Safe=A"}`),
+		},
+		{
+			name:      "empty metadata keeps an empty prior",
+			prior:     inputMetadata(nil),
+			priorJSON: types.StringNull(),
+			reported:  types.StringNull(),
+			want:      inputMetadata(nil),
+			wantJSON:  types.StringNull(),
+		},
+		{
+			name:      "JSON written differently is kept",
+			prior:     types.ObjectNull(inputMetadataAttrTypes),
+			priorJSON: types.StringValue(`{ "system-name": "db01", "account-name": "root" }`),
+			reported:  types.StringValue(`{"account-name":"root","system-name":"db01"}`),
+			want:      types.ObjectNull(inputMetadataAttrTypes),
+			wantJSON:  types.StringValue(`{ "system-name": "db01", "account-name": "root" }`),
+		},
+		{
+			name:      "changed JSON is reported",
+			prior:     types.ObjectNull(inputMetadataAttrTypes),
+			priorJSON: types.StringValue(`{"account-name": "root"}`),
+			reported:  types.StringValue(`{"account-name":"admin"}`),
+			want:      types.ObjectNull(inputMetadataAttrTypes),
+			wantJSON:  types.StringValue(`{"account-name":"admin"}`),
+		},
+		{
+			name:      "empty JSON object is kept",
+			prior:     types.ObjectNull(inputMetadataAttrTypes),
+			priorJSON: types.StringValue(`{}`),
+			reported:  types.StringNull(),
+			want:      types.ObjectNull(inputMetadataAttrTypes),
+			wantJSON:  types.StringValue(`{}`),
+		},
+		{
+			name:      "imported metadata is JSON",
+			prior:     types.ObjectNull(inputMetadataAttrTypes),
+			priorJSON: types.StringNull(),
+			reported:  types.StringValue(`{"secret_path":"/kv/app"}`),
+			want:      types.ObjectNull(inputMetadataAttrTypes),
+			wantJSON:  types.StringValue(`{"secret_path":"/kv/app"}`),
+		},
+		{
+			name:         "invalid JSON",
+			prior:        kvPath,
+			priorJSON:    types.StringNull(),
+			reported:     types.StringValue(`{`),
+			wantErrorMsg: "failed to decode the metadata",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata, metadataJSON := types.ObjectNull(inputMetadataAttrTypes), tt.reported
+			err := framework.ReconcileInputSourceMetadata(context.Background(), tt.prior, tt.priorJSON, &metadata, &metadataJSON)
+			if tt.wantErrorMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErrorMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, metadata)
+			assert.Equal(t, tt.wantJSON, metadataJSON)
+		})
+	}
+}
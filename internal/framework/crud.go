@@ -4,15 +4,23 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"unicode/utf8"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
 )
 
-func doRequest(ctx context.Context, r Requester, method string, endpoint string, body io.Reader, resourceName string, operation string) (map[string]any, diag.Diagnostics) {
+// doRequest sends the request and converts failures into diagnostics. The
+// underlying error is returned alongside so callers can branch on its kind
+// (e.g. client.ErrNotFound) without parsing diagnostic text. Responses are
+// passed through Redact before they are logged.
+func doRequest(ctx context.Context, r Requester, method string, endpoint string, body io.Reader, resourceName string, operation string) (map[string]any, diag.Diagnostics, error) {
 	var diags diag.Diagnostics
 
 	req, err := r.NewRequest(ctx, method, endpoint, body)
@@ -21,7 +29,7 @@ func doRequest(ctx context.Context, r Requester, method string, endpoint string,
 			fmt.Sprintf("Unable to create a new request for %s on %s for %s", resourceName, endpoint, operation),
 			err.Error(),
 		)
-		return nil, diags
+		return nil, diags, err
 	}
 
 	data, err := r.Do(ctx, req)
@@ -29,43 +37,144 @@ func doRequest(ctx context.Context, r Requester, method string, endpoint string,
 		tflog.Trace(ctx, fmt.Sprintf("[%s/%s] Request failed", resourceName, operation), map[string]any{
 			"method":   method,
 			"endpoint": endpoint,
-			"response": data,
+			"response": Redact(data),
 			"error":    err.Error(),
 		})
 		diags.AddError(
 			fmt.Sprintf("Unable to %s resource for %s on %s", operation, resourceName, endpoint),
 			err.Error(),
 		)
-		return nil, diags
+		return nil, diags, err
 	}
 
-	tflog.Trace(ctx, fmt.Sprintf("[%s/%s] Request succeeded", resourceName, operation), map[string]any{
+	tflog.Trace(MaskSecrets(ctx, data), fmt.Sprintf("[%s/%s] Request succeeded", resourceName, operation), map[string]any{
 		"method":   method,
 		"endpoint": endpoint,
-		"response": data,
+		"response": Redact(data),
 	})
 
-	return data, diags
+	return data, diags, nil
 }
 
+// CreateUpdateRequest encodes body as JSON and sends it. The secret values in
+// body are masked for every log line emitted while the request is in flight.
 func CreateUpdateRequest(ctx context.Context, r Requester, method string, endpoint string, body any, resourceName string, operation string) (map[string]any, diag.Diagnostics) {
+	data, diags, _ := createUpdateRequest(ctx, r, method, endpoint, body, resourceName, operation)
+	return data, diags
+}
+
+func createUpdateRequest(ctx context.Context, r Requester, method string, endpoint string, body any, resourceName string, operation string) (map[string]any, diag.Diagnostics, error) {
+	var buf bytes.Buffer
+	_ = json.NewEncoder(&buf).Encode(body)
+
+	var payload any
+	dec := json.NewDecoder(bytes.NewReader(buf.Bytes()))
+	dec.UseNumber()
+	_ = dec.Decode(&payload)
+
+	ctx = MaskSecrets(ctx, payload)
 	tflog.Debug(ctx, fmt.Sprintf("[%s/%s] Making a request", resourceName, operation), map[string]any{
-		"payload":  body,
+		"payload":  Redact(payload),
 		"method":   method,
 		"endpoint": endpoint,
 	})
 
-	var buf bytes.Buffer
-	_ = json.NewEncoder(&buf).Encode(body)
-
 	return doRequest(ctx, r, method, endpoint, &buf, resourceName, operation)
 }
 
 func ReadRequest(ctx context.Context, r Requester, endpoint string, resourceName string) (map[string]any, diag.Diagnostics) {
-	return doRequest(ctx, r, http.MethodGet, endpoint, nil, resourceName, "read")
+	data, diags, _ := doRequest(ctx, r, http.MethodGet, endpoint, nil, resourceName, "read")
+	return data, diags
+}
+
+// ReadRequestAllowNotFound behaves like ReadRequest, except that a 404 from
+// AWX is reported through found=false with no diagnostics. Resource Read
+// implementations use it to drop objects that were deleted out of band from
+// state, so Terraform proposes a re-create instead of failing the plan.
+func ReadRequestAllowNotFound(ctx context.Context, r Requester, endpoint string, resourceName string) (data map[string]any, found bool, diags diag.Diagnostics) {
+	data, diags, err := doRequest(ctx, r, http.MethodGet, endpoint, nil, resourceName, "read")
+	if errors.Is(err, client.ErrNotFound) {
+		tflog.Debug(ctx, fmt.Sprintf("[%s/read] Object no longer exists", resourceName), map[string]any{
+			"endpoint": endpoint,
+		})
+		return nil, false, nil
+	}
+	return data, !diags.HasError(), diags
 }
 
 func DeleteRequest(ctx context.Context, r Requester, endpoint string, resourceName string) diag.Diagnostics {
-	_, diags := doRequest(ctx, r, http.MethodDelete, endpoint, nil, resourceName, "delete")
+	_, diags, _ := doRequest(ctx, r, http.MethodDelete, endpoint, nil, resourceName, "delete")
 	return diags
 }
+
+// RawRequest sends a request to an endpoint that does not answer JSON, e.g.
+// the stdout of a job with ?format=txt_download, and returns the undecoded
+// response.
+func RawRequest(ctx context.Context, r Requester, method string, endpoint string, resourceName string, operation string) (*client.RawResponse, diag.Diagnostics) {
+	return rawRequest(ctx, r, method, endpoint, resourceName, operation, 0)
+}
+
+// rawRequest is RawRequest that reads at most limit bytes of the body, the
+// whole body when limit is zero or less.
+func rawRequest(ctx context.Context, r Requester, method string, endpoint string, resourceName string, operation string, limit int64) (*client.RawResponse, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	req, err := r.NewRequest(ctx, method, endpoint, nil)
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Unable to create a new request for %s on %s for %s", resourceName, endpoint, operation),
+			err.Error(),
+		)
+		return nil, diags
+	}
+
+	var resp *client.RawResponse
+	if limit > 0 {
+		resp, err = r.DoRawLimit(ctx, req, limit)
+	} else {
+		resp, err = r.DoRaw(ctx, req)
+	}
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Unable to %s resource for %s on %s", operation, resourceName, endpoint),
+			err.Error(),
+		)
+		return resp, diags
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("[%s/%s] Request succeeded", resourceName, operation), map[string]any{
+		"method":       method,
+		"endpoint":     endpoint,
+		"content_type": resp.ContentType,
+		"size":         len(resp.Body),
+	})
+	return resp, diags
+}
+
+// ReadTextRequest GETs an endpoint that answers plain text and returns at
+// most maxBytes of it. Only maxBytes and the few bytes needed to end on a
+// whole UTF-8 sequence are read, a zero or negative maxBytes reads it all.
+func ReadTextRequest(ctx context.Context, r Requester, endpoint string, resourceName string, maxBytes int64) (string, diag.Diagnostics) {
+	var limit int64
+	if maxBytes > 0 {
+		limit = maxBytes + utf8.UTFMax
+	}
+	resp, diags := rawRequest(ctx, r, http.MethodGet, endpoint, resourceName, "read", limit)
+	if diags.HasError() {
+		return "", diags
+	}
+	return TruncateOutput(string(resp.Body), maxBytes), diags
+}
+
+// TruncateOutput cuts s down to maxBytes without splitting a UTF-8 sequence.
+// A maxBytes of zero or less keeps s as is.
+func TruncateOutput(s string, maxBytes int64) string {
+	if maxBytes <= 0 || int64(len(s)) <= maxBytes {
+		return s
+	}
+	cut := int(maxBytes)
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut]
+}
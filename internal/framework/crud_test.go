@@ -2,12 +2,18 @@ package framework_test
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
 	"github.com/ilijamt/terraform-provider-awx/internal/framework"
 )
 
@@ -133,6 +139,42 @@ func TestReadRequest(t *testing.T) {
 	}
 }
 
+func TestReadRequestAllowNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	notFound := &mockRequester{
+		newRequestFunc: func(context.Context, string, string, io.Reader) (*http.Request, error) {
+			return &http.Request{}, nil
+		},
+		doFunc: func(context.Context, *http.Request) (map[string]any, error) {
+			return map[string]any{"detail": "Not found."}, fmt.Errorf("%w: 404", client.ErrNotFound)
+		},
+	}
+
+	tests := []struct {
+		name        string
+		requester   framework.Requester
+		expectFound bool
+		expectError bool
+	}{
+		{name: "success", requester: successRequester(map[string]any{"id": 1}), expectFound: true},
+		{name: "not found", requester: notFound, expectFound: false},
+		{name: "NewRequest fails", requester: failNewRequest(), expectError: true},
+		{name: "Do fails", requester: failDo(), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, found, diags := framework.ReadRequestAllowNotFound(ctx, tt.requester, "/api/v2/test/1/", "TestResource")
+			assert.Equal(t, tt.expectError, diags.HasError())
+			assert.Equal(t, tt.expectFound, found)
+			if !tt.expectFound {
+				assert.Nil(t, data)
+			}
+		})
+	}
+}
+
 func TestDeleteRequest(t *testing.T) {
 	ctx := context.Background()
 
@@ -165,3 +207,115 @@ func TestDeleteRequest(t *testing.T) {
 		})
 	}
 }
+
+func rawRequester(resp *client.RawResponse, err error) *mockRequester {
+	m := successRequester(nil)
+	m.doRawFunc = func(context.Context, *http.Request) (*client.RawResponse, error) { return resp, err }
+	m.doRawLimitFunc = func(context.Context, *http.Request, int64) (*client.RawResponse, error) { return resp, err }
+	return m
+}
+
+func TestRawRequest(t *testing.T) {
+	ctx := context.Background()
+
+	metrics := &client.RawResponse{Body: []byte("awx_system_info 1\n"), ContentType: "text/plain", StatusCode: http.StatusOK}
+	tests := []struct {
+		name        string
+		requester   framework.Requester
+		expect      *client.RawResponse
+		expectError bool
+	}{
+		{name: "success", requester: rawRequester(metrics, nil), expect: metrics},
+		{name: "NewRequest fails", requester: failNewRequest(), expectError: true},
+		{name: "DoRaw fails", requester: rawRequester(nil, fmt.Errorf("do error")), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, diags := framework.RawRequest(ctx, tt.requester, http.MethodGet, "/api/v2/metrics/", "Metrics", "read")
+			assert.Equal(t, tt.expectError, diags.HasError())
+			assert.Equal(t, tt.expect, resp)
+		})
+	}
+}
+
+func TestReadTextRequest(t *testing.T) {
+	ctx := context.Background()
+
+	stdout := &client.RawResponse{Body: []byte("ok: [web01]\n"), ContentType: "text/plain", StatusCode: http.StatusOK}
+	tests := []struct {
+		name        string
+		requester   framework.Requester
+		maxBytes    int64
+		expectText  string
+		expectError bool
+	}{
+		{name: "success", requester: rawRequester(stdout, nil), maxBytes: 100, expectText: "ok: [web01]\n"},
+		{name: "truncated", requester: rawRequester(stdout, nil), maxBytes: 2, expectText: "ok"},
+		{name: "NewRequest fails", requester: failNewRequest(), expectError: true},
+		{name: "DoRaw fails", requester: rawRequester(nil, fmt.Errorf("do error")), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, diags := framework.ReadTextRequest(ctx, tt.requester, "/api/v2/jobs/1/stdout/?format=txt", "Job", tt.maxBytes)
+			assert.Equal(t, tt.expectError, diags.HasError())
+			assert.Equal(t, tt.expectText, text)
+		})
+	}
+}
+
+func TestReadTextRequest_LargeOutput(t *testing.T) {
+	ctx := context.Background()
+	const maxBytes = 1024
+	output := strings.Repeat("ok: [wëb01]\n", 100_000)
+
+	t.Run("reads only maxBytes of the body", func(t *testing.T) {
+		svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "format=txt_download", r.URL.RawQuery)
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = io.WriteString(w, output)
+		}))
+		t.Cleanup(svr.Close)
+		c := client.NewClientWithBasicAuth("admin", "admin", svr.URL, "test", true, nil, client.RetryConfig{})
+
+		text, diags := framework.ReadTextRequest(ctx, c, "/api/v2/jobs/1/stdout/?format=txt_download", "Job", maxBytes)
+		require.False(t, diags.HasError(), "%v", diags)
+		assert.LessOrEqual(t, len(text), maxBytes)
+		assert.Greater(t, len(text), maxBytes-utf8.UTFMax)
+		assert.True(t, utf8.ValidString(text))
+		assert.True(t, strings.HasPrefix(output, text))
+	})
+
+	t.Run("asks for maxBytes and a UTF-8 sequence", func(t *testing.T) {
+		var gotLimit int64
+		m := rawRequester(nil, nil)
+		m.doRawLimitFunc = func(_ context.Context, _ *http.Request, limit int64) (*client.RawResponse, error) {
+			gotLimit = limit
+			return &client.RawResponse{Body: []byte(output[:limit]), StatusCode: http.StatusOK}, nil
+		}
+		text, diags := framework.ReadTextRequest(ctx, m, "/api/v2/jobs/1/stdout/?format=txt_download", "Job", maxBytes)
+		require.False(t, diags.HasError(), "%v", diags)
+		assert.Equal(t, int64(maxBytes+utf8.UTFMax), gotLimit)
+		assert.Equal(t, framework.TruncateOutput(output, maxBytes), text)
+	})
+}
+
+func TestTruncateOutput(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		maxBytes int64
+		want     string
+	}{
+		{name: "shorter than the limit", in: "pong", maxBytes: 10, want: "pong"},
+		{name: "no limit", in: "pong", maxBytes: 0, want: "pong"},
+		{name: "cut", in: "pong", maxBytes: 2, want: "po"},
+		{name: "does not split a rune", in: "pöng", maxBytes: 2, want: "p"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, framework.TruncateOutput(tt.in, tt.maxBytes))
+		})
+	}
+}
@@ -3,8 +3,12 @@ package framework
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
 	"github.com/ilijamt/terraform-provider-awx/internal/hooks"
@@ -44,13 +48,17 @@ func (ds *GenericDataSource[T, PT]) name() string {
 // Shadows the DataSourceBase.Configure promoted method so OnConfigure actually fires.
 func (ds *GenericDataSource[T, PT]) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	ds.DataSourceBase.Configure(ctx, req, resp)
+	RegisterDataSourceSchema(ds.Cfg.Schema)
 	if ds.Cfg.OnConfigure == nil || ds.Client == nil {
 		return
 	}
 	resp.Diagnostics.Append(ds.Cfg.OnConfigure(ctx, ds.Client)...)
 }
 
+// Schema returns ds.Cfg.Schema and registers its Sensitive attributes for log
+// redaction.
 func (ds *GenericDataSource[T, PT]) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	RegisterDataSourceSchema(ds.Cfg.Schema)
 	resp.Schema = ds.Cfg.Schema
 }
 
@@ -96,7 +104,18 @@ func (ds *GenericDataSource[T, PT]) Read(ctx context.Context, req datasource.Rea
 		}
 	}
 
-	if DiagnosticsHasError(&resp.Diagnostics, resp.State.Set(ctx, &state)...) {
+	// Search-only attributes (e.g. organization_name for named URLs) are not
+	// part of the model, they keep the configured value.
+	objectType, _ := ds.Cfg.Schema.Type().(types.ObjectType)
+	obj, d := modelToObject(ctx, &state, objectType, func(name string) (v attr.Value, diags diag.Diagnostics) {
+		diags = req.Config.GetAttribute(ctx, path.Root(name), &v)
+		return v, diags
+	})
+	if DiagnosticsHasError(&resp.Diagnostics, d...) {
+		return
+	}
+
+	if DiagnosticsHasError(&resp.Diagnostics, resp.State.Set(ctx, obj)...) {
 		return
 	}
 }
@@ -0,0 +1,108 @@
+package framework_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+var namedDataSourceSchema = dschema.Schema{
+	Attributes: map[string]dschema.Attribute{
+		"id":                dschema.Int64Attribute{Optional: true, Computed: true},
+		"name":              dschema.StringAttribute{Optional: true, Computed: true},
+		"organization_name": dschema.StringAttribute{Optional: true},
+	},
+}
+
+func newNamedDataSource(t *testing.T, handler http.HandlerFunc) *framework.GenericDataSource[namedModel, *namedModel] {
+	t.Helper()
+	svr := httptest.NewServer(handler)
+	t.Cleanup(svr.Close)
+	return &framework.GenericDataSource[namedModel, *namedModel]{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{
+			TypeName: "named",
+			Endpoint: "/api/v2/named/",
+			Client:   client.NewClientWithBasicAuth("admin", "admin", svr.URL, "test", true, nil, client.RetryConfig{}),
+		}},
+		Cfg: framework.DataSourceCfg[namedModel]{
+			Schema: namedDataSourceSchema,
+			SearchGroups: []framework.SearchGroup{
+				{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{{Name: "id", Type: "int64"}}},
+				{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+					{Name: "name", Type: "string"},
+					{Name: "organization_name", Type: "string"},
+				}},
+				{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{{Name: "name", Type: "string", URLEscape: true}}},
+			},
+		},
+	}
+}
+
+func readNamedDataSource(t *testing.T, ds *framework.GenericDataSource[namedModel, *namedModel], name, organization tftypes.Value) *datasource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+	config := tfsdk.Config{Schema: namedDataSourceSchema, Raw: tftypes.NewValue(namedDataSourceSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":                tftypes.NewValue(tftypes.Number, nil),
+		"name":              name,
+		"organization_name": organization,
+	})}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: namedDataSourceSchema}}
+	ds.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	return resp
+}
+
+func TestGenericDataSource_Read_NamedURL(t *testing.T) {
+	t.Run("resolves the named url and keeps search only attributes", func(t *testing.T) {
+		ds := newNamedDataSource(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v2/named/Deploy%20App[+]Blue++R%26D", r.URL.EscapedPath())
+			_, _ = w.Write([]byte(`{"id":7,"name":"Deploy App+Blue"}`))
+		})
+
+		resp := readNamedDataSource(t, ds, tftypes.NewValue(tftypes.String, "Deploy App+Blue"), tftypes.NewValue(tftypes.String, "R&D"))
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+		var id types.Int64
+		var organization types.String
+		require.False(t, resp.State.GetAttribute(context.Background(), path.Root("id"), &id).HasError())
+		require.False(t, resp.State.GetAttribute(context.Background(), path.Root("organization_name"), &organization).HasError())
+		assert.Equal(t, types.Int64Value(7), id)
+		assert.Equal(t, types.StringValue("R&D"), organization)
+	})
+
+	t.Run("without organization_name falls back to by_name", func(t *testing.T) {
+		ds := newNamedDataSource(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v2/named/", r.URL.Path)
+			assert.Equal(t, "Deploy App", r.URL.Query().Get("name__exact"))
+			_, _ = w.Write([]byte(`{"count":1,"results":[{"id":3,"name":"Deploy App"}]}`))
+		})
+
+		resp := readNamedDataSource(t, ds, tftypes.NewValue(tftypes.String, "Deploy App"), tftypes.NewValue(tftypes.String, nil))
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+		var organization types.String
+		require.False(t, resp.State.GetAttribute(context.Background(), path.Root("organization_name"), &organization).HasError())
+		assert.True(t, organization.IsNull())
+	})
+
+	t.Run("unknown named url fails", func(t *testing.T) {
+		ds := newNamedDataSource(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		resp := readNamedDataSource(t, ds, tftypes.NewValue(tftypes.String, "Missing"), tftypes.NewValue(tftypes.String, "Default"))
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+}
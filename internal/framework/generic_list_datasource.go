@@ -0,0 +1,200 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/hooks"
+)
+
+// ListDataSourceCfg holds per-datasource configuration for a plural data
+// source such as awx_hosts.
+type ListDataSourceCfg[T any] struct {
+	// ItemAttributes describe one listed object. They are all Computed and
+	// mirror the attributes of the singular data source.
+	ItemAttributes map[string]dschema.Attribute
+	// Description is the data source description.
+	Description string
+	// DeprecationMessage marks the whole data source as deprecated when set.
+	DeprecationMessage string
+	// Hook is called for every listed object before it is added to state (nil if no hook).
+	Hook HookFunc[T]
+	// ApiVersion is passed to hook functions.
+	ApiVersion string
+	// ResourceName is used in error messages. Defaults to TypeName if empty.
+	ResourceName string
+}
+
+// ListModel is the state model shared by every plural data source.
+type ListModel struct {
+	Filters types.Map  `tfsdk:"filters"`
+	Results types.List `tfsdk:"results"`
+}
+
+// GenericListDataSource lists every AWX object matching a set of query
+// filters, following pagination, and exposes them as a list of objects built
+// from the same model as the singular data source.
+type GenericListDataSource[T any, PT DataModel[T]] struct {
+	DataSourceBase
+	Cfg ListDataSourceCfg[T]
+}
+
+func (ds *GenericListDataSource[T, PT]) name() string {
+	if ds.Cfg.ResourceName != "" {
+		return ds.Cfg.ResourceName
+	}
+	return ds.TypeName
+}
+
+// Configure wires the client and registers the Sensitive item attributes for
+// log redaction.
+func (ds *GenericListDataSource[T, PT]) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ds.DataSourceBase.Configure(ctx, req, resp)
+	RegisterDataSourceSchema(ds.schema())
+}
+
+func (ds *GenericListDataSource[T, PT]) schema() dschema.Schema {
+	return dschema.Schema{
+		Description:        ds.Cfg.Description,
+		DeprecationMessage: ds.Cfg.DeprecationMessage,
+		Attributes: map[string]dschema.Attribute{
+			"filters": dschema.MapAttribute{
+				Description: "AWX query filters passed as is to the list endpoint, e.g. `name__icontains`, `organization`, `or__name`, `not__status` or `order_by`. Every value in a key's list is sent as a separate query parameter, so a key can be repeated, e.g. `{ or__name = [\"web\", \"db\"] }`. All objects are returned when empty.",
+				ElementType: types.ListType{ElemType: types.StringType},
+				Optional:    true,
+			},
+			"results": dschema.ListNestedAttribute{
+				Description: "Every object matching the filters, across all result pages.",
+				Computed:    true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: ds.Cfg.ItemAttributes,
+				},
+			},
+		},
+	}
+}
+
+func (ds *GenericListDataSource[T, PT]) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = ds.schema()
+	RegisterDataSourceSchema(resp.Schema)
+}
+
+func (ds *GenericListDataSource[T, PT]) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ListModel
+	if DiagnosticsHasError(&resp.Diagnostics, req.Config.Get(ctx, &state)...) {
+		return
+	}
+
+	var filters map[string][]string
+	if DiagnosticsHasError(&resp.Diagnostics, state.Filters.ElementsAs(ctx, &filters, false)...) {
+		return
+	}
+
+	endpoint := CleanEndpoint(ds.Endpoint)
+	if len(filters) > 0 {
+		query := url.Values{}
+		for k, values := range filters {
+			for _, v := range values {
+				query.Add(k, v)
+			}
+		}
+		endpoint += "?" + query.Encode()
+	}
+
+	items, d := ListAll(ctx, ds.Client, endpoint, ds.name(), 0)
+	if DiagnosticsHasError(&resp.Diagnostics, d...) {
+		return
+	}
+
+	objectType := types.ObjectType{AttrTypes: make(map[string]attr.Type, len(ds.Cfg.ItemAttributes))}
+	for name, a := range ds.Cfg.ItemAttributes {
+		objectType.AttrTypes[name] = a.GetType()
+	}
+
+	results := make([]attr.Value, 0, len(items))
+	for _, item := range items {
+		var model T
+		d, err := PT(&model).UpdateFromApiData(item)
+		resp.Diagnostics.Append(d...)
+		if err != nil || resp.Diagnostics.HasError() {
+			return
+		}
+
+		if ds.Cfg.Hook != nil {
+			if HookError(&resp.Diagnostics, ds.name(), ds.Cfg.Hook(ctx, ds.Cfg.ApiVersion, hooks.SourceData, hooks.CalleeRead, nil, &model)) {
+				return
+			}
+		}
+
+		obj, d := modelToObject(ctx, &model, objectType, nil)
+		if DiagnosticsHasError(&resp.Diagnostics, d...) {
+			return
+		}
+		results = append(results, obj)
+	}
+
+	state.Results, d = types.ListValue(objectType, results)
+	if DiagnosticsHasError(&resp.Diagnostics, d...) {
+		return
+	}
+
+	if DiagnosticsHasError(&resp.Diagnostics, resp.State.Set(ctx, &state)...) {
+		return
+	}
+}
+
+// modelToObject converts a generated model into an object of objectType. The
+// model's `tfsdk` fields are matched by name; model fields that are not part
+// of the object (e.g. Terraform-only toggles) are skipped and object
+// attributes the model lacks are taken from fallback, or set to null when
+// fallback is nil.
+func modelToObject[T any](ctx context.Context, model *T, objectType types.ObjectType, fallback func(name string) (attr.Value, diag.Diagnostics)) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	values := make(map[string]attr.Value, len(objectType.AttrTypes))
+
+	rv := reflect.ValueOf(model).Elem()
+	rt := rv.Type()
+	for i := range rt.NumField() {
+		name := rt.Field(i).Tag.Get("tfsdk")
+		if _, ok := objectType.AttrTypes[name]; !ok {
+			continue
+		}
+		if v, ok := rv.Field(i).Interface().(attr.Value); ok {
+			values[name] = v
+		}
+	}
+
+	for name, t := range objectType.AttrTypes {
+		if _, ok := values[name]; ok {
+			continue
+		}
+		if fallback != nil {
+			v, d := fallback(name)
+			if DiagnosticsHasError(&diags, d...) {
+				return types.ObjectNull(objectType.AttrTypes), diags
+			}
+			values[name] = v
+			continue
+		}
+		null, err := t.ValueFromTerraform(ctx, tftypes.NewValue(t.TerraformType(ctx), nil))
+		if err != nil {
+			diags.AddAttributeError(path.Root(name), fmt.Sprintf("Unable to build a null value for %q", name), err.Error())
+			return types.ObjectNull(objectType.AttrTypes), diags
+		}
+		values[name] = null
+	}
+
+	obj, d := types.ObjectValue(objectType.AttrTypes, values)
+	diags.Append(d...)
+	return obj, diags
+}
@@ -0,0 +1,202 @@
+package framework_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+	"github.com/ilijamt/terraform-provider-awx/internal/hooks"
+)
+
+var namedItemAttributes = map[string]dschema.Attribute{
+	"id":          dschema.Int64Attribute{Computed: true},
+	"name":        dschema.StringAttribute{Computed: true},
+	"description": dschema.StringAttribute{Computed: true},
+}
+
+func newNamedListDataSource(t *testing.T, handler http.HandlerFunc) *framework.GenericListDataSource[namedModel, *namedModel] {
+	t.Helper()
+	svr := httptest.NewServer(handler)
+	t.Cleanup(svr.Close)
+	return &framework.GenericListDataSource[namedModel, *namedModel]{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{
+			TypeName: "nameds",
+			Endpoint: "/api/v2/named/",
+			Client:   client.NewClientWithBasicAuth("admin", "admin", svr.URL, "test", true, nil, client.RetryConfig{}),
+		}},
+		Cfg: framework.ListDataSourceCfg[namedModel]{ItemAttributes: namedItemAttributes},
+	}
+}
+
+func readNamedList(t *testing.T, ds *framework.GenericListDataSource[namedModel, *namedModel], filters map[string][]string) *datasource.ReadResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	itemType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"id": types.Int64Type, "name": types.StringType, "description": types.StringType,
+	}}
+	model := framework.ListModel{Filters: types.MapNull(types.ListType{ElemType: types.StringType}), Results: types.ListNull(itemType)}
+	if filters != nil {
+		var diags diag.Diagnostics
+		model.Filters, diags = types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, filters)
+		require.False(t, diags.HasError(), "%v", diags)
+	}
+	raw := tfsdk.State{Schema: schemaResp.Schema}
+	require.False(t, raw.Set(ctx, &model).HasError())
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	ds.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw.Raw}}, resp)
+	return resp
+}
+
+func namedResults(t *testing.T, resp *datasource.ReadResponse) []map[string]attr.Value {
+	t.Helper()
+	var got framework.ListModel
+	require.False(t, resp.State.Get(context.Background(), &got).HasError())
+	var out []map[string]attr.Value
+	for _, item := range got.Results.Elements() {
+		out = append(out, item.(types.Object).Attributes())
+	}
+	return out
+}
+
+func TestGenericListDataSource_Schema(t *testing.T) {
+	ds := newNamedListDataSource(t, func(http.ResponseWriter, *http.Request) {})
+	resp := &datasource.SchemaResponse{}
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	filters, ok := resp.Schema.Attributes["filters"]
+	require.True(t, ok, "filters attribute missing")
+	assert.True(t, filters.IsOptional())
+
+	results, ok := resp.Schema.Attributes["results"].(dschema.ListNestedAttribute)
+	require.True(t, ok, "results must be a list of nested objects")
+	assert.True(t, results.IsComputed())
+	assert.Equal(t, namedItemAttributes, results.NestedObject.Attributes)
+}
+
+func TestGenericListDataSource_Read(t *testing.T) {
+	t.Run("follows next across pages and passes filters", func(t *testing.T) {
+		var queries []string
+		ds := newNamedListDataSource(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/v2/named/", r.URL.Path)
+			queries = append(queries, r.URL.RawQuery)
+			switch r.URL.Query().Get("page") {
+			case "":
+				_, _ = w.Write([]byte(`{"count":3,"next":"/api/v2/named/?name__icontains=web&or__inventory=1&page=2&page_size=200","results":[{"id":1,"name":"web-1"}]}`))
+			case "2":
+				// Proxies may rewrite next into an absolute URL.
+				_, _ = w.Write([]byte(`{"count":3,"next":"http://awx.example.com/api/v2/named/?name__icontains=web&or__inventory=1&page=3&page_size=200","results":[{"id":2,"name":"web-2"}]}`))
+			default:
+				_, _ = w.Write([]byte(`{"count":3,"next":null,"results":[{"id":3,"name":"web-3"}]}`))
+			}
+		})
+
+		resp := readNamedList(t, ds, map[string][]string{"name__icontains": {"web"}, "or__inventory": {"1"}})
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+		assert.Equal(t, []string{
+			"name__icontains=web&or__inventory=1&page_size=200",
+			"name__icontains=web&or__inventory=1&page=2&page_size=200",
+			"name__icontains=web&or__inventory=1&page=3&page_size=200",
+		}, queries)
+
+		results := namedResults(t, resp)
+		require.Len(t, results, 3)
+		for i, item := range results {
+			assert.Equal(t, types.Int64Value(int64(i+1)), item["id"])
+			assert.Equal(t, types.StringValue(fmt.Sprintf("web-%d", i+1)), item["name"])
+			assert.True(t, item["description"].IsNull(), "attributes missing from the model are null")
+		}
+	})
+
+	t.Run("repeated filter keys are all sent", func(t *testing.T) {
+		var query url.Values
+		ds := newNamedListDataSource(t, func(w http.ResponseWriter, r *http.Request) {
+			query = r.URL.Query()
+			_, _ = w.Write([]byte(`{"count":0,"next":null,"results":[]}`))
+		})
+
+		resp := readNamedList(t, ds, map[string][]string{"or__name": {"web", "db"}, "id__in": {"1,2"}, "not__status": {}})
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Equal(t, []string{"web", "db"}, query["or__name"])
+		assert.Equal(t, []string{"1,2"}, query["id__in"])
+		assert.NotContains(t, query, "not__status")
+	})
+
+	t.Run("no results", func(t *testing.T) {
+		ds := newNamedListDataSource(t, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "page_size=200", r.URL.RawQuery)
+			_, _ = w.Write([]byte(`{"count":0,"next":null,"results":[]}`))
+		})
+
+		resp := readNamedList(t, ds, nil)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Empty(t, namedResults(t, resp))
+	})
+
+	t.Run("hook runs for every item", func(t *testing.T) {
+		ds := newNamedListDataSource(t, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"next":null,"results":[{"id":1,"name":"a"},{"id":2,"name":"b"}]}`))
+		})
+		ds.Cfg.Hook = func(_ context.Context, _ string, source hooks.Source, callee hooks.Callee, _, state *namedModel) error {
+			assert.Equal(t, hooks.SourceData, source)
+			assert.Equal(t, hooks.CalleeRead, callee)
+			state.Name = types.StringValue(state.Name.ValueString() + "-hooked")
+			return nil
+		}
+
+		resp := readNamedList(t, ds, nil)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		results := namedResults(t, resp)
+		require.Len(t, results, 2)
+		assert.Equal(t, types.StringValue("a-hooked"), results[0]["name"])
+		assert.Equal(t, types.StringValue("b-hooked"), results[1]["name"])
+	})
+
+	t.Run("repeated next page is reported", func(t *testing.T) {
+		ds := newNamedListDataSource(t, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"next":"/api/v2/named/?page=2","results":[{"id":1,"name":"a"}]}`))
+		})
+
+		resp := readNamedList(t, ds, nil)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Pagination loop")
+	})
+
+	t.Run("api errors fail the read", func(t *testing.T) {
+		ds := newNamedListDataSource(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		})
+
+		resp := readNamedList(t, ds, nil)
+		assert.True(t, resp.Diagnostics.HasError())
+	})
+
+	t.Run("missing results array", func(t *testing.T) {
+		ds := newNamedListDataSource(t, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"id":1,"name":"not a list"}`))
+		})
+
+		resp := readNamedList(t, ds, nil)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "Unexpected response")
+	})
+}
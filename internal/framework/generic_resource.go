@@ -15,6 +15,7 @@ import (
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
 	"github.com/ilijamt/terraform-provider-awx/internal/hooks"
 )
 
@@ -56,6 +57,60 @@ type WaitLifecycleCfg[T any] struct {
 	// ResolveTimeout pulls the right duration off the plan's timeouts block
 	// (Create vs Update). Returns 0 if the user didn't set a timeout.
 	ResolveTimeout func(ctx context.Context, plan *T, callee hooks.Callee) (time.Duration, diag.Diagnostics)
+	// RefreshAfterWait re-reads the resource once the wait succeeded, so the
+	// final status and the other fields set by the run land in state.
+	RefreshAfterWait bool
+	// Output, when non-nil, captures the plain text output of the run once
+	// the wait succeeded.
+	Output *WaitOutputCfg[T]
+	// Launch, when non-nil, starts a job for the resource and polls that job
+	// instead of EndpointForModel.
+	Launch *WaitLaunchCfg[T]
+	// MarkUnknown marks the attributes changed by the job it launches
+	// unknown on a plan that is going to wait or launch one. The attributes
+	// only refreshed once the wait succeeded are marked unknown only when
+	// waiting, without a wait they keep their prior value until the next
+	// refresh rather than being reported as changed by the apply.
+	MarkUnknown func(plan *T, waiting bool)
+}
+
+// WaitLaunchCfg starts a job for the resource once it has been created or
+// updated, e.g. the update of an inventory source, and records it on state.
+type WaitLaunchCfg[T any] struct {
+	// ShouldLaunch decides on the launch from the plan and the prior state,
+	// which is nil on Create. nil → launch whenever the plan waits.
+	ShouldLaunch func(plan, prior *T) bool
+	// EndpointForModel returns the launch endpoint for a populated state model.
+	EndpointForModel func(model *T) string
+	// IDField is the field of the launch response holding the job ID.
+	IDField string
+	// JobEndpoint is the collection endpoint of the launched jobs.
+	JobEndpoint string
+	// SetJob stores the ID and the status of the job on the state model,
+	// once after the launch and again when the wait succeeded (nil for none).
+	SetJob func(state *T, id int64, status string)
+	// Counts are read once the wait succeeded.
+	Counts []WaitCountCfg[T]
+}
+
+// WaitCountCfg stores the number of objects on a list endpoint, e.g. the
+// hosts of an inventory source, on the state model.
+type WaitCountCfg[T any] struct {
+	// EndpointForModel returns the list endpoint for a populated state model.
+	EndpointForModel func(model *T) string
+	// SetCount stores the count on the state model.
+	SetCount func(state *T, count int64)
+}
+
+// WaitOutputCfg captures the plain text output of a run, e.g. the stdout of
+// an ad hoc command, into a Terraform-only attribute.
+type WaitOutputCfg[T any] struct {
+	// EndpointForModel returns the output endpoint for a populated state model.
+	EndpointForModel func(model *T) string
+	// MaxBytes reads the size limit from the plan. Zero or less → no capture.
+	MaxBytes func(plan *T) int64
+	// SetOutput stores the captured output on the state model.
+	SetOutput func(state *T, output string)
 }
 
 // ConfigureFunc runs once at Configure time after the client is wired up. Used
@@ -93,6 +148,13 @@ type ResourceCfg[T any, B any] struct {
 	// WaitLifecycle, when non-nil, polls the resource after Create/Update
 	// until the configured field reaches a terminal value.
 	WaitLifecycle *WaitLifecycleCfg[T]
+	// ValidatePlan checks a planned create or update against the AWX API,
+	// e.g. against an object the plan refers to (nil if none). Unchanged
+	// resources are not checked.
+	ValidatePlan func(ctx context.Context, client Requester, plan *T) diag.Diagnostics
+	// StateUpgraders upgrade the state of prior versions of Schema, keyed by
+	// the prior version (nil if none). See RawStateUpgraders.
+	StateUpgraders map[int64]resource.StateUpgrader
 	// IDAccessor returns the ID value from a model instance for endpoint construction (nil for NoId).
 	IDAccessor func(model *T) any
 	// IDKey is the schema attribute name carrying the imported ID (typically "id"). Empty when NoId.
@@ -104,8 +166,19 @@ type ResourceCfg[T any, B any] struct {
 	NoId bool
 	// NoImport disables terraform import for this resource. Attempts return an error diagnostic.
 	NoImport bool
+	// SearchGroups are the data source search groups of the type. Import IDs
+	// in the `key=value,...` form are resolved through them (nil for none).
+	SearchGroups []SearchGroup
+	// ImportIDFields names the fields of the `value/value` import ID form,
+	// e.g. workflow_job_template and identifier for `12/deploy`. They are
+	// resolved through SearchGroups like the `key=value` form.
+	ImportIDFields []string
 	// UnDeletable means Delete is a no-op.
 	UnDeletable bool
+	// Immutable means AWX cannot update the object (no PUT/PATCH). Every API
+	// argument forces a replacement, so Update only copies the Terraform-only
+	// attributes (CopyExtraAttributes) into state, without a request.
+	Immutable bool
 	// ApiVersion is passed to hook functions.
 	ApiVersion string
 	// ResourceName is used in error messages. Defaults to TypeName if empty.
@@ -136,6 +209,7 @@ func (r *GenericResource[T, B, PT]) endpointForModel(model *T) string {
 // Shadows the ResourceBase.Configure promoted method so OnConfigure actually fires.
 func (r *GenericResource[T, B, PT]) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
 	r.ResourceBase.Configure(ctx, request, response)
+	RegisterResourceSchema(r.Cfg.Schema)
 	if r.Cfg.OnConfigure == nil || r.Client == nil {
 		return
 	}
@@ -144,8 +218,10 @@ func (r *GenericResource[T, B, PT]) Configure(ctx context.Context, request resou
 
 // Schema returns r.Cfg.Schema, optionally injecting a `timeouts` block when
 // EmitTimeouts is set so wait-lifecycle resources get user-tunable Create/Update
-// timeouts without templating it per-resource.
+// timeouts without templating it per-resource. The Sensitive attributes are
+// registered for log redaction on the way.
 func (r *GenericResource[T, B, PT]) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	RegisterResourceSchema(r.Cfg.Schema)
 	resp.Schema = r.Cfg.Schema
 	if r.Cfg.EmitTimeouts {
 		if resp.Schema.Blocks == nil {
@@ -158,13 +234,76 @@ func (r *GenericResource[T, B, PT]) Schema(ctx context.Context, _ resource.Schem
 	}
 }
 
-// runWaitLifecycle polls the resource after a successful Create or Update
-// when WaitLifecycle is configured and the plan opts in via ShouldWait.
-func (r *GenericResource[T, B, PT]) runWaitLifecycle(ctx context.Context, plan, state *T, callee hooks.Callee, diags *diag.Diagnostics) {
+// ModifyPlan runs ValidatePlan on a planned create or update, then marks the
+// attributes changed by the wait lifecycle unknown when an update is going to
+// wait or launch a job, see WaitLifecycleCfg.MarkUnknown.
+func (r *GenericResource[T, B, PT]) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.Cfg.ValidatePlan != nil && r.Client != nil && !req.Plan.Raw.IsNull() && !req.Plan.Raw.Equal(req.State.Raw) {
+		var plan T
+		if DiagnosticsHasError(&resp.Diagnostics, req.Plan.Get(ctx, &plan)...) {
+			return
+		}
+		if DiagnosticsHasError(&resp.Diagnostics, r.Cfg.ValidatePlan(ctx, r.Client, &plan)...) {
+			return
+		}
+	}
+	wl := r.Cfg.WaitLifecycle
+	if wl == nil || wl.MarkUnknown == nil {
+		return
+	}
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+	var plan, prior T
+	if DiagnosticsHasError(&resp.Diagnostics, req.Plan.Get(ctx, &plan)...) {
+		return
+	}
+	if DiagnosticsHasError(&resp.Diagnostics, req.State.Get(ctx, &prior)...) {
+		return
+	}
+	waiting := r.shouldWait(&plan)
+	if !waiting && !r.shouldLaunch(&plan, &prior) {
+		return
+	}
+	wl.MarkUnknown(&plan, waiting)
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// UpgradeState returns r.Cfg.StateUpgraders. Terraform only asks for them
+// when the state was written with an older Schema.Version.
+func (r *GenericResource[T, B, PT]) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return r.Cfg.StateUpgraders
+}
+
+// shouldWait reports whether the plan opts into the wait lifecycle.
+func (r *GenericResource[T, B, PT]) shouldWait(plan *T) bool {
 	wl := r.Cfg.WaitLifecycle
-	if wl == nil || wl.ShouldWait == nil || !wl.ShouldWait(plan) {
+	return wl != nil && wl.ShouldWait != nil && wl.ShouldWait(plan)
+}
+
+// shouldLaunch reports whether the wait lifecycle launches a job, prior is
+// nil on Create.
+func (r *GenericResource[T, B, PT]) shouldLaunch(plan, prior *T) bool {
+	wl := r.Cfg.WaitLifecycle
+	if wl == nil || wl.Launch == nil {
+		return false
+	}
+	if wl.Launch.ShouldLaunch == nil {
+		return r.shouldWait(plan)
+	}
+	return wl.Launch.ShouldLaunch(plan, prior)
+}
+
+// runWaitLifecycle launches a job for the resource and polls the resource,
+// or the job, after a successful Create or Update when WaitLifecycle is
+// configured and the plan opts in via ShouldWait or Launch.ShouldLaunch.
+// prior is nil on Create.
+func (r *GenericResource[T, B, PT]) runWaitLifecycle(ctx context.Context, plan, prior, state *T, callee hooks.Callee, diags *diag.Diagnostics) {
+	waiting, launching := r.shouldWait(plan), r.shouldLaunch(plan, prior)
+	if !waiting && !launching {
 		return
 	}
+	wl := r.Cfg.WaitLifecycle
 
 	timeout := wl.DefaultTimeout
 	if wl.ResolveTimeout != nil {
@@ -176,7 +315,7 @@ func (r *GenericResource[T, B, PT]) runWaitLifecycle(ctx context.Context, plan,
 			timeout = resolved
 		}
 	}
-	if timeout <= 0 {
+	if waiting && timeout <= 0 {
 		diags.AddError(
 			fmt.Sprintf("Cannot wait for %s: no timeout configured", r.name()),
 			"WaitLifecycle is enabled but neither the timeouts block nor DefaultTimeout produced a positive duration.",
@@ -193,8 +332,18 @@ func (r *GenericResource[T, B, PT]) runWaitLifecycle(ctx context.Context, plan,
 		return
 	}
 
+	pollEndpoint := endpoint
+	if launching {
+		if pollEndpoint = r.launch(ctx, state, diags); pollEndpoint == "" {
+			return
+		}
+	}
+	if !waiting {
+		return
+	}
+
 	tflog.Debug(ctx, fmt.Sprintf("[%s/wait] polling for terminal status", r.name()), map[string]any{
-		"endpoint": endpoint,
+		"endpoint": pollEndpoint,
 		"field":    wl.Field,
 		"timeout":  timeout.String(),
 	})
@@ -203,30 +352,112 @@ func (r *GenericResource[T, B, PT]) runWaitLifecycle(ctx context.Context, plan,
 	defer cancel()
 
 	err := WaitForFieldValue(waitCtx, r.Client, WaitForFieldOpts{
-		Endpoint:      endpoint,
+		Endpoint:      pollEndpoint,
 		Field:         wl.Field,
 		SuccessValues: wl.SuccessValues,
 		FailureValues: wl.FailureValues,
 		PollInterval:  wl.PollInterval,
 	})
 	if err == nil {
+		r.afterWait(ctx, plan, state, endpoint, pollEndpoint, diags)
 		return
 	}
 
 	var term *WaitTerminalError
 	if errors.As(err, &term) {
+		// Keep what the failed run left behind, e.g. its output, in state.
+		r.afterWait(ctx, plan, state, endpoint, pollEndpoint, diags)
 		diags.AddError(
-			fmt.Sprintf("%s reached terminal failure status %q on %s", r.name(), term.Status, endpoint),
+			fmt.Sprintf("%s reached terminal failure status %q on %s", r.name(), term.Status, pollEndpoint),
 			"AWX reported a non-recoverable status while waiting for the resource to become ready. Check the AWX UI for details.",
 		)
 		return
 	}
 	diags.AddError(
-		fmt.Sprintf("Timed out or failed waiting for %s on %s", r.name(), endpoint),
+		fmt.Sprintf("Timed out or failed waiting for %s on %s", r.name(), pollEndpoint),
 		err.Error(),
 	)
 }
 
+// launch starts the job configured on WaitLifecycle.Launch and returns its
+// endpoint, or an empty string when it has appended an error to diags.
+func (r *GenericResource[T, B, PT]) launch(ctx context.Context, state *T, diags *diag.Diagnostics) string {
+	l := r.Cfg.WaitLifecycle.Launch
+	data, d := CreateUpdateRequest(ctx, r.Client, http.MethodPost, l.EndpointForModel(state), map[string]any{}, r.name(), "launch")
+	if DiagnosticsHasError(diags, d...) {
+		return ""
+	}
+	id, err := int64FromAPI(data[l.IDField])
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Unable to read the ID of the job launched for %s", r.name()),
+			fmt.Sprintf("expected a numeric %s in the launch response: %s", l.IDField, err),
+		)
+		return ""
+	}
+	if l.SetJob != nil {
+		status, _ := data[r.Cfg.WaitLifecycle.Field].(string)
+		l.SetJob(state, id, status)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("[%s/wait] launched job", r.name()), map[string]any{"id": id})
+	return EndpointWithID(l.JobEndpoint, id)
+}
+
+// afterWait refreshes the state, records the launched job and captures the
+// output of the run, as configured on WaitLifecycle, once the wait reached a
+// terminal status.
+func (r *GenericResource[T, B, PT]) afterWait(ctx context.Context, plan, state *T, endpoint, pollEndpoint string, diags *diag.Diagnostics) {
+	wl := r.Cfg.WaitLifecycle
+	if wl.RefreshAfterWait {
+		data, d := ReadRequest(ctx, r.Client, endpoint, r.name())
+		if DiagnosticsHasError(diags, d...) {
+			return
+		}
+		d, err := PT(state).UpdateFromApiData(data)
+		diags.Append(d...)
+		if err != nil || diags.HasError() {
+			return
+		}
+	}
+
+	// The poll endpoint only differs from the resource's when a job was launched.
+	if l := wl.Launch; l != nil && pollEndpoint != endpoint {
+		if l.SetJob != nil {
+			job, d := ReadRequest(ctx, r.Client, pollEndpoint, r.name())
+			if DiagnosticsHasError(diags, d...) {
+				return
+			}
+			id, err := int64FromAPI(job["id"])
+			if err != nil {
+				diags.AddError(fmt.Sprintf("Unable to read the ID of the job launched for %s", r.name()), err.Error())
+				return
+			}
+			status, _ := job[wl.Field].(string)
+			l.SetJob(state, id, status)
+		}
+		for _, c := range l.Counts {
+			count, d := CountAll(ctx, r.Client, c.EndpointForModel(state), r.name())
+			if DiagnosticsHasError(diags, d...) {
+				return
+			}
+			c.SetCount(state, count)
+		}
+	}
+
+	if wl.Output == nil {
+		return
+	}
+	maxBytes := wl.Output.MaxBytes(plan)
+	if maxBytes <= 0 {
+		return
+	}
+	output, d := ReadTextRequest(ctx, r.Client, wl.Output.EndpointForModel(state), r.name(), maxBytes)
+	if DiagnosticsHasError(diags, d...) {
+		return
+	}
+	wl.Output.SetOutput(state, output)
+}
+
 func (r *GenericResource[T, B, PT]) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	if r.Cfg.NoId || r.Cfg.NoImport {
 		resp.Diagnostics.AddError(
@@ -242,11 +473,18 @@ func (r *GenericResource[T, B, PT]) ImportState(ctx context.Context, req resourc
 	}
 	id, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			fmt.Sprintf("Unable to parse '%v' as an int64 number, please provide the ID for the %s.", req.ID, r.name()),
-			err.Error(),
-		)
-		return
+		// Not an ID, look the object up by name=value pairs or named URL.
+		var d diag.Diagnostics
+		id, d = ImportLookup{
+			Endpoint:     r.Endpoint,
+			SearchGroups: r.Cfg.SearchGroups,
+			IDFields:     r.Cfg.ImportIDFields,
+			Attributes:   r.Cfg.Schema.Attributes,
+			ResourceName: r.name(),
+		}.Resolve(ctx, r.Client, req.ID)
+		if DiagnosticsHasError(&resp.Diagnostics, d...) {
+			return
+		}
 	}
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, idPath, id)...)
 }
@@ -257,7 +495,7 @@ func (r *GenericResource[T, B, PT]) ImportState(ctx context.Context, req resourc
 // whenever it has appended a hard error to diags.
 func (r *GenericResource[T, B, PT]) applyMutation(
 	ctx context.Context,
-	plan *T,
+	plan, prior *T,
 	method, endpoint, operation string,
 	callee hooks.Callee,
 	diags *diag.Diagnostics,
@@ -270,12 +508,19 @@ func (r *GenericResource[T, B, PT]) applyMutation(
 		r.Cfg.MutateBody(plan, bodyRequest)
 	}
 
-	data, d := CreateUpdateRequest(ctx, r.Client, method, endpoint, bodyRequest, r.name(), operation)
+	data, d, err := createUpdateRequest(ctx, r.Client, method, endpoint, bodyRequest, r.name(), operation)
+	var verr *client.ValidationError
+	if errors.As(err, &verr) {
+		// Point at the offending arguments instead of one resource-wide error.
+		summary := fmt.Sprintf("AWX rejected the %s of %s", operation, r.name())
+		diags.Append(ValidationDiagnostics(verr, summary, NewAttributeResolver[T](r.Cfg.Schema.Attributes))...)
+		return state, false
+	}
 	if DiagnosticsHasError(diags, d...) {
 		return state, false
 	}
 
-	d, err := PT(&state).UpdateFromApiData(data)
+	d, err = PT(&state).UpdateFromApiData(data)
 	diags.Append(d...)
 	if err != nil || diags.HasError() {
 		return state, false
@@ -293,10 +538,9 @@ func (r *GenericResource[T, B, PT]) applyMutation(
 		}
 	}
 
-	r.runWaitLifecycle(ctx, plan, &state, callee, diags)
-	if diags.HasError() {
-		return state, false
-	}
+	// The object exists in AWX even when the wait fails, so its state is
+	// returned for the caller to save alongside the error.
+	r.runWaitLifecycle(ctx, plan, prior, &state, callee, diags)
 	return state, true
 }
 
@@ -311,7 +555,7 @@ func (r *GenericResource[T, B, PT]) Create(ctx context.Context, request resource
 		method = http.MethodPatch
 	}
 
-	state, ok := r.applyMutation(ctx, &plan, method, CleanEndpoint(r.Endpoint), "create", hooks.CalleeCreate, &response.Diagnostics)
+	state, ok := r.applyMutation(ctx, &plan, nil, method, CleanEndpoint(r.Endpoint), "create", hooks.CalleeCreate, &response.Diagnostics)
 	if !ok {
 		return
 	}
@@ -330,10 +574,14 @@ func (r *GenericResource[T, B, PT]) Read(ctx context.Context, request resource.R
 		orig = &o
 	}
 
-	data, d := ReadRequest(ctx, r.Client, r.endpointForModel(&state), r.name())
+	data, found, d := ReadRequestAllowNotFound(ctx, r.Client, r.endpointForModel(&state), r.name())
 	if DiagnosticsHasError(&response.Diagnostics, d...) {
 		return
 	}
+	if !found {
+		response.State.RemoveResource(ctx)
+		return
+	}
 
 	d, err := PT(&state).UpdateFromApiData(data)
 	response.Diagnostics.Append(d...)
@@ -351,11 +599,22 @@ func (r *GenericResource[T, B, PT]) Read(ctx context.Context, request resource.R
 }
 
 func (r *GenericResource[T, B, PT]) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
-	var plan T
+	var plan, prior T
 	if DiagnosticsHasError(&response.Diagnostics, request.Plan.Get(ctx, &plan)...) {
 		return
 	}
-	state, ok := r.applyMutation(ctx, &plan, http.MethodPatch, r.endpointForModel(&plan), "update", hooks.CalleeUpdate, &response.Diagnostics)
+	if DiagnosticsHasError(&response.Diagnostics, request.State.Get(ctx, &prior)...) {
+		return
+	}
+	if r.Cfg.Immutable {
+		state := prior
+		if r.Cfg.CopyExtraAttributes != nil {
+			r.Cfg.CopyExtraAttributes(&plan, &state)
+		}
+		response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+		return
+	}
+	state, ok := r.applyMutation(ctx, &plan, &prior, http.MethodPatch, r.endpointForModel(&plan), "update", hooks.CalleeUpdate, &response.Diagnostics)
 	if !ok {
 		return
 	}
@@ -0,0 +1,74 @@
+package framework_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+func TestGenericResource_ModifyPlan_ValidatePlan(t *testing.T) {
+	ctx := context.Background()
+	var validated []string
+	r := newNamedResource(t, func(w http.ResponseWriter, req *http.Request) {
+		t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+	})
+	r.Cfg.ValidatePlan = func(_ context.Context, client framework.Requester, plan *namedModel) (diags diag.Diagnostics) {
+		require.NotNil(t, client)
+		validated = append(validated, plan.Name.ValueString())
+		if plan.Name.ValueString() == "invalid" {
+			diags.AddAttributeError(path.Root("name"), "Invalid name", "the name is invalid")
+		}
+		return diags
+	}
+
+	plan := func(name string) tfsdk.Plan {
+		return tfsdk.Plan{Schema: namedSchema, Raw: namedState(t, namedModel{ID: types.Int64Unknown(), Name: types.StringValue(name)}).Raw}
+	}
+	null := tfsdk.State{Schema: namedSchema, Raw: tftypes.NewValue(namedSchema.Type().TerraformType(ctx), nil)}
+	existing := namedState(t, namedModel{ID: types.Int64Value(1), Name: types.StringValue("existing")})
+
+	t.Run("create is validated", func(t *testing.T) {
+		validated = nil
+		resp := &resource.ModifyPlanResponse{Plan: plan("invalid")}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan("invalid"), State: null}, resp)
+		assert.True(t, resp.Diagnostics.HasError())
+		assert.Equal(t, []string{"invalid"}, validated)
+	})
+
+	t.Run("update is validated", func(t *testing.T) {
+		validated = nil
+		resp := &resource.ModifyPlanResponse{Plan: plan("renamed")}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan("renamed"), State: existing}, resp)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Equal(t, []string{"renamed"}, validated)
+	})
+
+	t.Run("unchanged resource is not validated", func(t *testing.T) {
+		validated = nil
+		unchanged := tfsdk.Plan{Schema: namedSchema, Raw: existing.Raw}
+		resp := &resource.ModifyPlanResponse{Plan: unchanged}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: unchanged, State: existing}, resp)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Empty(t, validated)
+	})
+
+	t.Run("destroy is not validated", func(t *testing.T) {
+		validated = nil
+		destroy := tfsdk.Plan{Schema: namedSchema, Raw: tftypes.NewValue(namedSchema.Type().TerraformType(ctx), nil)}
+		resp := &resource.ModifyPlanResponse{Plan: destroy}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: destroy, State: existing}, resp)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.Empty(t, validated)
+	})
+}
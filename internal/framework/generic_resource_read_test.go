@@ -0,0 +1,124 @@
+package framework_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
+)
+
+// namedModel is a minimal id + name model used to drive GenericResource
+// through the real client against an httptest AWX stub.
+type namedModel struct {
+	ID   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+type namedBody struct {
+	Name string `json:"name"`
+}
+
+func (m *namedModel) Clone() namedModel { return *m }
+
+func (m *namedModel) BodyRequest() *namedBody { return &namedBody{Name: m.Name.ValueString()} }
+
+func (m *namedModel) UpdateFromApiData(data map[string]any) (diag.Diagnostics, error) {
+	diags := diag.Diagnostics{}
+	if data == nil {
+		return diags, fmt.Errorf("no data passed")
+	}
+	collect := func(d diag.Diagnostics, _ error) { diags.Append(d...) }
+	collect(helpers.AttrValueSetInt64(&m.ID, data["id"]))
+	collect(helpers.AttrValueSetString(&m.Name, data["name"], false))
+	return diags, nil
+}
+
+var namedSchema = rschema.Schema{
+	Attributes: map[string]rschema.Attribute{
+		"id":   rschema.Int64Attribute{Computed: true},
+		"name": rschema.StringAttribute{Required: true},
+	},
+}
+
+func newNamedResource(t *testing.T, handler http.HandlerFunc) *framework.GenericResource[namedModel, namedBody, *namedModel] {
+	t.Helper()
+	svr := httptest.NewServer(handler)
+	t.Cleanup(svr.Close)
+	return &framework.GenericResource[namedModel, namedBody, *namedModel]{
+		ResourceBase: framework.ResourceBase{ProviderBase: framework.ProviderBase{
+			TypeName: "named",
+			Endpoint: "/api/v2/named/",
+			Client:   client.NewClientWithBasicAuth("admin", "admin", svr.URL, "test", true, nil, client.RetryConfig{}),
+		}},
+		Cfg: framework.ResourceCfg[namedModel, namedBody]{
+			Schema:     namedSchema,
+			IDAccessor: func(m *namedModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+		},
+	}
+}
+
+func namedState(t *testing.T, m namedModel) tfsdk.State {
+	t.Helper()
+	state := tfsdk.State{Schema: namedSchema}
+	require.False(t, state.Set(context.Background(), &m).HasError())
+	return state
+}
+
+func TestGenericResource_Read(t *testing.T) {
+	t.Run("404 removes the resource from state", func(t *testing.T) {
+		r := newNamedResource(t, func(w http.ResponseWriter, req *http.Request) {
+			assert.Equal(t, "/api/v2/named/42/", req.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"detail":"Not found."}`))
+		})
+
+		state := namedState(t, namedModel{ID: types.Int64Value(42), Name: types.StringValue("gone")})
+		resp := &resource.ReadResponse{State: state}
+		r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		assert.True(t, resp.State.Raw.IsNull(), "state should be removed")
+	})
+
+	t.Run("200 refreshes state", func(t *testing.T) {
+		r := newNamedResource(t, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"id":42,"name":"renamed"}`))
+		})
+
+		state := namedState(t, namedModel{ID: types.Int64Value(42), Name: types.StringValue("original")})
+		resp := &resource.ReadResponse{State: state}
+		r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+		var got namedModel
+		require.False(t, resp.State.Get(context.Background(), &got).HasError())
+		assert.Equal(t, "renamed", got.Name.ValueString())
+	})
+
+	t.Run("other errors still fail the read", func(t *testing.T) {
+		r := newNamedResource(t, func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+		})
+
+		state := namedState(t, namedModel{ID: types.Int64Value(42), Name: types.StringValue("kept")})
+		resp := &resource.ReadResponse{State: state}
+		r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+
+		require.True(t, resp.Diagnostics.HasError())
+		assert.False(t, resp.State.Raw.IsNull(), "state must not be removed on non-404 errors")
+	})
+}
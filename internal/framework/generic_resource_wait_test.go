@@ -0,0 +1,696 @@
+package framework_test
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
+	"github.com/ilijamt/terraform-provider-awx/internal/hooks"
+)
+
+// runModel is a job-like model whose status changes while AWX runs it.
+type runModel struct {
+	ID             types.Int64  `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Status         types.String `tfsdk:"status"`
+	Wait           types.Bool   `tfsdk:"wait"`
+	Stdout         types.String `tfsdk:"stdout"`
+	StdoutMaxBytes types.Int64  `tfsdk:"stdout_max_bytes"`
+}
+
+func (m *runModel) Clone() runModel { return *m }
+
+func (m *runModel) BodyRequest() *namedBody { return &namedBody{Name: m.Name.ValueString()} }
+
+func (m *runModel) UpdateFromApiData(data map[string]any) (diag.Diagnostics, error) {
+	diags := diag.Diagnostics{}
+	if data == nil {
+		return diags, fmt.Errorf("no data passed")
+	}
+	collect := func(d diag.Diagnostics, _ error) { diags.Append(d...) }
+	collect(helpers.AttrValueSetInt64(&m.ID, data["id"]))
+	collect(helpers.AttrValueSetString(&m.Name, data["name"], false))
+	collect(helpers.AttrValueSetString(&m.Status, data["status"], false))
+	return diags, nil
+}
+
+var runSchema = rschema.Schema{
+	Attributes: map[string]rschema.Attribute{
+		"id":               rschema.Int64Attribute{Computed: true},
+		"name":             rschema.StringAttribute{Required: true},
+		"status":           rschema.StringAttribute{Computed: true},
+		"wait":             rschema.BoolAttribute{Optional: true},
+		"stdout":           rschema.StringAttribute{Computed: true},
+		"stdout_max_bytes": rschema.Int64Attribute{Optional: true},
+	},
+}
+
+func newRunResource(t *testing.T, refresh bool, status string) *framework.GenericResource[runModel, namedBody, *runModel] {
+	t.Helper()
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.RequestURI() {
+		case "/api/v2/runs/":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 1, "name": "ping", "status": "pending"}`))
+		case "/api/v2/runs/1/":
+			_, _ = fmt.Fprintf(w, `{"id": 1, "name": "ping", "status": %q}`, status)
+		case "/api/v2/runs/1/stdout/?format=txt":
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("web01 | SUCCESS => pong\nweb02 | SUCCESS => pong\n"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(svr.Close)
+
+	return &framework.GenericResource[runModel, namedBody, *runModel]{
+		ResourceBase: framework.ResourceBase{ProviderBase: framework.ProviderBase{
+			TypeName: "run",
+			Endpoint: "/api/v2/runs/",
+			Client:   client.NewClientWithBasicAuth("admin", "admin", svr.URL, "test", true, nil, client.RetryConfig{}),
+		}},
+		Cfg: framework.ResourceCfg[runModel, namedBody]{
+			Schema:     runSchema,
+			IDAccessor: func(m *runModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			CopyExtraAttributes: func(plan, state *runModel) {
+				state.Wait = plan.Wait
+				state.StdoutMaxBytes = plan.StdoutMaxBytes
+			},
+			WaitLifecycle: &framework.WaitLifecycleCfg[runModel]{
+				ShouldWait: func(plan *runModel) bool { return plan.Wait.ValueBool() },
+				EndpointForModel: func(m *runModel) string {
+					return framework.EndpointWithID("/api/v2/runs/", m.ID.ValueInt64())
+				},
+				Field:          "status",
+				SuccessValues:  []string{"successful"},
+				FailureValues:  []string{"failed"},
+				DefaultTimeout: time.Minute,
+				ResolveTimeout: func(context.Context, *runModel, hooks.Callee) (time.Duration, diag.Diagnostics) {
+					return 0, nil
+				},
+				RefreshAfterWait: refresh,
+				Output: &framework.WaitOutputCfg[runModel]{
+					EndpointForModel: func(m *runModel) string {
+						return fmt.Sprintf("/api/v2/runs/%d/stdout/?format=txt", m.ID.ValueInt64())
+					},
+					MaxBytes: func(plan *runModel) int64 { return plan.StdoutMaxBytes.ValueInt64() },
+					SetOutput: func(state *runModel, output string) {
+						state.Stdout = types.StringValue(output)
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGenericResource_CreateWaitRefreshAndOutput(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name        string
+		refresh     bool
+		wait        bool
+		maxBytes    int64
+		status      string
+		wantStatus  string
+		wantStdout  types.String
+		wantInError string
+	}{
+		{
+			name:       "refreshes the status and captures the output",
+			refresh:    true,
+			wait:       true,
+			maxBytes:   1024,
+			wantStatus: "successful",
+			wantStdout: types.StringValue("web01 | SUCCESS => pong\nweb02 | SUCCESS => pong\n"),
+		},
+		{
+			name:       "truncates the output",
+			refresh:    true,
+			wait:       true,
+			maxBytes:   10,
+			wantStatus: "successful",
+			wantStdout: types.StringValue("web01 | SU"),
+		},
+		{
+			name:       "zero max bytes does not capture the output",
+			refresh:    true,
+			wait:       true,
+			wantStatus: "successful",
+			wantStdout: types.StringNull(),
+		},
+		{
+			name:       "without refresh the status of the create response is kept",
+			wait:       true,
+			maxBytes:   1024,
+			wantStatus: "pending",
+			wantStdout: types.StringValue("web01 | SUCCESS => pong\nweb02 | SUCCESS => pong\n"),
+		},
+		{
+			name:        "a failed run still refreshes the status and captures the output",
+			refresh:     true,
+			wait:        true,
+			maxBytes:    1024,
+			status:      "failed",
+			wantStatus:  "failed",
+			wantStdout:  types.StringValue("web01 | SUCCESS => pong\nweb02 | SUCCESS => pong\n"),
+			wantInError: `reached terminal failure status "failed"`,
+		},
+		{
+			name:       "no wait",
+			refresh:    true,
+			maxBytes:   1024,
+			wantStatus: "pending",
+			wantStdout: types.StringNull(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newRunResource(t, tt.refresh, cmp.Or(tt.status, "successful"))
+			plan := tfsdk.Plan{Schema: runSchema}
+			require.False(t, plan.Set(ctx, &runModel{
+				ID:             types.Int64Unknown(),
+				Name:           types.StringValue("ping"),
+				Status:         types.StringUnknown(),
+				Wait:           types.BoolValue(tt.wait),
+				Stdout:         types.StringUnknown(),
+				StdoutMaxBytes: types.Int64Value(tt.maxBytes),
+			}).HasError())
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: runSchema}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+			if tt.wantInError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.wantInError)
+			} else {
+				require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			}
+
+			var state runModel
+			require.False(t, resp.State.Get(ctx, &state).HasError())
+			assert.Equal(t, tt.wantStatus, state.Status.ValueString())
+			assert.Equal(t, tt.wantStdout, state.Stdout)
+		})
+	}
+}
+
+func TestGenericResource_UpdateImmutableStoresTerraformOnlyAttributes(t *testing.T) {
+	ctx := context.Background()
+	r := newRunResource(t, true, "successful")
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.RequestURI())
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	t.Cleanup(svr.Close)
+	r.Client = client.NewClientWithBasicAuth("admin", "admin", svr.URL, "test", true, nil, client.RetryConfig{})
+	r.Cfg.Immutable = true
+
+	prior := runModel{
+		ID:             types.Int64Value(1),
+		Name:           types.StringValue("ping"),
+		Status:         types.StringValue("successful"),
+		Wait:           types.BoolValue(false),
+		Stdout:         types.StringNull(),
+		StdoutMaxBytes: types.Int64Value(0),
+	}
+	planned := prior
+	planned.Wait = types.BoolValue(true)
+	planned.StdoutMaxBytes = types.Int64Value(1024)
+
+	plan := tfsdk.Plan{Schema: runSchema}
+	require.False(t, plan.Set(ctx, &planned).HasError())
+	state := tfsdk.State{Schema: runSchema}
+	require.False(t, state.Set(ctx, &prior).HasError())
+
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: runSchema}}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var got runModel
+	require.False(t, resp.State.Get(ctx, &got).HasError())
+	assert.Equal(t, planned, got)
+}
+
+// syncModel is an inventory source like model that launches an update.
+type syncModel struct {
+	ID               types.Int64  `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	UpdateOnApply    types.Bool   `tfsdk:"update_on_apply"`
+	LastUpdateID     types.Int64  `tfsdk:"last_update_id"`
+	LastUpdateStatus types.String `tfsdk:"last_update_status"`
+	HostCount        types.Int64  `tfsdk:"host_count"`
+	Triggers         types.Map    `tfsdk:"triggers"`
+}
+
+func (m *syncModel) Clone() syncModel { return *m }
+
+func (m *syncModel) BodyRequest() *namedBody { return &namedBody{Name: m.Name.ValueString()} }
+
+func (m *syncModel) UpdateFromApiData(data map[string]any) (diag.Diagnostics, error) {
+	diags := diag.Diagnostics{}
+	if data == nil {
+		return diags, fmt.Errorf("no data passed")
+	}
+	collect := func(d diag.Diagnostics, _ error) { diags.Append(d...) }
+	collect(helpers.AttrValueSetInt64(&m.ID, data["id"]))
+	collect(helpers.AttrValueSetString(&m.Name, data["name"], false))
+	return diags, nil
+}
+
+var syncSchema = rschema.Schema{
+	Attributes: map[string]rschema.Attribute{
+		"id":                 rschema.Int64Attribute{Computed: true},
+		"name":               rschema.StringAttribute{Required: true},
+		"update_on_apply":    rschema.BoolAttribute{Optional: true},
+		"last_update_id":     rschema.Int64Attribute{Computed: true},
+		"last_update_status": rschema.StringAttribute{Computed: true},
+		"host_count":         rschema.Int64Attribute{Computed: true},
+		"triggers":           rschema.MapAttribute{ElementType: types.StringType, Optional: true},
+	},
+}
+
+// newSyncResource serves an inventory source whose update ends in updateStatus.
+// With triggered, an update is launched when triggers changes instead of
+// whenever update_on_apply is set.
+func newSyncResource(t *testing.T, updateStatus string, triggered bool) (*framework.GenericResource[syncModel, namedBody, *syncModel], *[]string) {
+	t.Helper()
+	var requested []string
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.Method+" "+r.URL.RequestURI())
+		switch r.Method + " " + r.URL.RequestURI() {
+		case "POST /api/v2/sources/":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"id": 1, "name": "cloud"}`))
+		case "PATCH /api/v2/sources/1/":
+			_, _ = w.Write([]byte(`{"id": 1, "name": "cloud"}`))
+		case "GET /api/v2/sources/1/":
+			_, _ = w.Write([]byte(`{"id": 1, "name": "cloud", "status": "successful"}`))
+		case "POST /api/v2/sources/1/update/":
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = w.Write([]byte(`{"inventory_update": 7, "id": 7, "status": "pending"}`))
+		case "GET /api/v2/updates/7/":
+			_, _ = fmt.Fprintf(w, `{"id": 7, "status": %q}`, updateStatus)
+		case "GET /api/v2/sources/1/hosts/?page_size=1":
+			_, _ = w.Write([]byte(`{"count": 3, "next": "/api/v2/sources/1/hosts/?page=2&page_size=1", "results": [{"id": 1}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(svr.Close)
+
+	var shouldLaunch func(plan, prior *syncModel) bool
+	if triggered {
+		shouldLaunch = func(plan, prior *syncModel) bool {
+			return prior != nil && !plan.Triggers.Equal(prior.Triggers)
+		}
+	}
+
+	return &framework.GenericResource[syncModel, namedBody, *syncModel]{
+		ResourceBase: framework.ResourceBase{ProviderBase: framework.ProviderBase{
+			TypeName: "source",
+			Endpoint: "/api/v2/sources/",
+			Client:   client.NewClientWithBasicAuth("admin", "admin", svr.URL, "test", true, nil, client.RetryConfig{}),
+		}},
+		Cfg: framework.ResourceCfg[syncModel, namedBody]{
+			Schema:     syncSchema,
+			IDAccessor: func(m *syncModel) any { return m.ID.ValueInt64() },
+			IDKey:      "id",
+			CopyExtraAttributes: func(plan, state *syncModel) {
+				state.UpdateOnApply = plan.UpdateOnApply
+				state.Triggers = plan.Triggers
+				if !plan.LastUpdateID.IsUnknown() {
+					state.LastUpdateID = plan.LastUpdateID
+				}
+				if !plan.LastUpdateStatus.IsUnknown() {
+					state.LastUpdateStatus = plan.LastUpdateStatus
+				}
+				if !plan.HostCount.IsUnknown() {
+					state.HostCount = plan.HostCount
+				}
+			},
+			WaitLifecycle: &framework.WaitLifecycleCfg[syncModel]{
+				ShouldWait: func(plan *syncModel) bool { return plan.UpdateOnApply.ValueBool() },
+				EndpointForModel: func(m *syncModel) string {
+					return framework.EndpointWithID("/api/v2/sources/", m.ID.ValueInt64())
+				},
+				Field:          "status",
+				SuccessValues:  []string{"successful"},
+				FailureValues:  []string{"failed"},
+				PollInterval:   time.Millisecond,
+				DefaultTimeout: time.Minute,
+				MarkUnknown: func(plan *syncModel, waiting bool) {
+					plan.LastUpdateID = types.Int64Unknown()
+					plan.LastUpdateStatus = types.StringUnknown()
+					if waiting {
+						plan.HostCount = types.Int64Unknown()
+					}
+				},
+				Launch: &framework.WaitLaunchCfg[syncModel]{
+					ShouldLaunch: shouldLaunch,
+					EndpointForModel: func(m *syncModel) string {
+						return fmt.Sprintf("/api/v2/sources/%d/update/", m.ID.ValueInt64())
+					},
+					IDField:     "inventory_update",
+					JobEndpoint: "/api/v2/updates/",
+					SetJob: func(state *syncModel, id int64, status string) {
+						state.LastUpdateID = types.Int64Value(id)
+						state.LastUpdateStatus = types.StringValue(status)
+					},
+					Counts: []framework.WaitCountCfg[syncModel]{
+						{
+							EndpointForModel: func(m *syncModel) string {
+								return fmt.Sprintf("/api/v2/sources/%d/hosts/", m.ID.ValueInt64())
+							},
+							SetCount: func(state *syncModel, count int64) {
+								state.HostCount = types.Int64Value(count)
+							},
+						},
+					},
+				},
+			},
+		},
+	}, &requested
+}
+
+func TestGenericResource_CreateLaunchAndWait(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name          string
+		updateOnApply bool
+		updateStatus  string
+		wantRequested []string
+		wantState     *syncModel
+		wantInError   string
+	}{
+		{
+			name:          "launches the update and records it",
+			updateOnApply: true,
+			updateStatus:  "successful",
+			wantRequested: []string{
+				"POST /api/v2/sources/",
+				"POST /api/v2/sources/1/update/",
+				"GET /api/v2/updates/7/",
+				"GET /api/v2/updates/7/",
+				"GET /api/v2/sources/1/hosts/?page_size=1",
+			},
+			wantState: &syncModel{
+				ID:               types.Int64Value(1),
+				Name:             types.StringValue("cloud"),
+				UpdateOnApply:    types.BoolValue(true),
+				LastUpdateID:     types.Int64Value(7),
+				LastUpdateStatus: types.StringValue("successful"),
+				HostCount:        types.Int64Value(3),
+				Triggers:         types.MapNull(types.StringType),
+			},
+		},
+		{
+			name:          "failed update",
+			updateOnApply: true,
+			updateStatus:  "failed",
+			wantRequested: []string{
+				"POST /api/v2/sources/",
+				"POST /api/v2/sources/1/update/",
+				"GET /api/v2/updates/7/",
+				"GET /api/v2/updates/7/",
+				"GET /api/v2/sources/1/hosts/?page_size=1",
+			},
+			wantState: &syncModel{
+				ID:               types.Int64Value(1),
+				Name:             types.StringValue("cloud"),
+				UpdateOnApply:    types.BoolValue(true),
+				LastUpdateID:     types.Int64Value(7),
+				LastUpdateStatus: types.StringValue("failed"),
+				HostCount:        types.Int64Value(3),
+				Triggers:         types.MapNull(types.StringType),
+			},
+			wantInError: `reached terminal failure status "failed" on /api/v2/updates/7/`,
+		},
+		{
+			name:          "no update",
+			wantRequested: []string{"POST /api/v2/sources/"},
+			wantState: &syncModel{
+				ID:               types.Int64Value(1),
+				Name:             types.StringValue("cloud"),
+				UpdateOnApply:    types.BoolValue(false),
+				LastUpdateID:     types.Int64Null(),
+				LastUpdateStatus: types.StringNull(),
+				HostCount:        types.Int64Null(),
+				Triggers:         types.MapNull(types.StringType),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, requested := newSyncResource(t, tt.updateStatus, false)
+			plan := tfsdk.Plan{Schema: syncSchema}
+			require.False(t, plan.Set(ctx, &syncModel{
+				ID:               types.Int64Unknown(),
+				Name:             types.StringValue("cloud"),
+				UpdateOnApply:    types.BoolValue(tt.updateOnApply),
+				LastUpdateID:     types.Int64Unknown(),
+				LastUpdateStatus: types.StringUnknown(),
+				HostCount:        types.Int64Unknown(),
+				Triggers:         types.MapNull(types.StringType),
+			}).HasError())
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: syncSchema}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+			assert.Equal(t, tt.wantRequested, *requested)
+			if tt.wantInError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), tt.wantInError)
+			} else {
+				require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			}
+
+			var state syncModel
+			require.False(t, resp.State.Get(ctx, &state).HasError())
+			assert.Equal(t, *tt.wantState, state)
+		})
+	}
+}
+
+func TestGenericResource_UpdateKeepsLastUpdateWithoutLaunch(t *testing.T) {
+	ctx := context.Background()
+	r, requested := newSyncResource(t, "successful", false)
+	prior := syncModel{
+		ID:               types.Int64Value(1),
+		Name:             types.StringValue("cloud"),
+		UpdateOnApply:    types.BoolValue(false),
+		LastUpdateID:     types.Int64Value(5),
+		LastUpdateStatus: types.StringValue("successful"),
+		HostCount:        types.Int64Value(2),
+		Triggers:         types.MapNull(types.StringType),
+	}
+	plan := tfsdk.Plan{Schema: syncSchema}
+	require.False(t, plan.Set(ctx, &prior).HasError())
+
+	state := tfsdk.State{Schema: syncSchema}
+	require.False(t, state.Set(ctx, &prior).HasError())
+
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: syncSchema}}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+	assert.Equal(t, []string{"PATCH /api/v2/sources/1/"}, *requested)
+
+	var got syncModel
+	require.False(t, resp.State.Get(ctx, &got).HasError())
+	assert.Equal(t, prior, got)
+}
+
+func TestGenericResource_ModifyPlanMarksLaunchUnknown(t *testing.T) {
+	ctx := context.Background()
+	prior := syncModel{
+		ID:               types.Int64Value(1),
+		Name:             types.StringValue("cloud"),
+		UpdateOnApply:    types.BoolValue(true),
+		LastUpdateID:     types.Int64Value(5),
+		LastUpdateStatus: types.StringValue("successful"),
+		HostCount:        types.Int64Value(2),
+		Triggers:         types.MapNull(types.StringType),
+	}
+	tests := []struct {
+		name            string
+		triggered       bool
+		plan            func(m syncModel) syncModel
+		wantUnknown     bool
+		wantWaitUnknown bool
+	}{
+		{
+			name:            "change with update_on_apply",
+			plan:            func(m syncModel) syncModel { m.Name = types.StringValue("renamed"); return m },
+			wantUnknown:     true,
+			wantWaitUnknown: true,
+		},
+		{
+			name: "change without update_on_apply",
+			plan: func(m syncModel) syncModel {
+				m.Name = types.StringValue("renamed")
+				m.UpdateOnApply = types.BoolValue(false)
+				return m
+			},
+		},
+		{
+			name: "no change",
+			plan: func(m syncModel) syncModel { return m },
+		},
+		{
+			name:      "changed triggers without update_on_apply",
+			triggered: true,
+			plan: func(m syncModel) syncModel {
+				m.UpdateOnApply = types.BoolValue(false)
+				m.Triggers = types.MapValueMust(types.StringType, map[string]attr.Value{"sha": types.StringValue("def")})
+				return m
+			},
+			// The launch is not waited for, so the host count is not refreshed
+			// by the apply and keeps its prior value.
+			wantUnknown: true,
+		},
+		{
+			name:      "changed triggers with update_on_apply",
+			triggered: true,
+			plan: func(m syncModel) syncModel {
+				m.Triggers = types.MapValueMust(types.StringType, map[string]attr.Value{"sha": types.StringValue("def")})
+				return m
+			},
+			wantUnknown:     true,
+			wantWaitUnknown: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, _ := newSyncResource(t, "successful", tt.triggered)
+			state := tfsdk.State{Schema: syncSchema}
+			require.False(t, state.Set(ctx, &prior).HasError())
+			plan := tfsdk.Plan{Schema: syncSchema}
+			require.False(t, plan.Set(ctx, tt.plan(prior)).HasError())
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+			var got syncModel
+			require.False(t, resp.Plan.Get(ctx, &got).HasError())
+			assert.Equal(t, tt.wantUnknown, got.LastUpdateID.IsUnknown())
+			assert.Equal(t, tt.wantUnknown, got.LastUpdateStatus.IsUnknown())
+			assert.Equal(t, tt.wantWaitUnknown, got.HostCount.IsUnknown())
+			if !tt.wantWaitUnknown {
+				assert.Equal(t, prior.HostCount, got.HostCount)
+			}
+		})
+	}
+}
+
+func TestGenericResource_UpdateLaunchesOnTriggerChange(t *testing.T) {
+	ctx := context.Background()
+	triggers := func(sha string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{"sha": types.StringValue(sha)})
+	}
+	prior := syncModel{
+		ID:               types.Int64Value(1),
+		Name:             types.StringValue("cloud"),
+		UpdateOnApply:    types.BoolValue(true),
+		LastUpdateID:     types.Int64Value(5),
+		LastUpdateStatus: types.StringValue("successful"),
+		HostCount:        types.Int64Value(2),
+		Triggers:         triggers("abc"),
+	}
+	tests := []struct {
+		name          string
+		wait          bool
+		triggers      types.Map
+		wantRequested []string
+		wantState     syncModel
+	}{
+		{
+			name:     "changed triggers launch and wait",
+			wait:     true,
+			triggers: triggers("def"),
+			wantRequested: []string{
+				"PATCH /api/v2/sources/1/",
+				"POST /api/v2/sources/1/update/",
+				"GET /api/v2/updates/7/",
+				"GET /api/v2/updates/7/",
+				"GET /api/v2/sources/1/hosts/?page_size=1",
+			},
+			wantState: syncModel{
+				ID:               types.Int64Value(1),
+				Name:             types.StringValue("cloud"),
+				UpdateOnApply:    types.BoolValue(true),
+				LastUpdateID:     types.Int64Value(7),
+				LastUpdateStatus: types.StringValue("successful"),
+				HostCount:        types.Int64Value(3),
+				Triggers:         triggers("def"),
+			},
+		},
+		{
+			name:     "changed triggers launch without waiting",
+			triggers: triggers("def"),
+			wantRequested: []string{
+				"PATCH /api/v2/sources/1/",
+				"POST /api/v2/sources/1/update/",
+			},
+			wantState: syncModel{
+				ID:               types.Int64Value(1),
+				Name:             types.StringValue("cloud"),
+				UpdateOnApply:    types.BoolValue(false),
+				LastUpdateID:     types.Int64Value(7),
+				LastUpdateStatus: types.StringValue("pending"),
+				HostCount:        types.Int64Value(2),
+				Triggers:         triggers("def"),
+			},
+		},
+		{
+			name:     "unchanged triggers wait on the resource",
+			wait:     true,
+			triggers: triggers("abc"),
+			wantRequested: []string{
+				"PATCH /api/v2/sources/1/",
+				"GET /api/v2/sources/1/",
+			},
+			wantState: prior,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, requested := newSyncResource(t, "successful", true)
+			state := tfsdk.State{Schema: syncSchema}
+			require.False(t, state.Set(ctx, &prior).HasError())
+
+			planned := prior
+			planned.UpdateOnApply = types.BoolValue(tt.wait)
+			planned.Triggers = tt.triggers
+			if !planned.Triggers.Equal(prior.Triggers) {
+				planned.LastUpdateID = types.Int64Unknown()
+				planned.LastUpdateStatus = types.StringUnknown()
+			}
+			plan := tfsdk.Plan{Schema: syncSchema}
+			require.False(t, plan.Set(ctx, &planned).HasError())
+
+			resp := &resource.UpdateResponse{State: tfsdk.State{Schema: syncSchema}}
+			r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+			require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+			assert.Equal(t, tt.wantRequested, *requested)
+
+			var got syncModel
+			require.False(t, resp.State.Get(ctx, &got).HasError())
+			assert.Equal(t, tt.wantState, got)
+		})
+	}
+}
@@ -2,10 +2,25 @@ package framework_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
 	"github.com/ilijamt/terraform-provider-awx/internal/framework"
 )
 
@@ -13,6 +28,8 @@ import (
 type mockRequester struct {
 	newRequestFunc func(ctx context.Context, method, endpoint string, body io.Reader) (*http.Request, error)
 	doFunc         func(ctx context.Context, req *http.Request) (map[string]any, error)
+	doRawFunc      func(ctx context.Context, req *http.Request) (*client.RawResponse, error)
+	doRawLimitFunc func(ctx context.Context, req *http.Request, limit int64) (*client.RawResponse, error)
 }
 
 var _ framework.Requester = (*mockRequester)(nil)
@@ -25,6 +42,14 @@ func (m *mockRequester) Do(ctx context.Context, req *http.Request) (map[string]a
 	return m.doFunc(ctx, req)
 }
 
+func (m *mockRequester) DoRaw(ctx context.Context, req *http.Request) (*client.RawResponse, error) {
+	return m.doRawFunc(ctx, req)
+}
+
+func (m *mockRequester) DoRawLimit(ctx context.Context, req *http.Request, limit int64) (*client.RawResponse, error) {
+	return m.doRawLimitFunc(ctx, req, limit)
+}
+
 func successRequester(data map[string]any) *mockRequester {
 	return &mockRequester{
 		newRequestFunc: func(context.Context, string, string, io.Reader) (*http.Request, error) {
@@ -52,3 +77,157 @@ func failDo() *mockRequester {
 		},
 	}
 }
+
+// pagedRequester serves one response per endpoint, like AWX does for the pages
+// of a list, and records the endpoints in the order they were requested.
+func pagedRequester(pages map[string]map[string]any, requested *[]string) *mockRequester {
+	return &mockRequester{
+		newRequestFunc: func(_ context.Context, _, endpoint string, _ io.Reader) (*http.Request, error) {
+			*requested = append(*requested, endpoint)
+			return http.NewRequest(http.MethodGet, endpoint, nil)
+		},
+		doFunc: func(_ context.Context, req *http.Request) (map[string]any, error) {
+			page, ok := pages[req.URL.String()]
+			if !ok {
+				return nil, fmt.Errorf("unexpected page %s", req.URL)
+			}
+			return page, nil
+		},
+	}
+}
+
+// fakeAWX is an in-memory AWX API for the resources that send several
+// requests per operation. Routes are http.ServeMux patterns such as
+// "GET /api/v2/teams/{id}/" and match the path exactly; a request no route
+// matches fails the test. Handlers record the requests that change AWX in
+// calls.
+type fakeAWX struct {
+	t     *testing.T
+	mux   *http.ServeMux
+	calls []string
+	// missing answers every request with 404, as if the object was deleted.
+	missing bool
+}
+
+func newFakeAWX(t *testing.T) *fakeAWX {
+	return &fakeAWX{t: t, mux: http.NewServeMux()}
+}
+
+func (f *fakeAWX) handle(pattern string, h http.HandlerFunc) {
+	if strings.HasSuffix(pattern, "/") {
+		pattern += "{$}"
+	}
+	f.mux.HandleFunc(pattern, h)
+}
+
+func (f *fakeAWX) record(format string, args ...any) {
+	f.calls = append(f.calls, fmt.Sprintf(format, args...))
+}
+
+// requester starts the server and returns a client for it.
+func (f *fakeAWX) requester() framework.Requester {
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if f.missing {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if _, pattern := f.mux.Handler(r); pattern == "" {
+			f.t.Errorf("unexpected request %s %s", r.Method, r.URL.RequestURI())
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.mux.ServeHTTP(w, r)
+	}))
+	f.t.Cleanup(svr.Close)
+	return client.NewClientWithBasicAuth("admin", "admin", svr.URL, "test", true, nil, client.RetryConfig{})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// writeList answers results as the only page of an AWX list.
+func writeList(w http.ResponseWriter, results []any) {
+	writeJSON(w, map[string]any{"count": len(results), "next": nil, "results": results})
+}
+
+// association decodes an associate or disassociate request into the ID of
+// the object and "+" or "-".
+func association(t *testing.T, r *http.Request) (int64, string) {
+	var body struct {
+		ID           int64 `json:"id"`
+		Disassociate bool  `json:"disassociate"`
+	}
+	require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+	if body.Disassociate {
+		return body.ID, "-"
+	}
+	return body.ID, "+"
+}
+
+func resourceSchema(t *testing.T, r resource.Resource) schema.Schema {
+	t.Helper()
+	resp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	return resp.Schema
+}
+
+func nullValue(s schema.Schema) tftypes.Value {
+	return tftypes.NewValue(s.Type().TerraformType(context.Background()), nil)
+}
+
+// objectValue builds a value of s with the given attributes set and every
+// other attribute null.
+func objectValue(t *testing.T, s schema.Schema, values map[string]attr.Value) tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+	state := tfsdk.State{Schema: s, Raw: nullValue(s)}
+	for name, v := range values {
+		require.False(t, state.SetAttribute(ctx, path.Root(name), v).HasError(), name)
+	}
+	return state.Raw
+}
+
+func int64Set(ids ...int64) types.Set {
+	elements := make([]attr.Value, 0, len(ids))
+	for _, id := range ids {
+		elements = append(elements, types.Int64Value(id))
+	}
+	return types.SetValueMust(types.Int64Type, elements)
+}
+
+// stateAttribute returns the attribute name of state.
+func stateAttribute[T attr.Value](t *testing.T, state tfsdk.State, name string) T {
+	t.Helper()
+	var v T
+	require.False(t, state.GetAttribute(context.Background(), path.Root(name), &v).HasError(), name)
+	return v
+}
+
+// applyResource runs Create when prior is null, Delete when plan is null and
+// Update otherwise, and returns the resulting state.
+func applyResource(r resource.Resource, s schema.Schema, prior, plan tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	ctx := context.Background()
+	state := tfsdk.State{Schema: s, Raw: prior}
+	switch {
+	case prior.IsNull():
+		resp := &resource.CreateResponse{State: tfsdk.State{Schema: s, Raw: nullValue(s)}}
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: plan}}, resp)
+		return resp.State, resp.Diagnostics
+	case plan.IsNull():
+		resp := &resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+		return resp.State, resp.Diagnostics
+	}
+	resp := &resource.UpdateResponse{State: state}
+	r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: s, Raw: plan}, State: state}, resp)
+	return resp.State, resp.Diagnostics
+}
+
+func readResource(r resource.Resource, s schema.Schema, prior tftypes.Value) (tfsdk.State, diag.Diagnostics) {
+	state := tfsdk.State{Schema: s, Raw: prior}
+	resp := &resource.ReadResponse{State: state}
+	r.Read(context.Background(), resource.ReadRequest{State: state}, resp)
+	return resp.State, resp.Diagnostics
+}
@@ -0,0 +1,286 @@
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	p "path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ImportLookup resolves the import IDs that are not a plain numeric ID into
+// the numeric AWX ID of the object. Three forms are supported:
+//
+//   - `key=value[,key=value...]`, e.g. `name=Deploy App,organization=Default`.
+//     The keys must cover one of the resource's search groups; any other key
+//     is sent as an AWX filter. Foreign keys may be given by name, in which
+//     case they are matched with `<key>__name`.
+//   - `value/value...` when IDFields is set, e.g. `12/deploy` for
+//     workflow_job_template=12,identifier=deploy. The last field takes the
+//     rest of the ID, slashes included.
+//   - an AWX named URL, e.g. `Deploy App++Default`, which AWX resolves itself.
+type ImportLookup struct {
+	Endpoint     string
+	SearchGroups []SearchGroup
+	IDFields     []string
+	Attributes   map[string]rschema.Attribute
+	ResourceName string
+}
+
+// Resolve returns the numeric ID of the single object matching importID.
+func (l ImportLookup) Resolve(ctx context.Context, r Requester, importID string) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var values map[string]string
+	switch {
+	case strings.Contains(importID, "="):
+		var err error
+		if values, err = parseImportKeyValues(importID); err != nil {
+			diags.AddError(fmt.Sprintf("Invalid import ID for %s", l.ResourceName), err.Error())
+			return 0, diags
+		}
+	case len(l.IDFields) > 0 && strings.Contains(importID, "/"):
+		parts := strings.SplitN(importID, "/", len(l.IDFields))
+		if len(parts) != len(l.IDFields) {
+			diags.AddError(
+				fmt.Sprintf("Invalid import ID for %s", l.ResourceName),
+				fmt.Sprintf("expected <%s>, got %q", strings.Join(l.IDFields, ">/<"), importID),
+			)
+			return 0, diags
+		}
+		values = make(map[string]string, len(parts))
+		for i, field := range l.IDFields {
+			values[field] = parts[i]
+		}
+	default:
+		return l.resolveNamedURL(ctx, r, importID)
+	}
+
+	endpoint, err := l.searchEndpoint(values)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Invalid import ID for %s", l.ResourceName), err.Error())
+		return 0, diags
+	}
+
+	data, d := ReadRequest(ctx, r, endpoint, l.ResourceName)
+	if DiagnosticsHasError(&diags, d...) {
+		return 0, diags
+	}
+	if _, isList := data["results"]; !isList {
+		return importIDFromData(data, l.ResourceName, importID)
+	}
+
+	results, _ := data["results"].([]any)
+	count, _ := strconv.Atoi(fmt.Sprint(data["count"]))
+	count = max(count, len(results))
+	switch count {
+	case 0:
+		diags.AddError(
+			fmt.Sprintf("No %s matches the import ID", l.ResourceName),
+			fmt.Sprintf("AWX returned no results for %q (%s).", importID, endpoint),
+		)
+		return 0, diags
+	case 1:
+		item, _ := results[0].(map[string]any)
+		return importIDFromData(item, l.ResourceName, importID)
+	}
+
+	var ids []string
+	for _, result := range results {
+		if item, ok := result.(map[string]any); ok {
+			ids = append(ids, fmt.Sprint(item["id"]))
+		}
+	}
+	diags.AddError(
+		fmt.Sprintf("Multiple %s objects match the import ID", l.ResourceName),
+		fmt.Sprintf("AWX returned %d results for %q (IDs: %s). Add more keys to narrow it down, or import by ID.", count, importID, strings.Join(ids, ", ")),
+	)
+	return 0, diags
+}
+
+func (l ImportLookup) resolveNamedURL(ctx context.Context, r Requester, namedURL string) (int64, diag.Diagnostics) {
+	endpoint := CleanEndpoint(l.Endpoint) + namedURLPath(namedURL) + "/"
+	data, found, diags := ReadRequestAllowNotFound(ctx, r, endpoint, l.ResourceName)
+	if !found && !diags.HasError() {
+		diags.AddError(
+			fmt.Sprintf("No %s matches the import ID", l.ResourceName),
+			fmt.Sprintf("AWX could not resolve the named URL %q. Named URLs join the identifying fields with `++`, e.g. `Deploy App++Default` for a job template in the Default organization.", namedURL),
+		)
+		return 0, diags
+	}
+	if diags.HasError() {
+		return 0, diags
+	}
+	return importIDFromData(data, l.ResourceName, namedURL)
+}
+
+// namedURLPath makes a named URL, as shown by AWX, safe to use as a path
+// segment. It is already escaped the AWX way, so `%`, `[`, `]` and `+` are
+// kept as they are.
+func namedURLPath(namedURL string) string {
+	var b strings.Builder
+	for _, r := range namedURL {
+		if strings.ContainsRune("%[]+", r) {
+			b.WriteRune(r)
+			continue
+		}
+		b.WriteString(url.PathEscape(string(r)))
+	}
+	return b.String()
+}
+
+// searchEndpoint picks the search group covering the most keys and appends
+// the remaining keys as AWX filters.
+func (l ImportLookup) searchEndpoint(values map[string]string) (string, error) {
+	var group *SearchGroup
+	var params []any
+	for i, g := range l.SearchGroups {
+		if group != nil && len(g.Fields) <= len(group.Fields) {
+			continue
+		}
+		gp, ok := searchGroupParams(g, values)
+		if ok {
+			group, params = &l.SearchGroups[i], gp
+		}
+	}
+	if group == nil {
+		return "", fmt.Errorf("the keys %s do not identify a %s, expected one of: %s",
+			strings.Join(sortedKeys(values), ", "), l.ResourceName, l.supportedKeys())
+	}
+
+	endpoint := p.Clean(fmt.Sprintf("%s/"+group.URLSuffix, append([]any{l.Endpoint}, params...)...))
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+	for _, key := range sortedKeys(values) {
+		if slices.ContainsFunc(group.Fields, func(f SearchField) bool { return f.Name == key }) {
+			continue
+		}
+		a, ok := l.Attributes[key]
+		if !ok {
+			return "", fmt.Errorf("%q is not an attribute of %s", key, l.ResourceName)
+		}
+		if _, err := strconv.ParseInt(values[key], 10, 64); err != nil && a.GetType().Equal(types.Int64Type) {
+			// Related objects are referenced by ID, let AWX match them by name.
+			query.Set(key+"__name", values[key])
+			continue
+		}
+		query.Set(key, values[key])
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+func (l ImportLookup) supportedKeys() string {
+	var out []string
+	for _, g := range l.SearchGroups {
+		var names []string
+		for _, f := range g.Fields {
+			names = append(names, f.Name+"=...")
+		}
+		out = append(out, strings.Join(names, ","))
+	}
+	return strings.Join(out, " | ")
+}
+
+// searchGroupParams returns the fmt parameters for the URLSuffix of g when
+// values provide every field of the group with the right type.
+func searchGroupParams(g SearchGroup, values map[string]string) ([]any, bool) {
+	var params []any
+	for _, field := range g.Fields {
+		v, ok := values[field.Name]
+		if !ok {
+			return nil, false
+		}
+		switch field.Type {
+		case "int64":
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, false
+			}
+			params = append(params, n)
+		default:
+			params = append(params, g.value(field, v))
+		}
+	}
+	return params, len(params) > 0
+}
+
+// parseImportKeyValues parses `key=value,key=value`. A literal comma inside a
+// value is written as `\,`.
+func parseImportKeyValues(id string) (map[string]string, error) {
+	values := map[string]string{}
+	for _, pair := range splitUnescaped(id, ',') {
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("expected key=value, got %q", pair)
+		}
+		if _, dup := values[key]; dup {
+			return nil, fmt.Errorf("key %q is set more than once", key)
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+func splitUnescaped(s string, sep byte) []string {
+	var parts []string
+	var current strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && i+1 < len(s) && s[i+1] == sep:
+			current.WriteByte(sep)
+			i++
+		case s[i] == sep:
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(s[i])
+		}
+	}
+	return append(parts, current.String())
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func importIDFromData(data map[string]any, resourceName, importID string) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	id, err := int64FromAPI(data["id"])
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to read the ID of the %s matching %q", resourceName, importID), err.Error())
+	}
+	return id, diags
+}
+
+// int64FromAPI converts a numeric AWX ID, as decoded from JSON, into an int64.
+func int64FromAPI(v any) (int64, error) {
+	switch v := v.(type) {
+	case json.Number:
+		return v.Int64()
+	case float64:
+		return int64(v), nil
+	case int64:
+		return v, nil
+	case int:
+		return int64(v), nil
+	default:
+		return 0, fmt.Errorf("expected a numeric id, got %T", v)
+	}
+}
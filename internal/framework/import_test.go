@@ -0,0 +1,197 @@
+package framework_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+var importSchema = rschema.Schema{
+	Attributes: map[string]rschema.Attribute{
+		"id":           rschema.Int64Attribute{Computed: true},
+		"name":         rschema.StringAttribute{Required: true},
+		"organization": rschema.Int64Attribute{Optional: true},
+		"kind":         rschema.StringAttribute{Optional: true},
+	},
+}
+
+var importSearchGroups = []framework.SearchGroup{
+	{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{
+		{Name: "id", Type: "int64"},
+	}},
+	{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{
+		{Name: "name", Type: "string", URLEscape: true},
+	}},
+	{Name: "by_name_organization", URLSuffix: "?name__exact=%s&organization=%d", Fields: []framework.SearchField{
+		{Name: "name", Type: "string", URLEscape: true},
+		{Name: "organization", Type: "int64"},
+	}},
+}
+
+func TestGenericResource_ImportState(t *testing.T) {
+	tests := []struct {
+		name        string
+		importID    string
+		wantPath    string
+		wantQuery   string
+		status      int
+		response    string
+		wantID      int64
+		wantInError string
+	}{
+		{
+			name:     "numeric id is used as is",
+			importID: "42",
+			wantID:   42,
+		},
+		{
+			name:      "by name",
+			importID:  "name=Deploy App",
+			wantPath:  "/api/v2/named/",
+			wantQuery: "name__exact=Deploy+App",
+			response:  `{"count":1,"results":[{"id":7,"name":"Deploy App"}]}`,
+			wantID:    7,
+		},
+		{
+			name:      "search group with the most matching fields wins",
+			importID:  "name=Deploy App,organization=3",
+			wantPath:  "/api/v2/named/",
+			wantQuery: "name__exact=Deploy+App&organization=3",
+			response:  `{"count":1,"results":[{"id":8}]}`,
+			wantID:    8,
+		},
+		{
+			name:      "related object by name",
+			importID:  "name=Deploy App,organization=Default",
+			wantPath:  "/api/v2/named/",
+			wantQuery: "name__exact=Deploy+App&organization__name=Default",
+			response:  `{"count":1,"results":[{"id":9}]}`,
+			wantID:    9,
+		},
+		{
+			name:      "extra keys are sent as filters and commas can be escaped",
+			importID:  `name=a\,b,kind=smart`,
+			wantPath:  "/api/v2/named/",
+			wantQuery: "kind=smart&name__exact=a%2Cb",
+			response:  `{"count":1,"results":[{"id":10}]}`,
+			wantID:    10,
+		},
+		{
+			name:     "named url",
+			importID: "Deploy App++Default",
+			wantPath: "/api/v2/named/Deploy App++Default/",
+			response: `{"id":11,"name":"Deploy App"}`,
+			wantID:   11,
+		},
+		{
+			name:        "named url not found",
+			importID:    "Missing++Default",
+			wantPath:    "/api/v2/named/Missing++Default/",
+			status:      http.StatusNotFound,
+			response:    `{"detail":"Not found."}`,
+			wantInError: "could not resolve the named URL",
+		},
+		{
+			name:        "no matches",
+			importID:    "name=nothing",
+			wantPath:    "/api/v2/named/",
+			wantQuery:   "name__exact=nothing",
+			response:    `{"count":0,"results":[]}`,
+			wantInError: "No named matches the import ID",
+		},
+		{
+			name:        "multiple matches",
+			importID:    "name=web",
+			wantPath:    "/api/v2/named/",
+			wantQuery:   "name__exact=web",
+			response:    `{"count":2,"results":[{"id":1},{"id":2}]}`,
+			wantInError: "IDs: 1, 2",
+		},
+		{
+			name:      "value/value form",
+			importID:  "3/Deploy App",
+			wantPath:  "/api/v2/named/",
+			wantQuery: "name__exact=Deploy+App&organization=3",
+			response:  `{"count":1,"results":[{"id":12}]}`,
+			wantID:    12,
+		},
+		{
+			name:      "value/value form keeps slashes in the last value",
+			importID:  "3/team/app",
+			wantPath:  "/api/v2/named/",
+			wantQuery: "name__exact=team%2Fapp&organization=3",
+			response:  `{"count":1,"results":[{"id":13}]}`,
+			wantID:    13,
+		},
+		{
+			name:      "value/value form with a related object by name",
+			importID:  "Default/Deploy",
+			wantPath:  "/api/v2/named/",
+			wantQuery: "name__exact=Deploy&organization__name=Default",
+			response:  `{"count":1,"results":[{"id":14}]}`,
+			wantID:    14,
+		},
+		{
+			name:        "keys not covering a search group",
+			importID:    "kind=smart",
+			wantInError: "expected one of: id=... | name=... | name=...,organization=...",
+		},
+		{
+			name:        "unknown attribute",
+			importID:    "name=web,colour=blue",
+			wantInError: `"colour" is not an attribute of named`,
+		},
+		{
+			name:        "malformed pair",
+			importID:    "name=web,organization",
+			wantInError: `expected key=value, got "organization"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newNamedResource(t, func(w http.ResponseWriter, req *http.Request) {
+				if tt.wantPath == "" {
+					t.Errorf("unexpected request to %s", req.URL)
+				}
+				assert.Equal(t, tt.wantPath, req.URL.Path)
+				assert.Equal(t, tt.wantQuery, req.URL.RawQuery)
+				if tt.status != 0 {
+					w.WriteHeader(tt.status)
+				}
+				_, _ = w.Write([]byte(tt.response))
+			})
+			r.Cfg.Schema = importSchema
+			r.Cfg.SearchGroups = importSearchGroups
+			r.Cfg.ImportIDFields = []string{"organization", "name"}
+
+			ctx := context.Background()
+			resp := &resource.ImportStateResponse{State: tfsdk.State{
+				Schema: importSchema,
+				Raw:    tftypes.NewValue(importSchema.Type().TerraformType(ctx), nil),
+			}}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.importID}, resp)
+
+			if tt.wantInError != "" {
+				require.True(t, resp.Diagnostics.HasError())
+				assert.Contains(t, resp.Diagnostics.Errors()[0].Summary()+" "+resp.Diagnostics.Errors()[0].Detail(), tt.wantInError)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			var id types.Int64
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("id"), &id).HasError())
+			assert.Equal(t, tt.wantID, id.ValueInt64())
+		})
+	}
+}
@@ -0,0 +1,696 @@
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	p "path"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ resource.Resource               = (*LaunchResource)(nil)
+	_ resource.ResourceWithConfigure  = (*LaunchResource)(nil)
+	_ resource.ResourceWithModifyPlan = (*LaunchResource)(nil)
+)
+
+const launchDefaultTimeout = 30 * time.Minute
+
+var (
+	launchSuccessValues = []string{"successful"}
+	launchFailureValues = []string{"failed", "error", "canceled"}
+)
+
+// LaunchPromptKind is the Terraform type of a launch prompt.
+type LaunchPromptKind int
+
+const (
+	LaunchPromptString LaunchPromptKind = iota
+	LaunchPromptInt64
+	LaunchPromptBool
+	// LaunchPromptIDSet is a set of IDs of related objects.
+	LaunchPromptIDSet
+	// LaunchPromptIDList is an ordered list of IDs of related objects.
+	LaunchPromptIDList
+)
+
+// LaunchPrompt is a value that may be given when launching a template. AWX
+// only accepts it when the template asks for it on launch, and ignores it
+// otherwise.
+type LaunchPrompt struct {
+	// Attribute is the schema attribute name.
+	Attribute string
+	// Field is the field sent to the launch endpoint.
+	Field string
+	// AskFlag is the ask_*_on_launch flag of the template that allows it.
+	AskFlag     string
+	Kind        LaunchPromptKind
+	Description string
+	// Validators apply to string and int64 prompts.
+	StringValidators []validator.String
+	Int64Validators  []validator.Int64
+}
+
+// JobLaunchPrompts are the prompts of a job template launch.
+var JobLaunchPrompts = []LaunchPrompt{
+	{Attribute: "extra_vars", Field: "extra_vars", AskFlag: "ask_variables_on_launch", Kind: LaunchPromptString, Description: "Extra variables for the job, as JSON or YAML. Accepted when the template prompts for variables or has a survey enabled."},
+	{Attribute: "inventory", Field: "inventory", AskFlag: "ask_inventory_on_launch", Kind: LaunchPromptInt64, Description: "Inventory to run the job against."},
+	{Attribute: "limit", Field: "limit", AskFlag: "ask_limit_on_launch", Kind: LaunchPromptString, Description: "Host pattern to further constrain the list of hosts."},
+	{Attribute: "credential_ids", Field: "credentials", AskFlag: "ask_credential_on_launch", Kind: LaunchPromptIDSet, Description: "Credentials to use for the job, replacing the template credentials of the same type."},
+	{Attribute: "job_tags", Field: "job_tags", AskFlag: "ask_tags_on_launch", Kind: LaunchPromptString, Description: "Comma separated list of tags to run."},
+	{Attribute: "skip_tags", Field: "skip_tags", AskFlag: "ask_skip_tags_on_launch", Kind: LaunchPromptString, Description: "Comma separated list of tags to skip."},
+	{Attribute: "job_type", Field: "job_type", AskFlag: "ask_job_type_on_launch", Kind: LaunchPromptString, Description: "Job type, `run` or `check`.", StringValidators: []validator.String{stringvalidator.OneOf("run", "check")}},
+	{Attribute: "verbosity", Field: "verbosity", AskFlag: "ask_verbosity_on_launch", Kind: LaunchPromptInt64, Description: "Verbosity, from 0 (normal) to 5 (WinRM debug).", Int64Validators: []validator.Int64{int64validator.Between(0, 5)}},
+	{Attribute: "diff_mode", Field: "diff_mode", AskFlag: "ask_diff_mode_on_launch", Kind: LaunchPromptBool, Description: "Show the changes made by Ansible tasks, where supported."},
+	{Attribute: "scm_branch", Field: "scm_branch", AskFlag: "ask_scm_branch_on_launch", Kind: LaunchPromptString, Description: "Branch to use for the project of the job template."},
+	{Attribute: "execution_environment", Field: "execution_environment", AskFlag: "ask_execution_environment_on_launch", Kind: LaunchPromptInt64, Description: "Execution environment to run the job in."},
+	{Attribute: "label_ids", Field: "labels", AskFlag: "ask_labels_on_launch", Kind: LaunchPromptIDSet, Description: "Labels to attach to the job."},
+	{Attribute: "forks", Field: "forks", AskFlag: "ask_forks_on_launch", Kind: LaunchPromptInt64, Description: "Number of parallel processes.", Int64Validators: []validator.Int64{int64validator.AtLeast(0)}},
+	{Attribute: "job_slice_count", Field: "job_slice_count", AskFlag: "ask_job_slice_count_on_launch", Kind: LaunchPromptInt64, Description: "Number of slices to run the job in.", Int64Validators: []validator.Int64{int64validator.AtLeast(0)}},
+	{Attribute: "timeout", Field: "timeout", AskFlag: "ask_timeout_on_launch", Kind: LaunchPromptInt64, Description: "Seconds to run the job before it is canceled, 0 for no timeout.", Int64Validators: []validator.Int64{int64validator.AtLeast(0)}},
+	{Attribute: "instance_group_ids", Field: "instance_groups", AskFlag: "ask_instance_groups_on_launch", Kind: LaunchPromptIDList, Description: "Instance groups to run the job on, in order of preference."},
+}
+
+// WorkflowJobLaunchPrompts are the prompts of a workflow job template launch.
+var WorkflowJobLaunchPrompts = []LaunchPrompt{
+	{Attribute: "extra_vars", Field: "extra_vars", AskFlag: "ask_variables_on_launch", Kind: LaunchPromptString, Description: "Extra variables for the workflow job, as JSON or YAML. Accepted when the template prompts for variables or has a survey enabled."},
+	{Attribute: "inventory", Field: "inventory", AskFlag: "ask_inventory_on_launch", Kind: LaunchPromptInt64, Description: "Inventory applied to the nodes of the workflow that prompt for one."},
+	{Attribute: "limit", Field: "limit", AskFlag: "ask_limit_on_launch", Kind: LaunchPromptString, Description: "Host pattern to further constrain the list of hosts."},
+	{Attribute: "scm_branch", Field: "scm_branch", AskFlag: "ask_scm_branch_on_launch", Kind: LaunchPromptString, Description: "Branch to use for the nodes of the workflow that prompt for one."},
+	{Attribute: "label_ids", Field: "labels", AskFlag: "ask_labels_on_launch", Kind: LaunchPromptIDSet, Description: "Labels to attach to the workflow job."},
+	{Attribute: "job_tags", Field: "job_tags", AskFlag: "ask_tags_on_launch", Kind: LaunchPromptString, Description: "Comma separated list of tags to run."},
+	{Attribute: "skip_tags", Field: "skip_tags", AskFlag: "ask_skip_tags_on_launch", Kind: LaunchPromptString, Description: "Comma separated list of tags to skip."},
+}
+
+var launchNodeAttrTypes = map[string]attr.Type{
+	"id":                   types.Int64Type,
+	"identifier":           types.StringType,
+	"unified_job_template": types.Int64Type,
+	"job_id":               types.Int64Type,
+	"status":               types.StringType,
+	"do_not_run":           types.BoolType,
+}
+
+// LaunchConfig configures a LaunchResource.
+type LaunchConfig struct {
+	// TypeName is the resource type name, e.g. "job_launch".
+	TypeName string
+	// Endpoint is the endpoint of the templates, e.g. "/api/v2/job_templates/".
+	Endpoint string
+	// JobEndpoint is the endpoint of the launched jobs, e.g. "/api/v2/jobs/".
+	JobEndpoint string
+	// TemplateAttribute is the schema attribute holding the template ID.
+	TemplateAttribute string
+	// TemplateName and JobName are used in diagnostics.
+	TemplateName string
+	JobName      string
+	Prompts      []LaunchPrompt
+	// WorkflowNodes reports the nodes of the finished workflow job in the
+	// nodes attribute.
+	WorkflowNodes bool
+}
+
+// NewJobLaunchResource returns a LaunchResource that launches job templates.
+func NewJobLaunchResource(typeName, endpoint, jobEndpoint string) resource.Resource {
+	return NewLaunchResource(LaunchConfig{
+		TypeName:          typeName,
+		Endpoint:          endpoint,
+		JobEndpoint:       jobEndpoint,
+		TemplateAttribute: "job_template_id",
+		TemplateName:      "JobTemplate",
+		JobName:           "Job",
+		Prompts:           JobLaunchPrompts,
+	})
+}
+
+// NewWorkflowJobLaunchResource returns a LaunchResource that launches
+// workflow job templates.
+func NewWorkflowJobLaunchResource(typeName, endpoint, jobEndpoint string) resource.Resource {
+	return NewLaunchResource(LaunchConfig{
+		TypeName:          typeName,
+		Endpoint:          endpoint,
+		JobEndpoint:       jobEndpoint,
+		TemplateAttribute: "workflow_job_template_id",
+		TemplateName:      "WorkflowJobTemplate",
+		JobName:           "WorkflowJob",
+		Prompts:           WorkflowJobLaunchPrompts,
+		WorkflowNodes:     true,
+	})
+}
+
+// LaunchResource launches a template when created, and launches it again
+// whenever the launch is replaced, i.e. when the template, a prompt or the
+// triggers change. Destroying it keeps the job in AWX.
+type LaunchResource struct {
+	ResourceBase
+	Cfg LaunchConfig
+}
+
+// NewLaunchResource constructs a LaunchResource.
+func NewLaunchResource(cfg LaunchConfig) resource.Resource {
+	return &LaunchResource{
+		ResourceBase: ResourceBase{
+			ProviderBase: ProviderBase{TypeName: cfg.TypeName, Endpoint: cfg.Endpoint},
+		},
+		Cfg: cfg,
+	}
+}
+
+// Schema defines the schema for the resource.
+func (o *LaunchResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		o.Cfg.TemplateAttribute: schema.Int64Attribute{
+			Description: fmt.Sprintf("ID of the %s to launch.", o.Cfg.TemplateName),
+			Required:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"triggers": schema.MapAttribute{
+			Description: "Arbitrary values that launch the template again when they change.",
+			ElementType: types.StringType,
+			Optional:    true,
+			PlanModifiers: []planmodifier.Map{
+				mapplanmodifier.RequiresReplace(),
+			},
+		},
+		"wait_for_completion": schema.BoolAttribute{
+			Description: "If true, wait for the launched job to finish and fail when it does not succeed. Configure the maximum wait via the timeouts block.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"job_id": schema.Int64Attribute{
+			Description: fmt.Sprintf("ID of the launched %s.", o.Cfg.JobName),
+			Computed:    true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"status": schema.StringAttribute{
+			Description: "Status of the launched job.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"failed": schema.BoolAttribute{
+			Description: "Whether the launched job failed.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"elapsed": schema.Float64Attribute{
+			Description: "Elapsed time of the launched job in seconds.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.Float64{
+				float64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"artifacts": schema.StringAttribute{
+			Description: "Artifacts of the launched job as JSON, as set by the set_stats module.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+	for _, prompt := range o.Cfg.Prompts {
+		attributes[prompt.Attribute] = prompt.schemaAttribute()
+	}
+	if o.Cfg.WorkflowNodes {
+		attributes["nodes"] = schema.ListNestedAttribute{
+			Description: "Nodes of the workflow job, once it has finished.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.Int64Attribute{
+						Description: "Database ID of the workflow job node.",
+						Computed:    true,
+					},
+					"identifier": schema.StringAttribute{
+						Description: "Identifier of the workflow job template node the node was created from.",
+						Computed:    true,
+					},
+					"unified_job_template": schema.Int64Attribute{
+						Description: "Template the node runs.",
+						Computed:    true,
+					},
+					"job_id": schema.Int64Attribute{
+						Description: "ID of the job the node spawned, null when it did not run.",
+						Computed:    true,
+					},
+					"status": schema.StringAttribute{
+						Description: "Status of the job the node spawned, empty when it did not run.",
+						Computed:    true,
+					},
+					"do_not_run": schema.BoolAttribute{
+						Description: "Whether the node was skipped because of the outcome of its parents.",
+						Computed:    true,
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Launches a %s. Changing the template, a prompt or the triggers launches it again; destroying the resource keeps the job in AWX.", o.Cfg.TemplateName),
+		Attributes:  attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true}),
+		},
+	}
+}
+
+func (prompt LaunchPrompt) schemaAttribute() schema.Attribute {
+	switch prompt.Kind {
+	case LaunchPromptInt64:
+		return schema.Int64Attribute{
+			Description:   prompt.Description,
+			Optional:      true,
+			Validators:    prompt.Int64Validators,
+			PlanModifiers: []planmodifier.Int64{int64planmodifier.RequiresReplace()},
+		}
+	case LaunchPromptBool:
+		return schema.BoolAttribute{
+			Description:   prompt.Description,
+			Optional:      true,
+			PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+		}
+	case LaunchPromptIDSet:
+		return schema.SetAttribute{
+			Description:   prompt.Description,
+			ElementType:   types.Int64Type,
+			Optional:      true,
+			PlanModifiers: []planmodifier.Set{setplanmodifier.RequiresReplace()},
+		}
+	case LaunchPromptIDList:
+		return schema.ListAttribute{
+			Description:   prompt.Description,
+			ElementType:   types.Int64Type,
+			Optional:      true,
+			PlanModifiers: []planmodifier.List{listplanmodifier.RequiresReplace()},
+		}
+	default:
+		return schema.StringAttribute{
+			Description:   prompt.Description,
+			Optional:      true,
+			Validators:    prompt.StringValidators,
+			PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+		}
+	}
+}
+
+// value reads the prompt from src. known is false while the value is unknown,
+// set is false when it is null.
+func (prompt LaunchPrompt) value(ctx context.Context, src attributeReader, diags *diag.Diagnostics) (value any, known bool, set bool) {
+	root := path.Root(prompt.Attribute)
+	switch prompt.Kind {
+	case LaunchPromptInt64:
+		var v types.Int64
+		diags.Append(src.GetAttribute(ctx, root, &v)...)
+		return v.ValueInt64(), !v.IsUnknown(), !v.IsNull() && !v.IsUnknown()
+	case LaunchPromptBool:
+		var v types.Bool
+		diags.Append(src.GetAttribute(ctx, root, &v)...)
+		return v.ValueBool(), !v.IsUnknown(), !v.IsNull() && !v.IsUnknown()
+	case LaunchPromptIDSet:
+		var v types.Set
+		diags.Append(src.GetAttribute(ctx, root, &v)...)
+		if v.IsNull() || v.IsUnknown() {
+			return nil, !v.IsUnknown(), false
+		}
+		ids := make([]int64, 0, len(v.Elements()))
+		diags.Append(v.ElementsAs(ctx, &ids, true)...)
+		slices.Sort(ids)
+		return ids, !diags.HasError(), true
+	case LaunchPromptIDList:
+		var v types.List
+		diags.Append(src.GetAttribute(ctx, root, &v)...)
+		if v.IsNull() || v.IsUnknown() {
+			return nil, !v.IsUnknown(), false
+		}
+		ids := make([]int64, 0, len(v.Elements()))
+		diags.Append(v.ElementsAs(ctx, &ids, true)...)
+		return ids, !diags.HasError(), true
+	default:
+		var v types.String
+		diags.Append(src.GetAttribute(ctx, root, &v)...)
+		return v.ValueString(), !v.IsUnknown(), !v.IsNull() && !v.IsUnknown()
+	}
+}
+
+// ModifyPlan checks the prompts against the template before a launch is
+// planned, so a prompt AWX would ignore is reported at plan time.
+func (o *LaunchResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if o.Client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	if !req.State.Raw.IsNull() && len(resp.RequiresReplace) == 0 {
+		return
+	}
+
+	var templateID types.Int64
+	if DiagnosticsHasError(&resp.Diagnostics, req.Plan.GetAttribute(ctx, path.Root(o.Cfg.TemplateAttribute), &templateID)...) {
+		return
+	}
+	if templateID.IsUnknown() {
+		return
+	}
+	o.validatePrompts(ctx, &req.Plan, templateID.ValueInt64(), &resp.Diagnostics)
+}
+
+// validatePrompts reports the prompts the template does not ask for on
+// launch, and the survey answers it needs to start that are missing.
+func (o *LaunchResource) validatePrompts(ctx context.Context, src attributeReader, templateID int64, diags *diag.Diagnostics) {
+	info, d := ReadRequest(ctx, o.Client, o.launchEndpoint(templateID), o.Cfg.TemplateName)
+	if DiagnosticsHasError(diags, d...) {
+		return
+	}
+	surveyEnabled, _ := info["survey_enabled"].(bool)
+
+	for _, prompt := range o.Cfg.Prompts {
+		value, known, set := prompt.value(ctx, src, diags)
+		if prompt.Field == "extra_vars" && known {
+			validateSurveyAnswers(info, prompt, value.(string), diags)
+		}
+		if !set {
+			continue
+		}
+		if asked, _ := info[prompt.AskFlag].(bool); asked || (prompt.Field == "extra_vars" && surveyEnabled) {
+			continue
+		}
+		diags.AddAttributeError(
+			path.Root(prompt.Attribute),
+			fmt.Sprintf("%s does not prompt for %s on launch", o.Cfg.TemplateName, prompt.Attribute),
+			fmt.Sprintf("%s %d has %s disabled, so AWX would ignore %s. Enable %s on the template or remove %s.",
+				o.Cfg.TemplateName, templateID, prompt.AskFlag, prompt.Attribute, prompt.AskFlag, prompt.Attribute),
+		)
+	}
+}
+
+// validateSurveyAnswers reports the variables the template needs to start,
+// the required survey questions without a default, that extraVars misses.
+func validateSurveyAnswers(info map[string]any, prompt LaunchPrompt, extraVars string, diags *diag.Diagnostics) {
+	needed, _ := info["variables_needed_to_start"].([]any)
+	if len(needed) == 0 {
+		return
+	}
+	answers := map[string]any{}
+	if err := yaml.Unmarshal([]byte(extraVars), &answers); err != nil {
+		diags.AddAttributeError(path.Root(prompt.Attribute), "Invalid extra variables", fmt.Sprintf("%s must be a JSON or YAML object: %s", prompt.Attribute, err))
+		return
+	}
+	var missing []string
+	for _, name := range needed {
+		if _, ok := answers[fmt.Sprint(name)]; !ok {
+			missing = append(missing, fmt.Sprint(name))
+		}
+	}
+	if len(missing) > 0 {
+		diags.AddAttributeError(path.Root(prompt.Attribute), "Missing survey answers",
+			fmt.Sprintf("The survey requires an answer for %v, set them in %s.", missing, prompt.Attribute))
+	}
+}
+
+// Create launches the template, and waits for the job to finish when
+// wait_for_completion is set. The launch is kept in state even when the job
+// fails, so Terraform taints it and launches again on the next apply.
+func (o *LaunchResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var templateID types.Int64
+	if DiagnosticsHasError(&response.Diagnostics, request.Plan.GetAttribute(ctx, path.Root(o.Cfg.TemplateAttribute), &templateID)...) {
+		return
+	}
+	o.validatePrompts(ctx, &request.Plan, templateID.ValueInt64(), &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	body := map[string]any{}
+	for _, prompt := range o.Cfg.Prompts {
+		if value, _, set := prompt.value(ctx, &request.Plan, &response.Diagnostics); set {
+			body[prompt.Field] = value
+		}
+	}
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data, d := CreateUpdateRequest(ctx, o.Client, http.MethodPost, o.launchEndpoint(templateID.ValueInt64()), body, o.Cfg.TemplateName, "launch")
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	jobID, err := launchedJobID(data)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("Unexpected response while launching %s %d", o.Cfg.TemplateName, templateID.ValueInt64()), err.Error())
+		return
+	}
+
+	response.State.Raw = request.Plan.Raw
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("job_id"), types.Int64Value(jobID))...)
+	o.setJob(ctx, &response.State, jobID, data, &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var wait types.Bool
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("wait_for_completion"), &wait)...)
+	if response.Diagnostics.HasError() || !wait.ValueBool() {
+		return
+	}
+
+	var tv timeouts.Value
+	if DiagnosticsHasError(&response.Diagnostics, request.Plan.GetAttribute(ctx, path.Root("timeouts"), &tv)...) {
+		return
+	}
+	timeout, d := tv.Create(ctx, launchDefaultTimeout)
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	o.wait(ctx, &response.State, jobID, timeout, &response.Diagnostics)
+}
+
+// wait polls the job until it finishes, then records the final job.
+func (o *LaunchResource) wait(ctx context.Context, state attributeWriter, jobID int64, timeout time.Duration, diags *diag.Diagnostics) {
+	endpoint := o.jobEndpoint(jobID)
+	tflog.Debug(ctx, fmt.Sprintf("[%s/wait] polling for terminal status", o.Cfg.JobName), map[string]any{
+		"endpoint": endpoint,
+		"timeout":  timeout.String(),
+	})
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err := WaitForFieldValue(waitCtx, o.Client, WaitForFieldOpts{
+		Endpoint:      endpoint,
+		Field:         "status",
+		SuccessValues: launchSuccessValues,
+		FailureValues: launchFailureValues,
+	})
+
+	var term *WaitTerminalError
+	if err != nil && !errors.As(err, &term) {
+		diags.AddError(fmt.Sprintf("Timed out or failed waiting for %s on %s", o.Cfg.JobName, endpoint), err.Error())
+		return
+	}
+
+	job, d := ReadRequest(ctx, o.Client, endpoint, o.Cfg.JobName)
+	if DiagnosticsHasError(diags, d...) {
+		return
+	}
+	nodes := o.setJob(ctx, state, jobID, job, diags)
+	if term == nil {
+		return
+	}
+	detail := "Check the job output in AWX for details."
+	if explanation, _ := job["job_explanation"].(string); explanation != "" {
+		detail = explanation
+	}
+	diags.AddError(fmt.Sprintf("%s %d reached terminal failure status %q on %s", o.Cfg.JobName, jobID, term.Status, endpoint), detail)
+	for _, node := range nodes {
+		summary, _ := node["summary_fields"].(map[string]any)
+		nodeJob, _ := summary["job"].(map[string]any)
+		status, _ := nodeJob["status"].(string)
+		if !slices.Contains(launchFailureValues, status) {
+			continue
+		}
+		diags.AddError(
+			fmt.Sprintf("Workflow node %q failed", fmt.Sprint(node["identifier"])),
+			fmt.Sprintf("Job %v (%v) of workflow job %d finished with status %q.", node["job"], nodeJob["name"], jobID, status),
+		)
+	}
+}
+
+// Read refreshes the outputs of the job. A job deleted from AWX keeps its
+// last known outputs, as removing the launch from state would launch again.
+func (o *LaunchResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var jobID types.Int64
+	if DiagnosticsHasError(&response.Diagnostics, request.State.GetAttribute(ctx, path.Root("job_id"), &jobID)...) {
+		return
+	}
+	if jobID.IsNull() {
+		return
+	}
+
+	job, found, d := ReadRequestAllowNotFound(ctx, o.Client, o.jobEndpoint(jobID.ValueInt64()), o.Cfg.JobName)
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	if !found {
+		tflog.Debug(ctx, fmt.Sprintf("[%s/read] Job no longer exists, keeping the last known outputs", o.Cfg.JobName), map[string]any{
+			"job_id": jobID.ValueInt64(),
+		})
+		return
+	}
+	o.setJob(ctx, &response.State, jobID.ValueInt64(), job, &response.Diagnostics)
+}
+
+// Update only stores the settings that do not launch again, such as
+// wait_for_completion and the timeouts.
+func (o *LaunchResource) Update(_ context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	response.State.Raw = request.Plan.Raw
+}
+
+// Delete removes the launch from state; the job is kept in AWX.
+func (o *LaunchResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+// setJob records the outputs of a job, as returned by the launch or the job
+// endpoint. The nodes of a finished workflow job are read and returned too.
+func (o *LaunchResource) setJob(ctx context.Context, state attributeWriter, jobID int64, job map[string]any, diags *diag.Diagnostics) []map[string]any {
+	status, _ := job["status"].(string)
+	failed, _ := job["failed"].(bool)
+	elapsed, _ := float64FromAPI(job["elapsed"])
+	artifacts := job["artifacts"]
+	if artifacts == nil {
+		artifacts = map[string]any{}
+	}
+	raw, err := json.Marshal(artifacts)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to encode the artifacts of %s", o.Cfg.JobName), err.Error())
+		return nil
+	}
+
+	diags.Append(state.SetAttribute(ctx, path.Root("status"), types.StringValue(status))...)
+	diags.Append(state.SetAttribute(ctx, path.Root("failed"), types.BoolValue(failed))...)
+	diags.Append(state.SetAttribute(ctx, path.Root("elapsed"), types.Float64Value(elapsed))...)
+	diags.Append(state.SetAttribute(ctx, path.Root("artifacts"), types.StringValue(string(raw)))...)
+	if !o.Cfg.WorkflowNodes {
+		return nil
+	}
+
+	nodeType := types.ObjectType{AttrTypes: launchNodeAttrTypes}
+	if !slices.Contains(launchSuccessValues, status) && !slices.Contains(launchFailureValues, status) {
+		diags.Append(state.SetAttribute(ctx, path.Root("nodes"), types.ListNull(nodeType))...)
+		return nil
+	}
+	nodes, d := ListAll(ctx, o.Client, p.Clean(fmt.Sprintf("%s/%d/workflow_nodes", o.Cfg.JobEndpoint, jobID))+"/", "WorkflowJobNode", 0)
+	if DiagnosticsHasError(diags, d...) {
+		return nil
+	}
+	models := make([]LaunchNodeModel, 0, len(nodes))
+	for _, node := range nodes {
+		models = append(models, launchNodeFromAPI(node))
+	}
+	values, d := types.ListValueFrom(ctx, nodeType, models)
+	if DiagnosticsHasError(diags, d...) {
+		return nil
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root("nodes"), values)...)
+	return nodes
+}
+
+// LaunchNodeModel is an element of the nodes of a workflow job launch.
+type LaunchNodeModel struct {
+	ID                 types.Int64  `tfsdk:"id"`
+	Identifier         types.String `tfsdk:"identifier"`
+	UnifiedJobTemplate types.Int64  `tfsdk:"unified_job_template"`
+	JobID              types.Int64  `tfsdk:"job_id"`
+	Status             types.String `tfsdk:"status"`
+	DoNotRun           types.Bool   `tfsdk:"do_not_run"`
+}
+
+// launchNodeFromAPI converts a workflow job node into a nodes element.
+func launchNodeFromAPI(node map[string]any) LaunchNodeModel {
+	int64Value := func(v any) types.Int64 {
+		if id, err := int64FromAPI(v); err == nil {
+			return types.Int64Value(id)
+		}
+		return types.Int64Null()
+	}
+	identifier, _ := node["identifier"].(string)
+	doNotRun, _ := node["do_not_run"].(bool)
+	summary, _ := node["summary_fields"].(map[string]any)
+	job, _ := summary["job"].(map[string]any)
+	status, _ := job["status"].(string)
+	return LaunchNodeModel{
+		ID:                 int64Value(node["id"]),
+		Identifier:         types.StringValue(identifier),
+		UnifiedJobTemplate: int64Value(node["unified_job_template"]),
+		JobID:              int64Value(node["job"]),
+		Status:             types.StringValue(status),
+		DoNotRun:           types.BoolValue(doNotRun),
+	}
+}
+
+func (o *LaunchResource) launchEndpoint(templateID int64) string {
+	return p.Clean(fmt.Sprintf("%s/%d/launch", o.Endpoint, templateID)) + "/"
+}
+
+func (o *LaunchResource) jobEndpoint(jobID int64) string {
+	return EndpointWithID(o.Cfg.JobEndpoint, jobID)
+}
+
+// launchedJobID returns the ID of the job a launch started. Job templates
+// return it as "job", workflow job templates as "workflow_job".
+func launchedJobID(data map[string]any) (int64, error) {
+	for _, key := range []string{"job", "workflow_job", "id"} {
+		if v, ok := data[key]; ok && v != nil {
+			return int64FromAPI(v)
+		}
+	}
+	return 0, fmt.Errorf("the launch response does not include the job ID")
+}
+
+// float64FromAPI converts a number, as decoded from JSON, into a float64.
+func float64FromAPI(v any) (float64, error) {
+	switch v := v.(type) {
+	case json.Number:
+		return v.Float64()
+	case float64:
+		return v, nil
+	case int64:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	default:
+		return 0, fmt.Errorf("expected a number, got %T", v)
+	}
+}
@@ -0,0 +1,409 @@
+package framework_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// launchAWX serves the launch endpoint of template 5 and job 42, of a job
+// template or, with workflow set, of a workflow job template. A nil job is
+// answered with 404.
+type launchAWX struct {
+	*fakeAWX
+	workflow bool
+	info     map[string]any
+	job      map[string]any
+	nodes    []any
+	launched []map[string]any
+}
+
+func newLaunchAWX(t *testing.T, workflow bool) *launchAWX {
+	f := &launchAWX{fakeAWX: newFakeAWX(t), workflow: workflow}
+	templates, jobs, jobKey := "job_templates", "jobs", "job"
+	if workflow {
+		templates, jobs, jobKey = "workflow_job_templates", "workflow_jobs", "workflow_job"
+	}
+	f.handle("GET /api/v2/"+templates+"/5/launch/", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, f.info)
+	})
+	f.handle("POST /api/v2/"+templates+"/5/launch/", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		f.launched = append(f.launched, body)
+		w.WriteHeader(http.StatusCreated)
+		writeJSON(w, map[string]any{jobKey: 42, "id": 42, "status": "pending", "failed": false, "elapsed": 0})
+	})
+	f.handle("GET /api/v2/"+jobs+"/42/", func(w http.ResponseWriter, _ *http.Request) {
+		if f.job == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeJSON(w, f.job)
+	})
+	if workflow {
+		f.handle("GET /api/v2/"+jobs+"/42/workflow_nodes/", func(w http.ResponseWriter, _ *http.Request) {
+			writeList(w, f.nodes)
+		})
+	}
+	return f
+}
+
+func newLaunchResource(t *testing.T, f *launchAWX) (*framework.LaunchResource, schema.Schema) {
+	t.Helper()
+	r := framework.NewJobLaunchResource("job_launch", "/api/v2/job_templates/", "/api/v2/jobs/").(*framework.LaunchResource)
+	if f.workflow {
+		r = framework.NewWorkflowJobLaunchResource("workflow_job_launch", "/api/v2/workflow_job_templates/", "/api/v2/workflow_jobs/").(*framework.LaunchResource)
+	}
+	r.Client = f.requester()
+	return r, resourceSchema(t, r)
+}
+
+// launchValue builds a plan or state value with the given attributes set and
+// the outputs unknown.
+func launchValue(t *testing.T, s schema.Schema, values map[string]attr.Value) tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+	plan := tfsdk.Plan{Schema: s, Raw: nullValue(s)}
+	defaults := map[string]attr.Value{
+		"wait_for_completion": types.BoolValue(false),
+		"job_id":              types.Int64Unknown(),
+		"status":              types.StringUnknown(),
+		"failed":              types.BoolUnknown(),
+		"elapsed":             types.Float64Unknown(),
+		"artifacts":           types.StringUnknown(),
+	}
+	if _, ok := s.Attributes["nodes"]; ok {
+		defaults["workflow_job_template_id"] = types.Int64Value(5)
+		defaults["nodes"] = types.ListUnknown(types.ObjectType{AttrTypes: launchNodeAttrTypes})
+	} else {
+		defaults["job_template_id"] = types.Int64Value(5)
+	}
+	for k, v := range values {
+		defaults[k] = v
+	}
+	for k, v := range defaults {
+		require.False(t, plan.SetAttribute(ctx, path.Root(k), v).HasError(), k)
+	}
+	return plan.Raw
+}
+
+var launchNodeAttrTypes = map[string]attr.Type{
+	"id":                   types.Int64Type,
+	"identifier":           types.StringType,
+	"unified_job_template": types.Int64Type,
+	"job_id":               types.Int64Type,
+	"status":               types.StringType,
+	"do_not_run":           types.BoolType,
+}
+
+func TestLaunchResource_Create(t *testing.T) {
+	tests := []struct {
+		name          string
+		info          map[string]any
+		job           map[string]any
+		values        map[string]attr.Value
+		wantLaunched  map[string]any
+		wantError     string
+		wantDetail    string
+		wantStatus    string
+		wantFailed    bool
+		wantElapsed   float64
+		wantArtifacts string
+	}{
+		{
+			name: "waits for the job and reports its outputs",
+			info: map[string]any{"ask_limit_on_launch": true, "ask_credential_on_launch": true, "ask_variables_on_launch": true},
+			job: map[string]any{
+				"id": 42, "status": "successful", "failed": false, "elapsed": 12.5,
+				"artifacts": map[string]any{"bootstrapped": true},
+			},
+			values: map[string]attr.Value{
+				"limit":               types.StringValue("web"),
+				"extra_vars":          types.StringValue(`{"version": "1.2"}`),
+				"credential_ids":      int64Set(9, 3),
+				"wait_for_completion": types.BoolValue(true),
+			},
+			wantLaunched:  map[string]any{"limit": "web", "extra_vars": `{"version": "1.2"}`, "credentials": []any{float64(3), float64(9)}},
+			wantStatus:    "successful",
+			wantElapsed:   12.5,
+			wantArtifacts: `{"bootstrapped": true}`,
+		},
+		{
+			name:          "without waiting reports the launched job",
+			info:          map[string]any{},
+			wantLaunched:  map[string]any{},
+			wantStatus:    "pending",
+			wantArtifacts: `{}`,
+		},
+		{
+			// The launch stays in state so Terraform taints it and launches again.
+			name:         "failed job stays in state",
+			info:         map[string]any{},
+			job:          map[string]any{"id": 42, "status": "failed", "failed": true, "elapsed": 3, "job_explanation": "Task failed on web01"},
+			values:       map[string]attr.Value{"wait_for_completion": types.BoolValue(true)},
+			wantLaunched: map[string]any{},
+			wantError:    `Job 42 reached terminal failure status "failed" on /api/v2/jobs/42/`,
+			wantDetail:   "Task failed on web01",
+			wantStatus:   "failed",
+			wantFailed:   true,
+			wantElapsed:  3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLaunchAWX(t, false)
+			f.info, f.job = tt.info, tt.job
+			r, s := newLaunchResource(t, f)
+
+			state, diags := applyResource(r, s, nullValue(s), launchValue(t, s, tt.values))
+			if tt.wantError != "" {
+				require.True(t, diags.HasError())
+				assert.Equal(t, tt.wantError, diags.Errors()[0].Summary())
+				assert.Equal(t, tt.wantDetail, diags.Errors()[0].Detail())
+			} else {
+				require.False(t, diags.HasError(), "%v", diags)
+			}
+			assert.Equal(t, []map[string]any{tt.wantLaunched}, f.launched)
+
+			assert.Equal(t, int64(42), stateAttribute[types.Int64](t, state, "job_id").ValueInt64())
+			assert.Equal(t, tt.wantStatus, stateAttribute[types.String](t, state, "status").ValueString())
+			assert.Equal(t, tt.wantFailed, stateAttribute[types.Bool](t, state, "failed").ValueBool())
+			assert.InDelta(t, tt.wantElapsed, stateAttribute[types.Float64](t, state, "elapsed").ValueFloat64(), 0.001)
+			if tt.wantArtifacts != "" {
+				assert.JSONEq(t, tt.wantArtifacts, stateAttribute[types.String](t, state, "artifacts").ValueString())
+			}
+		})
+	}
+}
+
+func TestLaunchResource_CreateRejectsPrompts(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name      string
+		info      map[string]any
+		values    map[string]attr.Value
+		wantError string
+		wantPath  path.Path
+	}{
+		{
+			name:      "prompt the template does not ask for",
+			info:      map[string]any{"ask_limit_on_launch": false},
+			values:    map[string]attr.Value{"limit": types.StringValue("web")},
+			wantError: "JobTemplate does not prompt for limit on launch",
+			wantPath:  path.Root("limit"),
+		},
+		{
+			name:      "extra variables without a survey",
+			info:      map[string]any{"survey_enabled": false},
+			values:    map[string]attr.Value{"extra_vars": types.StringValue(`{"a": 1}`)},
+			wantError: "JobTemplate does not prompt for extra_vars on launch",
+			wantPath:  path.Root("extra_vars"),
+		},
+		{
+			name:      "missing survey answers",
+			info:      map[string]any{"survey_enabled": true, "variables_needed_to_start": []any{"version", "region"}},
+			values:    map[string]attr.Value{"extra_vars": types.StringValue("version: '1.2'")},
+			wantError: "Missing survey answers",
+			wantPath:  path.Root("extra_vars"),
+		},
+		{
+			name:      "survey without extra variables",
+			info:      map[string]any{"survey_enabled": true, "variables_needed_to_start": []any{"version"}},
+			wantError: "Missing survey answers",
+			wantPath:  path.Root("extra_vars"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newLaunchAWX(t, false)
+			fake.info = tt.info
+			r, s := newLaunchResource(t, fake)
+
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: s}}
+			r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: launchValue(t, s, tt.values)}}, resp)
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+			withPath, ok := resp.Diagnostics.Errors()[0].(interface{ Path() path.Path })
+			require.True(t, ok)
+			assert.True(t, tt.wantPath.Equal(withPath.Path()))
+			assert.Empty(t, fake.launched)
+		})
+	}
+}
+
+func TestLaunchResource_Read(t *testing.T) {
+	tests := []struct {
+		name       string
+		job        map[string]any
+		wantStatus string
+	}{
+		{
+			name:       "refreshes the job",
+			job:        map[string]any{"id": 42, "status": "successful", "failed": false, "elapsed": 8, "artifacts": map[string]any{}},
+			wantStatus: "successful",
+		},
+		{
+			name:       "job deleted from AWX keeps the last outputs",
+			wantStatus: "running",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLaunchAWX(t, false)
+			f.job = tt.job
+			r, s := newLaunchResource(t, f)
+			state := launchValue(t, s, map[string]attr.Value{
+				"job_id":    types.Int64Value(42),
+				"status":    types.StringValue("running"),
+				"failed":    types.BoolValue(false),
+				"elapsed":   types.Float64Value(1),
+				"artifacts": types.StringValue("{}"),
+			})
+
+			got, diags := readResource(r, s, state)
+			require.False(t, diags.HasError(), "%v", diags)
+			require.False(t, got.Raw.IsNull())
+			assert.Equal(t, tt.wantStatus, stateAttribute[types.String](t, got, "status").ValueString())
+		})
+	}
+}
+
+func TestLaunchResource_ModifyPlan(t *testing.T) {
+	values := map[string]attr.Value{"inventory": types.Int64Value(3)}
+	tests := []struct {
+		name      string
+		create    bool
+		wantError string
+	}{
+		{name: "new launch", create: true, wantError: "JobTemplate does not prompt for inventory on launch"},
+		{name: "in-place update"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newLaunchAWX(t, false)
+			f.info = map[string]any{"ask_inventory_on_launch": false}
+			r, s := newLaunchResource(t, f)
+
+			plan := tfsdk.Plan{Schema: s, Raw: launchValue(t, s, values)}
+			state := tfsdk.State{Schema: s, Raw: launchValue(t, s, values)}
+			if tt.create {
+				state.Raw = nullValue(s)
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: plan, State: state}, resp)
+			if tt.wantError == "" {
+				require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+				return
+			}
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.wantError, resp.Diagnostics.Errors()[0].Summary())
+		})
+	}
+}
+
+func workflowJobNode(id int64, identifier string, job any, status string, doNotRun bool) map[string]any {
+	node := map[string]any{"id": id, "identifier": identifier, "unified_job_template": 10 + id, "job": job, "do_not_run": doNotRun, "summary_fields": map[string]any{}}
+	if job != nil {
+		node["summary_fields"] = map[string]any{"job": map[string]any{"id": job, "name": identifier + " playbook", "status": status}}
+	}
+	return node
+}
+
+func TestLaunchResource_WorkflowCreate(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name       string
+		status     string
+		wantErrors []string
+		wantNodes  []framework.LaunchNodeModel
+	}{
+		{
+			name:   "successful workflow",
+			status: "successful",
+			wantNodes: []framework.LaunchNodeModel{
+				{ID: types.Int64Value(1), Identifier: types.StringValue("deploy"), UnifiedJobTemplate: types.Int64Value(11), JobID: types.Int64Value(101), Status: types.StringValue("successful"), DoNotRun: types.BoolValue(false)},
+				{ID: types.Int64Value(2), Identifier: types.StringValue("rollback"), UnifiedJobTemplate: types.Int64Value(12), JobID: types.Int64Null(), Status: types.StringValue(""), DoNotRun: types.BoolValue(true)},
+			},
+		},
+		{
+			name:   "failed workflow reports the failed nodes",
+			status: "failed",
+			wantErrors: []string{
+				`WorkflowJob 42 reached terminal failure status "failed" on /api/v2/workflow_jobs/42/`,
+				`Workflow node "deploy" failed`,
+			},
+			wantNodes: []framework.LaunchNodeModel{
+				{ID: types.Int64Value(1), Identifier: types.StringValue("deploy"), UnifiedJobTemplate: types.Int64Value(11), JobID: types.Int64Value(101), Status: types.StringValue("failed"), DoNotRun: types.BoolValue(false)},
+				{ID: types.Int64Value(2), Identifier: types.StringValue("rollback"), UnifiedJobTemplate: types.Int64Value(12), JobID: types.Int64Null(), Status: types.StringValue(""), DoNotRun: types.BoolValue(true)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := newLaunchAWX(t, true)
+			fake.info = map[string]any{"survey_enabled": true, "variables_needed_to_start": []any{"version"}}
+			fake.job = map[string]any{"id": 42, "status": tt.status, "failed": tt.status != "successful", "elapsed": 30}
+			fake.nodes = []any{
+				workflowJobNode(1, "deploy", 101, tt.status, false),
+				workflowJobNode(2, "rollback", nil, "", true),
+			}
+			r, s := newLaunchResource(t, fake)
+
+			plan := launchValue(t, s, map[string]attr.Value{
+				"extra_vars":          types.StringValue(`{"version": "2"}`),
+				"wait_for_completion": types.BoolValue(true),
+			})
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: s}}
+			r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: s, Raw: plan}}, resp)
+
+			var summaries []string
+			for _, d := range resp.Diagnostics.Errors() {
+				summaries = append(summaries, d.Summary())
+			}
+			assert.Equal(t, tt.wantErrors, summaries)
+			assert.Equal(t, []map[string]any{{"extra_vars": `{"version": "2"}`}}, fake.launched)
+
+			var nodes []framework.LaunchNodeModel
+			require.False(t, resp.State.GetAttribute(ctx, path.Root("nodes"), &nodes).HasError())
+			assert.Equal(t, tt.wantNodes, nodes)
+		})
+	}
+}
+
+func TestLaunchResource_WorkflowRead(t *testing.T) {
+	ctx := context.Background()
+	fake := newLaunchAWX(t, true)
+	fake.job = map[string]any{"id": 42, "status": "running", "failed": false, "elapsed": 2}
+	r, s := newLaunchResource(t, fake)
+	state := launchValue(t, s, map[string]attr.Value{
+		"job_id":    types.Int64Value(42),
+		"status":    types.StringValue("pending"),
+		"failed":    types.BoolValue(false),
+		"elapsed":   types.Float64Value(0),
+		"artifacts": types.StringValue("{}"),
+		"nodes":     types.ListNull(types.ObjectType{AttrTypes: launchNodeAttrTypes}),
+	})
+
+	resp := &resource.ReadResponse{State: tfsdk.State{Schema: s, Raw: state}}
+	r.Read(ctx, resource.ReadRequest{State: tfsdk.State{Schema: s, Raw: state}}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	// The nodes are only reported once the workflow job has finished.
+	var nodes types.List
+	resp.State.GetAttribute(ctx, path.Root("nodes"), &nodes)
+	assert.True(t, nodes.IsNull())
+}
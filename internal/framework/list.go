@@ -0,0 +1,154 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// DefaultPageSize is the page_size hint ListAll sends when the caller does not
+// pick one. It matches the default MAX_PAGE_SIZE of AWX, so larger values are
+// capped by the server anyway.
+const DefaultPageSize = 200
+
+// ListAll reads every page of an AWX list endpoint by following the `next`
+// links and returns the collected results. pageSize is sent as the page_size
+// query parameter unless the endpoint already carries one; zero or less uses
+// DefaultPageSize.
+func ListAll(ctx context.Context, r Requester, endpoint string, resourceName string, pageSize int) ([]map[string]any, diag.Diagnostics) {
+	items, _, diags := listAll(ctx, r, endpoint, resourceName, pageSize, false)
+	return items, diags
+}
+
+// ListAllAllowNotFound is ListAll for sub-collections whose parent may have
+// been deleted outside of Terraform. A 404 on the first page returns
+// found=false without diagnostics.
+func ListAllAllowNotFound(ctx context.Context, r Requester, endpoint string, resourceName string, pageSize int) (items []map[string]any, found bool, diags diag.Diagnostics) {
+	return listAll(ctx, r, endpoint, resourceName, pageSize, true)
+}
+
+// CountAll returns the number of objects on an AWX list endpoint as reported
+// by its `count` field, reading a single page of one result.
+func CountAll(ctx context.Context, r Requester, endpoint string, resourceName string) (int64, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	first, err := withPageSize(endpoint, 1)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Invalid list endpoint for %s", resourceName), err.Error())
+		return 0, diags
+	}
+	data, d := ReadRequest(ctx, r, first, resourceName)
+	if DiagnosticsHasError(&diags, d...) {
+		return 0, diags
+	}
+	count, err := int64FromAPI(data["count"])
+	if err != nil {
+		diags.AddError(
+			fmt.Sprintf("Unexpected response while counting %s", resourceName),
+			fmt.Sprintf("expected a count on %s: %s", first, err),
+		)
+		return 0, diags
+	}
+	return count, diags
+}
+
+func listAll(ctx context.Context, r Requester, endpoint string, resourceName string, pageSize int, allowNotFound bool) ([]map[string]any, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var items []map[string]any
+
+	next, err := withPageSize(endpoint, pageSize)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Invalid list endpoint for %s", resourceName), err.Error())
+		return nil, false, diags
+	}
+
+	seen := map[string]bool{}
+	for next != "" {
+		if seen[next] {
+			diags.AddError(
+				fmt.Sprintf("Pagination loop while listing %s", resourceName),
+				fmt.Sprintf("AWX returned %s as the next page more than once.", next),
+			)
+			return nil, false, diags
+		}
+		// Only a missing first page means the collection is gone, a 404
+		// further down is a real error.
+		allow := allowNotFound && len(seen) == 0
+		seen[next] = true
+
+		var data map[string]any
+		var d diag.Diagnostics
+		found := true
+		if allow {
+			data, found, d = ReadRequestAllowNotFound(ctx, r, next, resourceName)
+		} else {
+			data, d = ReadRequest(ctx, r, next, resourceName)
+		}
+		if DiagnosticsHasError(&diags, d...) {
+			return nil, false, diags
+		}
+		if !found {
+			return nil, false, diags
+		}
+
+		results, ok := data["results"].([]any)
+		if !ok {
+			diags.AddError(
+				fmt.Sprintf("Unexpected response while listing %s", resourceName),
+				fmt.Sprintf("expected a results array on %s, got %T", next, data["results"]),
+			)
+			return nil, false, diags
+		}
+		for _, result := range results {
+			item, ok := result.(map[string]any)
+			if !ok {
+				diags.AddError(
+					fmt.Sprintf("Unexpected response while listing %s", resourceName),
+					fmt.Sprintf("expected an object in the results on %s, got %T", next, result),
+				)
+				return nil, false, diags
+			}
+			items = append(items, item)
+		}
+
+		next = nextPage(data["next"])
+	}
+	return items, true, diags
+}
+
+// withPageSize adds the page_size hint to endpoint, keeping one set by the
+// caller.
+func withPageSize(endpoint string, pageSize int) (string, error) {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	query := u.Query()
+	if query.Has("page_size") {
+		return endpoint, nil
+	}
+	query.Set("page_size", strconv.Itoa(pageSize))
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
+
+// nextPage returns the endpoint of the next page. AWX answers with a path
+// relative to the host, but a proxy in front of it may rewrite that into an
+// absolute URL, which is reduced back to its path and query.
+func nextPage(v any) string {
+	next, _ := v.(string)
+	if !strings.HasPrefix(next, "http://") && !strings.HasPrefix(next, "https://") {
+		return next
+	}
+	u, err := url.Parse(next)
+	if err != nil {
+		return next
+	}
+	return u.RequestURI()
+}
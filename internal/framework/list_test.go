@@ -0,0 +1,214 @@
+package framework_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+func TestListAll(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		endpoint      string
+		pageSize      int
+		pages         map[string]map[string]any
+		wantRequested []string
+		wantIDs       []int
+		wantInError   string
+	}{
+		{
+			name:     "follows next across pages",
+			endpoint: "/api/v2/hosts/?name__icontains=web",
+			pageSize: 2,
+			pages: map[string]map[string]any{
+				"/api/v2/hosts/?name__icontains=web&page_size=2": {
+					"count": 5, "next": "/api/v2/hosts/?name__icontains=web&page=2&page_size=2",
+					"results": []any{map[string]any{"id": 1}, map[string]any{"id": 2}},
+				},
+				"/api/v2/hosts/?name__icontains=web&page=2&page_size=2": {
+					"count": 5, "next": "https://awx.example.com/api/v2/hosts/?name__icontains=web&page=3&page_size=2",
+					"results": []any{map[string]any{"id": 3}, map[string]any{"id": 4}},
+				},
+				"/api/v2/hosts/?name__icontains=web&page=3&page_size=2": {
+					"count": 5, "next": nil,
+					"results": []any{map[string]any{"id": 5}},
+				},
+			},
+			wantRequested: []string{
+				"/api/v2/hosts/?name__icontains=web&page_size=2",
+				"/api/v2/hosts/?name__icontains=web&page=2&page_size=2",
+				"/api/v2/hosts/?name__icontains=web&page=3&page_size=2",
+			},
+			wantIDs: []int{1, 2, 3, 4, 5},
+		},
+		{
+			name:     "default page size",
+			endpoint: "/api/v2/hosts/",
+			pages: map[string]map[string]any{
+				"/api/v2/hosts/?page_size=200": {"count": 1, "next": nil, "results": []any{map[string]any{"id": 1}}},
+			},
+			wantRequested: []string{"/api/v2/hosts/?page_size=200"},
+			wantIDs:       []int{1},
+		},
+		{
+			name:     "page size set by the caller is kept",
+			endpoint: "/api/v2/hosts/?page_size=10",
+			pageSize: 50,
+			pages: map[string]map[string]any{
+				"/api/v2/hosts/?page_size=10": {"count": 0, "next": nil, "results": []any{}},
+			},
+			wantRequested: []string{"/api/v2/hosts/?page_size=10"},
+		},
+		{
+			name:     "repeated next page",
+			endpoint: "/api/v2/hosts/",
+			pages: map[string]map[string]any{
+				"/api/v2/hosts/?page_size=200":        {"next": "/api/v2/hosts/?page=2&page_size=200", "results": []any{}},
+				"/api/v2/hosts/?page=2&page_size=200": {"next": "/api/v2/hosts/?page=2&page_size=200", "results": []any{}},
+			},
+			wantInError: "Pagination loop",
+		},
+		{
+			name:     "missing results",
+			endpoint: "/api/v2/hosts/1/",
+			pages: map[string]map[string]any{
+				"/api/v2/hosts/1/?page_size=200": {"id": 1},
+			},
+			wantInError: "expected a results array",
+		},
+		{
+			name:     "result is not an object",
+			endpoint: "/api/v2/hosts/",
+			pages: map[string]map[string]any{
+				"/api/v2/hosts/?page_size=200": {"results": []any{"host"}},
+			},
+			wantInError: "expected an object in the results",
+		},
+		{
+			name:        "request fails",
+			endpoint:    "/api/v2/hosts/",
+			pages:       map[string]map[string]any{},
+			wantInError: "unexpected page",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested []string
+			items, diags := framework.ListAll(ctx, pagedRequester(tt.pages, &requested), tt.endpoint, "Host", tt.pageSize)
+			if tt.wantInError != "" {
+				require.True(t, diags.HasError())
+				var combined string
+				for _, d := range diags.Errors() {
+					combined += d.Summary() + "|" + d.Detail() + "\n"
+				}
+				assert.Contains(t, combined, tt.wantInError)
+				assert.Nil(t, items)
+				return
+			}
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, tt.wantRequested, requested)
+			var ids []int
+			for _, item := range items {
+				ids = append(ids, item["id"].(int))
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestListAllAllowNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	// notFoundRequester serves pages and answers 404 for everything else.
+	notFoundRequester := func(pages map[string]map[string]any) *mockRequester {
+		return &mockRequester{
+			newRequestFunc: func(_ context.Context, _, endpoint string, _ io.Reader) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, endpoint, nil)
+			},
+			doFunc: func(_ context.Context, req *http.Request) (map[string]any, error) {
+				if page, ok := pages[req.URL.String()]; ok {
+					return page, nil
+				}
+				return nil, fmt.Errorf("%w: 404, on %s", client.ErrNotFound, req.URL)
+			},
+		}
+	}
+
+	t.Run("found", func(t *testing.T) {
+		items, found, diags := framework.ListAllAllowNotFound(ctx, notFoundRequester(map[string]map[string]any{
+			"/api/v2/hosts/1/groups/?page_size=200": {"next": nil, "results": []any{map[string]any{"id": 1}}},
+		}), "/api/v2/hosts/1/groups/", "Host", 0)
+		require.False(t, diags.HasError(), "%v", diags)
+		assert.True(t, found)
+		assert.Len(t, items, 1)
+	})
+
+	t.Run("missing collection", func(t *testing.T) {
+		items, found, diags := framework.ListAllAllowNotFound(ctx, notFoundRequester(nil), "/api/v2/hosts/1/groups/", "Host", 0)
+		require.False(t, diags.HasError(), "%v", diags)
+		assert.False(t, found)
+		assert.Nil(t, items)
+	})
+
+	t.Run("missing later page is an error", func(t *testing.T) {
+		_, found, diags := framework.ListAllAllowNotFound(ctx, notFoundRequester(map[string]map[string]any{
+			"/api/v2/hosts/1/groups/?page_size=200": {"next": "/api/v2/hosts/1/groups/?page=2&page_size=200", "results": []any{}},
+		}), "/api/v2/hosts/1/groups/", "Host", 0)
+		assert.True(t, diags.HasError())
+		assert.False(t, found)
+	})
+
+	t.Run("ListAll reports a missing collection", func(t *testing.T) {
+		_, diags := framework.ListAll(ctx, notFoundRequester(nil), "/api/v2/hosts/1/groups/", "Host", 0)
+		assert.True(t, diags.HasError())
+	})
+}
+
+func TestCountAll(t *testing.T) {
+	ctx := context.Background()
+
+	requester := func(pages map[string]map[string]any) *mockRequester {
+		return &mockRequester{
+			newRequestFunc: func(_ context.Context, _, endpoint string, _ io.Reader) (*http.Request, error) {
+				return http.NewRequest(http.MethodGet, endpoint, nil)
+			},
+			doFunc: func(_ context.Context, req *http.Request) (map[string]any, error) {
+				if page, ok := pages[req.URL.String()]; ok {
+					return page, nil
+				}
+				return nil, fmt.Errorf("%w: 404, on %s", client.ErrNotFound, req.URL)
+			},
+		}
+	}
+
+	t.Run("reads the count of a single page", func(t *testing.T) {
+		count, diags := framework.CountAll(ctx, requester(map[string]map[string]any{
+			"/api/v2/inventory_sources/1/hosts/?page_size=1": {"count": 42, "next": "/api/v2/inventory_sources/1/hosts/?page=2&page_size=1", "results": []any{}},
+		}), "/api/v2/inventory_sources/1/hosts/", "InventorySource")
+		require.False(t, diags.HasError(), "%v", diags)
+		assert.EqualValues(t, 42, count)
+	})
+
+	t.Run("missing count", func(t *testing.T) {
+		_, diags := framework.CountAll(ctx, requester(map[string]map[string]any{
+			"/api/v2/inventory_sources/1/hosts/?page_size=1": {"results": []any{}},
+		}), "/api/v2/inventory_sources/1/hosts/", "InventorySource")
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("missing collection", func(t *testing.T) {
+		_, diags := framework.CountAll(ctx, requester(nil), "/api/v2/inventory_sources/1/hosts/", "InventorySource")
+		assert.True(t, diags.HasError())
+	})
+}
@@ -26,14 +26,13 @@ func LookupCredentialTypeIDByNamespace(ctx context.Context, client Requester, na
 	}
 
 	endpoint := fmt.Sprintf("/api/v2/credential_types/?managed=true&namespace=%s", url.QueryEscape(namespace))
-	data, d := ReadRequest(ctx, client, endpoint, fmt.Sprintf("CredentialType[%s]", namespace))
+	results, d := ListAll(ctx, client, endpoint, fmt.Sprintf("CredentialType[%s]", namespace), 0)
 	diags.Append(d...)
 	if d.HasError() {
 		return 0, diags
 	}
 
-	results, ok := data["results"].([]any)
-	if !ok || len(results) == 0 {
+	if len(results) == 0 {
 		diags.AddError(
 			fmt.Sprintf("No managed credential_type found for namespace %q", namespace),
 			fmt.Sprintf("AWX returned 0 results for %s. Confirm the AWX instance has the managed credential type for this namespace.", endpoint),
@@ -41,15 +40,7 @@ func LookupCredentialTypeIDByNamespace(ctx context.Context, client Requester, na
 		return 0, diags
 	}
 
-	first, ok := results[0].(map[string]any)
-	if !ok {
-		diags.AddError(
-			fmt.Sprintf("Unexpected response shape for credential_type lookup (%s)", namespace),
-			"results[0] is not an object",
-		)
-		return 0, diags
-	}
-
+	first := results[0]
 	switch id := first["id"].(type) {
 	case json.Number:
 		v, err := id.Int64()
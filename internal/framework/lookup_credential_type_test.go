@@ -2,6 +2,7 @@ package framework_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -31,6 +32,19 @@ func TestLookupCredentialTypeIDByNamespace(t *testing.T) {
 			requester: namespaceLookupRequester(t, "aws", []map[string]any{{"id": float64(5), "namespace": "aws"}}),
 			wantID:    5,
 		},
+		{
+			name:      "result on a later page",
+			namespace: "vault",
+			requester: pagedRequester(map[string]map[string]any{
+				"/api/v2/credential_types/?managed=true&namespace=vault&page_size=200": {
+					"next": "/api/v2/credential_types/?managed=true&namespace=vault&page=2&page_size=200", "results": []any{},
+				},
+				"/api/v2/credential_types/?managed=true&namespace=vault&page=2&page_size=200": {
+					"next": nil, "results": []any{map[string]any{"id": json.Number("11"), "namespace": "vault"}},
+				},
+			}, new([]string)),
+			wantID: 11,
+		},
 		{
 			name:        "empty namespace",
 			namespace:   "",
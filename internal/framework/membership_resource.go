@@ -0,0 +1,369 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = (*MembershipResource)(nil)
+	_ resource.ResourceWithConfigure   = (*MembershipResource)(nil)
+	_ resource.ResourceWithImportState = (*MembershipResource)(nil)
+)
+
+// MembershipResource owns the complete list of member and admin users of an
+// object with a member_role and an admin_role, such as a team. Members are
+// the users of the object, admins the users with direct access through the
+// admin role in its access list. Users granted either role out of band show
+// up as drift and lose the role on the next apply.
+type MembershipResource struct {
+	ResourceBase
+	rolesEndpoint string
+	displayName   string
+	parentIDAttr  string
+}
+
+// NewMembershipResource constructs a MembershipResource. endpoint is the
+// endpoint of the object, rolesEndpoint the roles endpoint and displayName
+// the human-readable object name (e.g. "Team").
+func NewMembershipResource(typeName, endpoint, rolesEndpoint, displayName string) resource.Resource {
+	return &MembershipResource{
+		ResourceBase: ResourceBase{
+			ProviderBase: ProviderBase{TypeName: typeName, Endpoint: endpoint},
+		},
+		rolesEndpoint: rolesEndpoint,
+		displayName:   displayName,
+		parentIDAttr:  strings.TrimSuffix(typeName, "_membership") + "_id",
+	}
+}
+
+// membership is the member and admin users of an object, and the roles
+// granting them.
+type membership struct {
+	parentID     int64
+	memberRoleID int64
+	adminRoleID  int64
+	members      []int64
+	admins       []int64
+}
+
+// Schema defines the schema for the resource.
+func (o *MembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	name := strings.ToLower(o.displayName)
+	resp.Schema = schema.Schema{
+		Description: fmt.Sprintf("Manages the complete list of member and admin users of a %s.", name),
+		Attributes: map[string]schema.Attribute{
+			o.parentIDAttr: schema.Int64Attribute{
+				Description: fmt.Sprintf("Database ID for this %s.", o.displayName),
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"member_user_ids": schema.SetAttribute{
+				Description: fmt.Sprintf("Database IDs of every user that is a member of the %s. Any other member added outside of Terraform is removed.", name),
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.Int64Type, nil)),
+			},
+			"admin_user_ids": schema.SetAttribute{
+				Description: fmt.Sprintf("Database IDs of every user that is an admin of the %s. Any other admin added outside of Terraform is removed.", name),
+				ElementType: types.Int64Type,
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.Int64Type, nil)),
+			},
+			"member_role_id": schema.Int64Attribute{
+				Description: fmt.Sprintf("Database ID of the member role of the %s.", name),
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"admin_role_id": schema.Int64Attribute{
+				Description: fmt.Sprintf("Database ID of the admin role of the %s.", name),
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ImportState imports the members and admins of an object by its ID. Read
+// fills in the users.
+func (o *MembershipResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(request.ID, 10, 64)
+	if err != nil {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("Unable to parse '%v' as an int64 number, please provide the %s for the %s membership.", request.ID, o.parentIDAttr, o.displayName),
+			err.Error(),
+		)
+		return
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(o.parentIDAttr), types.Int64Value(id))...)
+}
+
+// Create makes the members and admins of the object match the plan.
+func (o *MembershipResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	desired, ok := o.readState(ctx, &request.Plan, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	current, found, d := o.fetch(ctx, desired.parentID)
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	if !found {
+		response.Diagnostics.AddAttributeError(path.Root(o.parentIDAttr), fmt.Sprintf("%s not found", o.displayName),
+			fmt.Sprintf("%s %d does not exist", o.displayName, desired.parentID))
+		return
+	}
+	desired.memberRoleID, desired.adminRoleID = current.memberRoleID, current.adminRoleID
+	if !o.reconcile(ctx, current, desired, &response.Diagnostics) {
+		return
+	}
+	o.setState(ctx, &response.State, desired, &response.Diagnostics)
+}
+
+// Read replaces the users in state with the members and admins in AWX, and
+// drops the resource from state when the object no longer exists.
+func (o *MembershipResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	state, ok := o.readState(ctx, &request.State, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	current, found, d := o.fetch(ctx, state.parentID)
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	if !found {
+		tflog.Debug(ctx, fmt.Sprintf("[%s/read] %s no longer exists", o.displayName, o.displayName), map[string]any{
+			o.parentIDAttr: state.parentID,
+		})
+		response.State.RemoveResource(ctx)
+		return
+	}
+	o.setState(ctx, &response.State, current, &response.Diagnostics)
+}
+
+// Update grants and revokes only the roles of the users that differ between
+// state and plan.
+func (o *MembershipResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	desired, ok := o.readState(ctx, &request.Plan, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	current, ok := o.readState(ctx, &request.State, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	desired.memberRoleID, desired.adminRoleID = current.memberRoleID, current.adminRoleID
+	if !o.reconcile(ctx, current, desired, &response.Diagnostics) {
+		return
+	}
+	o.setState(ctx, &response.State, desired, &response.Diagnostics)
+}
+
+// Delete revokes the member and admin roles of every user in state.
+func (o *MembershipResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	current, ok := o.readState(ctx, &request.State, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	desired := current
+	desired.members, desired.admins = nil, nil
+	o.reconcile(ctx, current, desired, &response.Diagnostics)
+}
+
+// readState pulls the membership from a plan or state. The users are null
+// right after an import and unknown role IDs are left at zero.
+func (o *MembershipResource) readState(ctx context.Context, src attributeReader, diags *diag.Diagnostics) (m membership, ok bool) {
+	var parentID, memberRoleID, adminRoleID types.Int64
+	var members, admins types.Set
+	for attr, target := range map[string]any{
+		o.parentIDAttr:    &parentID,
+		"member_role_id":  &memberRoleID,
+		"admin_role_id":   &adminRoleID,
+		"member_user_ids": &members,
+		"admin_user_ids":  &admins,
+	} {
+		if DiagnosticsHasError(diags, src.GetAttribute(ctx, path.Root(attr), target)...) {
+			return m, false
+		}
+	}
+
+	m = membership{
+		parentID:     parentID.ValueInt64(),
+		memberRoleID: memberRoleID.ValueInt64(),
+		adminRoleID:  adminRoleID.ValueInt64(),
+	}
+	if m.members, ok = int64Set(ctx, members, diags); !ok {
+		return m, false
+	}
+	if m.admins, ok = int64Set(ctx, admins, diags); !ok {
+		return m, false
+	}
+	return m, true
+}
+
+// int64Set returns the sorted elements of a set of IDs, nil for a null or
+// unknown set.
+func int64Set(ctx context.Context, set types.Set, diags *diag.Diagnostics) ([]int64, bool) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, true
+	}
+	var ids []int64
+	if DiagnosticsHasError(diags, set.ElementsAs(ctx, &ids, false)...) {
+		return nil, false
+	}
+	slices.Sort(ids)
+	return ids, true
+}
+
+func (o *MembershipResource) setState(ctx context.Context, state attributeWriter, m membership, diags *diag.Diagnostics) {
+	members, d := types.SetValueFrom(ctx, types.Int64Type, m.members)
+	if DiagnosticsHasError(diags, d...) {
+		return
+	}
+	admins, d := types.SetValueFrom(ctx, types.Int64Type, m.admins)
+	if DiagnosticsHasError(diags, d...) {
+		return
+	}
+	for attr, value := range map[string]any{
+		o.parentIDAttr:    types.Int64Value(m.parentID),
+		"member_role_id":  types.Int64Value(m.memberRoleID),
+		"admin_role_id":   types.Int64Value(m.adminRoleID),
+		"member_user_ids": members,
+		"admin_user_ids":  admins,
+	} {
+		if DiagnosticsHasError(diags, state.SetAttribute(ctx, path.Root(attr), value)...) {
+			return
+		}
+	}
+}
+
+// fetch resolves the member and admin roles of the object and lists its
+// users, sorted. found is false when the object no longer exists.
+func (o *MembershipResource) fetch(ctx context.Context, parentID int64) (m membership, found bool, diags diag.Diagnostics) {
+	m.parentID = parentID
+	data, found, diags := ReadRequestAllowNotFound(ctx, o.Client, EndpointWithID(o.Endpoint, parentID), o.displayName)
+	if diags.HasError() || !found {
+		return m, found, diags
+	}
+
+	var err error
+	summary, _ := data["summary_fields"].(map[string]any)
+	roles, _ := summary["object_roles"].(map[string]any)
+	for name, id := range map[string]*int64{"member_role": &m.memberRoleID, "admin_role": &m.adminRoleID} {
+		role, _ := roles[name].(map[string]any)
+		if *id, err = int64FromAPI(role["id"]); err != nil {
+			diags.AddError(fmt.Sprintf("Unable to resolve the %s of %s %d", name, o.displayName, parentID), err.Error())
+			return m, false, diags
+		}
+	}
+
+	users, found, d := ListAllAllowNotFound(ctx, o.Client, o.subEndpoint(parentID, "users"), o.displayName, 0)
+	if diags.Append(d...); diags.HasError() || !found {
+		return m, found, diags
+	}
+	for _, user := range users {
+		id, err := int64FromAPI(user["id"])
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Unexpected response while listing the users of %s", o.displayName), err.Error())
+			return m, false, diags
+		}
+		m.members = append(m.members, id)
+	}
+
+	access, found, d := ListAllAllowNotFound(ctx, o.Client, o.subEndpoint(parentID, "access_list"), o.displayName, 0)
+	if diags.Append(d...); diags.HasError() || !found {
+		return m, found, diags
+	}
+	for _, user := range access {
+		if !hasDirectRole(user, m.adminRoleID) {
+			continue
+		}
+		id, err := int64FromAPI(user["id"])
+		if err != nil {
+			diags.AddError(fmt.Sprintf("Unexpected response while listing the access list of %s", o.displayName), err.Error())
+			return m, false, diags
+		}
+		m.admins = append(m.admins, id)
+	}
+
+	slices.Sort(m.members)
+	slices.Sort(m.admins)
+	return m, true, diags
+}
+
+// reconcile revokes the roles of the users in current that are not in
+// desired and then grants the roles of the users missing from current.
+func (o *MembershipResource) reconcile(ctx context.Context, current, desired membership, diags *diag.Diagnostics) bool {
+	for _, role := range []struct {
+		id               int64
+		current, desired []int64
+	}{
+		{id: desired.adminRoleID, current: current.admins, desired: desired.admins},
+		{id: desired.memberRoleID, current: current.members, desired: desired.members},
+	} {
+		endpoint := o.roleUsersEndpoint(role.id)
+		for _, id := range role.current {
+			if slices.Contains(role.desired, id) {
+				continue
+			}
+			if DiagnosticsHasError(diags, sendAssociation(ctx, o.Client, endpoint, id, true, o.displayName)...) {
+				return false
+			}
+		}
+		for _, id := range role.desired {
+			if slices.Contains(role.current, id) {
+				continue
+			}
+			if DiagnosticsHasError(diags, sendAssociation(ctx, o.Client, endpoint, id, false, o.displayName)...) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (o *MembershipResource) subEndpoint(parentID int64, name string) string {
+	return EndpointWithID(EndpointWithID(o.Endpoint, parentID), name)
+}
+
+func (o *MembershipResource) roleUsersEndpoint(roleID int64) string {
+	return EndpointWithID(EndpointWithID(o.rolesEndpoint, roleID), "users")
+}
+
+// hasDirectRole reports whether an access list entry holds the role directly
+// rather than through a team or a parent role.
+func hasDirectRole(entry map[string]any, roleID int64) bool {
+	summary, _ := entry["summary_fields"].(map[string]any)
+	direct, _ := summary["direct_access"].([]any)
+	for _, v := range direct {
+		access, _ := v.(map[string]any)
+		role, _ := access["role"].(map[string]any)
+		if id, err := int64FromAPI(role["id"]); err == nil && id == roleID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,205 @@
+package framework_test
+
+import (
+	"cmp"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+const teamAccessList = `{"next":null,"results":[
+	{"id":1,"summary_fields":{"direct_access":[{"role":{"id":51}}],"indirect_access":[]}},
+	{"id":2,"summary_fields":{"direct_access":[{"role":{"id":50}}],"indirect_access":[{"role":{"id":51}}]}},
+	{"id":3,"summary_fields":{"direct_access":[{"role":{"id":50}},{"role":{"id":51}}],"indirect_access":[]}}
+]}`
+
+// teamAWX serves team 5 with member role 50 and admin role 51 and records
+// the role associations as "<path> +<id>" or "<path> -<id>".
+func teamAWX(t *testing.T, users, access string) *fakeAWX {
+	f := newFakeAWX(t)
+	f.handle("GET /api/v2/teams/5/", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, `{"id":5,"summary_fields":{"object_roles":{"member_role":{"id":50},"admin_role":{"id":51},"read_role":{"id":52}}}}`)
+	})
+	f.handle("GET /api/v2/teams/5/users/", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, users)
+	})
+	f.handle("GET /api/v2/teams/5/access_list/", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = io.WriteString(w, access)
+	})
+	f.handle("POST /api/v2/roles/{id}/users/", func(w http.ResponseWriter, r *http.Request) {
+		id, op := association(t, r)
+		f.record("%s %s%d", r.URL.Path, op, id)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	return f
+}
+
+func newMembershipResource(t *testing.T, f *fakeAWX) (*framework.MembershipResource, schema.Schema) {
+	t.Helper()
+	r := framework.NewMembershipResource("team_membership", "/api/v2/teams/", "/api/v2/roles/", "Team").(*framework.MembershipResource)
+	r.Client = f.requester()
+	return r, resourceSchema(t, r)
+}
+
+// membership is the member and admin user IDs of team 5.
+type membership struct {
+	members, admins []int64
+}
+
+// membershipValue builds the value of m, with the role IDs known when roles
+// is set. A nil m is a null value.
+func membershipValue(t *testing.T, s schema.Schema, m *membership, roles bool) tftypes.Value {
+	t.Helper()
+	if m == nil {
+		return nullValue(s)
+	}
+	values := map[string]attr.Value{
+		"team_id":         types.Int64Value(5),
+		"member_user_ids": int64Set(m.members...),
+		"admin_user_ids":  int64Set(m.admins...),
+		"member_role_id":  types.Int64Unknown(),
+		"admin_role_id":   types.Int64Unknown(),
+	}
+	if roles {
+		values["member_role_id"], values["admin_role_id"] = types.Int64Value(50), types.Int64Value(51)
+	}
+	return objectValue(t, s, values)
+}
+
+func membershipIDs(t *testing.T, state tfsdk.State) membership {
+	t.Helper()
+	ctx := context.Background()
+	var m membership
+	require.False(t, state.GetAttribute(ctx, path.Root("member_user_ids"), &m.members).HasError())
+	require.False(t, state.GetAttribute(ctx, path.Root("admin_user_ids"), &m.admins).HasError())
+	return m
+}
+
+func TestMembershipResource_Read(t *testing.T) {
+	tests := []struct {
+		name        string
+		missing     bool
+		users       string
+		want        membership
+		wantRemoved bool
+	}{
+		{
+			name:  "reports the members and the direct admins",
+			users: `{"next":null,"results":[{"id":3},{"id":2}]}`,
+			want:  membership{members: []int64{2, 3}, admins: []int64{1, 3}},
+		},
+		{
+			name:        "deleted team is removed from state",
+			missing:     true,
+			wantRemoved: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := teamAWX(t, tt.users, teamAccessList)
+			f.missing = tt.missing
+			r, s := newMembershipResource(t, f)
+
+			state, diags := readResource(r, s, membershipValue(t, s, &membership{members: []int64{2}, admins: []int64{1}}, true))
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Empty(t, f.calls)
+			if tt.wantRemoved {
+				assert.True(t, state.Raw.IsNull())
+				return
+			}
+			assert.Equal(t, tt.want, membershipIDs(t, state))
+		})
+	}
+}
+
+func TestMembershipResource_Apply(t *testing.T) {
+	tests := []struct {
+		name      string
+		users     string
+		prior     *membership
+		plan      *membership
+		wantCalls []string
+	}{
+		{
+			name:  "create reconciles the current members",
+			users: `{"next":null,"results":[{"id":2},{"id":3}]}`,
+			plan:  &membership{members: []int64{3, 4}, admins: []int64{1}},
+			wantCalls: []string{
+				"/api/v2/roles/51/users/ -3",
+				"/api/v2/roles/50/users/ -2",
+				"/api/v2/roles/50/users/ +4",
+			},
+		},
+		{
+			name:  "update promotes a member to admin",
+			prior: &membership{members: []int64{2, 3}, admins: []int64{1}},
+			plan:  &membership{members: []int64{3}, admins: []int64{1, 2}},
+			wantCalls: []string{
+				"/api/v2/roles/51/users/ +2",
+				"/api/v2/roles/50/users/ -2",
+			},
+		},
+		{
+			name:  "delete removes every managed user",
+			prior: &membership{members: []int64{3}, admins: []int64{1, 2}},
+			wantCalls: []string{
+				"/api/v2/roles/51/users/ -1",
+				"/api/v2/roles/51/users/ -2",
+				"/api/v2/roles/50/users/ -3",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := teamAWX(t, cmp.Or(tt.users, `{"next":null,"results":[]}`), teamAccessList)
+			r, s := newMembershipResource(t, f)
+
+			state, diags := applyResource(r, s, membershipValue(t, s, tt.prior, true), membershipValue(t, s, tt.plan, tt.prior != nil))
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, tt.wantCalls, f.calls)
+			if tt.plan == nil {
+				return
+			}
+			assert.Equal(t, *tt.plan, membershipIDs(t, state))
+			assert.Equal(t, int64(50), stateAttribute[types.Int64](t, state, "member_role_id").ValueInt64())
+			assert.Equal(t, int64(51), stateAttribute[types.Int64](t, state, "admin_role_id").ValueInt64())
+		})
+	}
+}
+
+func TestMembershipResource_ImportState(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		wantError bool
+	}{
+		{name: "team id", id: "5"},
+		{name: "not a number", id: "team", wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, s := newMembershipResource(t, newFakeAWX(t))
+			resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: s, Raw: nullValue(s)}}
+			r.ImportState(context.Background(), resource.ImportStateRequest{ID: tt.id}, resp)
+			if tt.wantError {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.Equal(t, int64(5), stateAttribute[types.Int64](t, resp.State, "team_id").ValueInt64())
+		})
+	}
+}
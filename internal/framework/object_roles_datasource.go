@@ -84,13 +84,13 @@ func (o *ObjectRolesDataSource) Read(ctx context.Context, req datasource.ReadReq
 	}
 
 	endpoint := fmt.Sprintf(o.Endpoint, state.ID.ValueInt64())
-	data, d := ReadRequest(ctx, o.Client, endpoint, fmt.Sprintf("%s/ObjectRoles", o.DisplayName))
+	data, d := ListAll(ctx, o.Client, endpoint, fmt.Sprintf("%s/ObjectRoles", o.DisplayName), 0)
 	if DiagnosticsHasError(&resp.Diagnostics, d...) {
 		return
 	}
 
-	var sr models.SearchResultObjectRole
-	if err := mapstructure.Decode(data, &sr); err != nil {
+	var roles []models.ObjectRole
+	if err := mapstructure.Decode(data, &roles); err != nil {
 		resp.Diagnostics.AddError(
 			fmt.Sprintf("Unable to decode the search result data for %s", strings.ToLower(o.DisplayName)),
 			err.Error(),
@@ -98,8 +98,8 @@ func (o *ObjectRolesDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	in := make(map[string]attr.Value, sr.Count)
-	for _, role := range sr.Results {
+	in := make(map[string]attr.Value, len(roles))
+	for _, role := range roles {
 		in[role.Name] = types.Int64Value(role.ID)
 	}
 
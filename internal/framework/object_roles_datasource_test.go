@@ -2,9 +2,14 @@ package framework_test
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -79,3 +84,47 @@ func TestObjectRolesDataSource_Metadata(t *testing.T) {
 
 	assert.Equal(t, "awx_inventory_object_roles", resp.TypeName)
 }
+
+func TestObjectRolesDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+
+	// 30 roles spread over two pages, more than the AWX default page size.
+	page := func(from, to int, next any) map[string]any {
+		results := make([]any, 0, to-from+1)
+		for i := from; i <= to; i++ {
+			results = append(results, map[string]any{"id": json.Number(strconv.Itoa(i)), "name": fmt.Sprintf("Role %d", i)})
+		}
+		return map[string]any{"count": json.Number("30"), "next": next, "results": results}
+	}
+	var requested []string
+	requester := pagedRequester(map[string]map[string]any{
+		"/api/v2/inventories/7/object_roles/?page_size=200":        page(1, 25, "/api/v2/inventories/7/object_roles/?page=2&page_size=200"),
+		"/api/v2/inventories/7/object_roles/?page=2&page_size=200": page(26, 30, nil),
+	}, &requested)
+
+	ds := &framework.ObjectRolesDataSource{
+		DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{
+			TypeName: "inventory_object_roles",
+			Endpoint: "/api/v2/inventories/%d/object_roles/",
+			Client:   requester,
+		}},
+		DisplayName: "Inventory",
+	}
+
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	raw := tfsdk.State{Schema: schemaResp.Schema}
+	require.False(t, raw.Set(ctx, &framework.ObjectRolesModel{ID: types.Int64Value(7), Roles: types.MapNull(types.Int64Type)}).HasError())
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	ds.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw.Raw}}, resp)
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.Len(t, requested, 2)
+
+	var state framework.ObjectRolesModel
+	require.False(t, resp.State.Get(ctx, &state).HasError())
+	roles := state.Roles.Elements()
+	assert.Len(t, roles, 30)
+	assert.Equal(t, types.Int64Value(1), roles["Role 1"])
+	assert.Equal(t, types.Int64Value(30), roles["Role 30"])
+}
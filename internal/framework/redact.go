@@ -0,0 +1,204 @@
+package framework
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// RedactedValue replaces sensitive values in log fields.
+	RedactedValue = "<redacted>"
+	// EncryptedPlaceholder is what AWX returns in place of secret values.
+	EncryptedPlaceholder = "$encrypted$"
+)
+
+// sensitiveKeys holds the lower-cased payload keys whose values must never
+// reach the logs. It is shared by every resource and data source: a key that
+// is Sensitive in one schema (e.g. "password" on awx_user) is masked in every
+// payload, including opaque blobs such as credential inputs.
+var sensitiveKeys sync.Map
+
+// RegisterSensitiveKeys marks payload keys as sensitive. Matching is case
+// insensitive, since AWX settings use upper-case keys in the API and
+// lower-case attribute names in the schema.
+func RegisterSensitiveKeys(keys ...string) {
+	for _, k := range keys {
+		if k != "" {
+			sensitiveKeys.Store(strings.ToLower(k), struct{}{})
+		}
+	}
+}
+
+// IsSensitiveKey reports whether values stored under key are masked in logs.
+func IsSensitiveKey(key string) bool {
+	_, ok := sensitiveKeys.Load(strings.ToLower(key))
+	return ok
+}
+
+// RegisterResourceSchema registers every Sensitive attribute of the resource
+// schema, including attributes of nested objects and blocks.
+func RegisterResourceSchema(s rschema.Schema) {
+	registerResourceAttributes(s.Attributes)
+	registerResourceBlocks(s.Blocks)
+}
+
+// RegisterDataSourceSchema registers every Sensitive attribute of the data
+// source schema, including attributes of nested objects and blocks.
+func RegisterDataSourceSchema(s dschema.Schema) {
+	registerDataSourceAttributes(s.Attributes)
+	registerDataSourceBlocks(s.Blocks)
+}
+
+func registerResourceAttributes(attrs map[string]rschema.Attribute) {
+	for name, attr := range attrs {
+		if attr.IsSensitive() {
+			RegisterSensitiveKeys(name)
+		}
+		switch a := attr.(type) {
+		case rschema.SingleNestedAttribute:
+			registerResourceAttributes(a.Attributes)
+		case rschema.ListNestedAttribute:
+			registerResourceAttributes(a.NestedObject.Attributes)
+		case rschema.SetNestedAttribute:
+			registerResourceAttributes(a.NestedObject.Attributes)
+		case rschema.MapNestedAttribute:
+			registerResourceAttributes(a.NestedObject.Attributes)
+		}
+	}
+}
+
+func registerResourceBlocks(blocks map[string]rschema.Block) {
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case rschema.SingleNestedBlock:
+			registerResourceAttributes(b.Attributes)
+			registerResourceBlocks(b.Blocks)
+		case rschema.ListNestedBlock:
+			registerResourceAttributes(b.NestedObject.Attributes)
+			registerResourceBlocks(b.NestedObject.Blocks)
+		case rschema.SetNestedBlock:
+			registerResourceAttributes(b.NestedObject.Attributes)
+			registerResourceBlocks(b.NestedObject.Blocks)
+		}
+	}
+}
+
+func registerDataSourceAttributes(attrs map[string]dschema.Attribute) {
+	for name, attr := range attrs {
+		if attr.IsSensitive() {
+			RegisterSensitiveKeys(name)
+		}
+		switch a := attr.(type) {
+		case dschema.SingleNestedAttribute:
+			registerDataSourceAttributes(a.Attributes)
+		case dschema.ListNestedAttribute:
+			registerDataSourceAttributes(a.NestedObject.Attributes)
+		case dschema.SetNestedAttribute:
+			registerDataSourceAttributes(a.NestedObject.Attributes)
+		case dschema.MapNestedAttribute:
+			registerDataSourceAttributes(a.NestedObject.Attributes)
+		}
+	}
+}
+
+func registerDataSourceBlocks(blocks map[string]dschema.Block) {
+	for _, block := range blocks {
+		switch b := block.(type) {
+		case dschema.SingleNestedBlock:
+			registerDataSourceAttributes(b.Attributes)
+			registerDataSourceBlocks(b.Blocks)
+		case dschema.ListNestedBlock:
+			registerDataSourceAttributes(b.NestedObject.Attributes)
+			registerDataSourceBlocks(b.NestedObject.Blocks)
+		case dschema.SetNestedBlock:
+			registerDataSourceAttributes(b.NestedObject.Attributes)
+			registerDataSourceBlocks(b.NestedObject.Blocks)
+		}
+	}
+}
+
+// Redact returns a deep copy of v with the values of sensitive keys replaced
+// by RedactedValue. Keys AWX answers with `$encrypted$` are registered as
+// sensitive on the way, so the real value is masked when it is sent later on.
+// Values other than maps and slices are returned unchanged.
+func Redact(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, item := range val {
+			if s, ok := item.(string); ok && s == EncryptedPlaceholder {
+				RegisterSensitiveKeys(k)
+				out[k] = item
+				continue
+			}
+			if item != nil && IsSensitiveKey(k) {
+				out[k] = RedactedValue
+				continue
+			}
+			out[k] = Redact(item)
+		}
+		return out
+	case []any:
+		out := make([]any, len(val))
+		for i, item := range val {
+			out[i] = Redact(item)
+		}
+		return out
+	}
+	return v
+}
+
+// secretValues collects the string values stored under sensitive keys in v,
+// at any depth.
+func secretValues(v any) (secrets []string) {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, item := range val {
+			if IsSensitiveKey(k) {
+				secrets = append(secrets, stringLeaves(item)...)
+				continue
+			}
+			secrets = append(secrets, secretValues(item)...)
+		}
+	case []any:
+		for _, item := range val {
+			secrets = append(secrets, secretValues(item)...)
+		}
+	}
+	return secrets
+}
+
+func stringLeaves(v any) (out []string) {
+	switch val := v.(type) {
+	case string:
+		if val != "" && val != EncryptedPlaceholder {
+			out = append(out, val)
+		}
+	case map[string]any:
+		for _, item := range val {
+			out = append(out, stringLeaves(item)...)
+		}
+	case []any:
+		for _, item := range val {
+			out = append(out, stringLeaves(item)...)
+		}
+	}
+	return out
+}
+
+// MaskSecrets returns a context whose logger masks every secret value found
+// in payload, wherever it shows up later: log messages, error strings that
+// echo the AWX response, and the HTTP client's own log fields.
+func MaskSecrets(ctx context.Context, payload any) context.Context {
+	secrets := secretValues(payload)
+	if len(secrets) == 0 {
+		return ctx
+	}
+	ctx = tflog.MaskAllFieldValuesStrings(ctx, secrets...)
+	return tflog.MaskMessageStrings(ctx, secrets...)
+}
@@ -0,0 +1,184 @@
+package framework_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+func TestRegisterSchema(t *testing.T) {
+	framework.RegisterResourceSchema(rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"redact_test_name":   rschema.StringAttribute{Optional: true},
+			"redact_test_secret": rschema.StringAttribute{Optional: true, Sensitive: true},
+			"redact_test_nested": rschema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]rschema.Attribute{
+					"redact_test_nested_secret": rschema.StringAttribute{Optional: true, Sensitive: true},
+				},
+			},
+		},
+		Blocks: map[string]rschema.Block{
+			"redact_test_block": rschema.ListNestedBlock{
+				NestedObject: rschema.NestedBlockObject{
+					Attributes: map[string]rschema.Attribute{
+						"redact_test_block_secret": rschema.StringAttribute{Optional: true, Sensitive: true},
+					},
+				},
+			},
+		},
+	})
+	framework.RegisterDataSourceSchema(dschema.Schema{
+		Attributes: map[string]dschema.Attribute{
+			"redact_test_ds_secret": dschema.StringAttribute{Computed: true, Sensitive: true},
+			"redact_test_ds_list": dschema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: dschema.NestedAttributeObject{
+					Attributes: map[string]dschema.Attribute{
+						"redact_test_ds_nested_secret": dschema.StringAttribute{Computed: true, Sensitive: true},
+					},
+				},
+			},
+		},
+	})
+
+	for _, key := range []string{
+		"redact_test_secret", "REDACT_TEST_SECRET", "redact_test_nested_secret", "redact_test_block_secret",
+		"redact_test_ds_secret", "redact_test_ds_nested_secret",
+	} {
+		assert.True(t, framework.IsSensitiveKey(key), key)
+	}
+	for _, key := range []string{"redact_test_name", "redact_test_nested", "redact_test_block", "redact_test_ds_list"} {
+		assert.False(t, framework.IsSensitiveKey(key), key)
+	}
+}
+
+func TestRedact(t *testing.T) {
+	framework.RegisterSensitiveKeys("redact_pw")
+
+	in := map[string]any{
+		"name":      "visible",
+		"REDACT_PW": "hunter2",
+		"inputs": map[string]any{
+			"username":  "admin",
+			"redact_pw": "hunter3",
+		},
+		"items": []any{
+			map[string]any{"redact_pw": json.Number("1234")},
+			"plain",
+		},
+		"redact_learned": framework.EncryptedPlaceholder,
+		"empty":          nil,
+	}
+
+	out := framework.Redact(in)
+	assert.Equal(t, map[string]any{
+		"name":      "visible",
+		"REDACT_PW": framework.RedactedValue,
+		"inputs": map[string]any{
+			"username":  "admin",
+			"redact_pw": framework.RedactedValue,
+		},
+		"items": []any{
+			map[string]any{"redact_pw": framework.RedactedValue},
+			"plain",
+		},
+		"redact_learned": framework.EncryptedPlaceholder,
+		"empty":          nil,
+	}, out)
+	assert.Equal(t, "hunter2", in["REDACT_PW"], "input must not be modified")
+
+	assert.True(t, framework.IsSensitiveKey("redact_learned"), "keys returned as $encrypted$ are learned")
+	assert.Equal(t, map[string]any{"redact_learned": framework.RedactedValue}, framework.Redact(map[string]any{"redact_learned": "real value"}))
+
+	assert.Equal(t, "scalar", framework.Redact("scalar"))
+	assert.Nil(t, framework.Redact(nil))
+}
+
+// TestRedaction_NoSecretReachesLogger drives requests through the real client
+// with a trace level logger and checks that none of the secret values end up
+// in the log output, from the framework or from the client.
+func TestRedaction_NoSecretReachesLogger(t *testing.T) {
+	framework.RegisterResourceSchema(rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			"redact_bind_password": rschema.StringAttribute{Optional: true, Sensitive: true},
+			"redact_client_secret": rschema.StringAttribute{Computed: true, Sensitive: true},
+		},
+	})
+
+	const (
+		requestSecret  = "req-s3cret-value"
+		responseSecret = "resp-s3cret-value"
+		inputsSecret   = "inputs-s3cret-value"
+	)
+
+	var calls atomic.Int32
+	svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch {
+		case calls.Add(1) == 1:
+			// Exercise the client's retry logging as well.
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case r.Method == http.MethodPatch:
+			// AWX validation errors can echo the submitted values back.
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write(body)
+		case r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`{"id":1,"inputs":{"redact_bind_password":"$encrypted$"},"redact_client_secret":"` + responseSecret + `"}`))
+		default:
+			_, _ = w.Write([]byte(`{"id":1,"redact_bind_password":"$encrypted$"}`))
+		}
+	}))
+	t.Cleanup(svr.Close)
+
+	var logs bytes.Buffer
+	ctx := tflogtest.RootLogger(t.Context(), &logs)
+	c := client.NewClientWithBasicAuth("admin", "admin", svr.URL, "test", true, nil,
+		client.RetryConfig{MaxRetries: 1, WaitMin: time.Millisecond, WaitMax: time.Millisecond})
+
+	body := map[string]any{
+		"name":                 "visible-name",
+		"REDACT_BIND_PASSWORD": requestSecret,
+		"inputs":               json.RawMessage(`{"username":"admin","redact_bind_password":"` + inputsSecret + `"}`),
+	}
+
+	_, d := framework.CreateUpdateRequest(ctx, c, http.MethodPut, "/api/v2/things/1/", body, "Thing", "update")
+	require.False(t, d.HasError(), "%v", d)
+
+	_, d = framework.CreateUpdateRequest(ctx, c, http.MethodPatch, "/api/v2/things/1/", body, "Thing", "update")
+	require.True(t, d.HasError())
+
+	_, d = framework.ReadRequest(ctx, c, "/api/v2/things/1/", "Thing")
+	require.False(t, d.HasError(), "%v", d)
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	require.NoError(t, err)
+	require.NotEmpty(t, entries)
+
+	var raw bytes.Buffer
+	enc := json.NewEncoder(&raw)
+	enc.SetEscapeHTML(false)
+	require.NoError(t, enc.Encode(entries))
+	output := raw.String()
+	for _, secret := range []string{requestSecret, responseSecret, inputsSecret} {
+		assert.NotContains(t, output, secret)
+	}
+	assert.Contains(t, output, "visible-name")
+	assert.Contains(t, output, "Retrying AWX request")
+	assert.Contains(t, output, "HTTP response")
+	assert.Contains(t, output, framework.RedactedValue)
+}
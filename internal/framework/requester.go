@@ -4,6 +4,8 @@ import (
 	"context"
 	"io"
 	"net/http"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
 )
 
 // Requester is the minimal interface for making HTTP requests to the AWX API.
@@ -11,4 +13,8 @@ import (
 type Requester interface {
 	NewRequest(ctx context.Context, method string, endpoint string, body io.Reader) (*http.Request, error)
 	Do(ctx context.Context, req *http.Request) (map[string]any, error)
+	// DoRaw returns the body undecoded, for the endpoints that do not answer JSON.
+	DoRaw(ctx context.Context, req *http.Request) (*client.RawResponse, error)
+	// DoRawLimit is DoRaw that reads at most limit bytes of the body.
+	DoRawLimit(ctx context.Context, req *http.Request, limit int64) (*client.RawResponse, error)
 }
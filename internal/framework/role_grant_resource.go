@@ -0,0 +1,306 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/mapstructure"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/models"
+)
+
+// RoleGrantConfig configures a RoleGrantResource.
+type RoleGrantConfig struct {
+	// TypeName is the Terraform type name suffix (e.g. "role_grant").
+	TypeName string
+	// Principals maps a principal_type to the endpoint of the roles held by
+	// the principal, with %d for the principal ID
+	// (e.g. "team" → "/api/v2/teams/%d/roles/").
+	Principals map[string]string
+	// ObjectRoles maps an object_type to the object_roles endpoint of the
+	// object, with %d for the object ID
+	// (e.g. "job_template" → "/api/v2/job_templates/%d/object_roles/").
+	ObjectRoles map[string]string
+}
+
+var (
+	_ resource.Resource                = (*RoleGrantResource)(nil)
+	_ resource.ResourceWithConfigure   = (*RoleGrantResource)(nil)
+	_ resource.ResourceWithImportState = (*RoleGrantResource)(nil)
+)
+
+// RoleGrantModel is the state model of RoleGrantResource.
+type RoleGrantModel struct {
+	PrincipalType types.String `tfsdk:"principal_type"`
+	PrincipalID   types.Int64  `tfsdk:"principal_id"`
+	ObjectType    types.String `tfsdk:"object_type"`
+	ObjectID      types.Int64  `tfsdk:"object_id"`
+	Role          types.String `tfsdk:"role"`
+	RoleID        types.Int64  `tfsdk:"role_id"`
+}
+
+// RoleGrantResource grants a user or a team a role of an object, addressing
+// the role by name. The role ID is resolved through the object_roles endpoint
+// of the object, the same one the <resource>_object_roles data sources read,
+// and the grant is an association on the roles of the principal.
+type RoleGrantResource struct {
+	ResourceBase
+	cfg RoleGrantConfig
+}
+
+// NewRoleGrantResource constructs a RoleGrantResource.
+func NewRoleGrantResource(cfg RoleGrantConfig) resource.Resource {
+	return &RoleGrantResource{
+		ResourceBase: ResourceBase{
+			ProviderBase: ProviderBase{TypeName: cfg.TypeName},
+		},
+		cfg: cfg,
+	}
+}
+
+// Schema defines the schema for the resource.
+func (o *RoleGrantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Grants a user or a team a role of an object, the role is looked up by name.",
+		Attributes: map[string]schema.Attribute{
+			"principal_type": schema.StringAttribute{
+				Description: "Type of the principal receiving the role.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(sortedKeys(o.cfg.Principals)...),
+				},
+			},
+			"principal_id": schema.Int64Attribute{
+				Description: "Database ID of the user or team receiving the role.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"object_type": schema.StringAttribute{
+				Description: fmt.Sprintf("Type of the object the role belongs to, one of %s.", strings.Join(sortedKeys(o.cfg.ObjectRoles), ", ")),
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(sortedKeys(o.cfg.ObjectRoles)...),
+				},
+			},
+			"object_id": schema.Int64Attribute{
+				Description: "Database ID of the object the role belongs to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Description: "Name of the role of the object, e.g. Admin, Execute, Use or Read.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"role_id": schema.Int64Attribute{
+				Description: "Database ID of the resolved role.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ImportState imports a grant as
+// <principal_type>/<principal_id>/<object_type>/<object_id>/<role>.
+func (o *RoleGrantResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts := strings.SplitN(request.ID, "/", 5)
+	if len(parts) != 5 {
+		response.Diagnostics.AddError(
+			"Unable to import state for role grant, invalid format.",
+			fmt.Sprintf("requires the identifier to be set to <principal_type>/<principal_id>/<object_type>/<object_id>/<role>, currently set to %s", request.ID),
+		)
+		return
+	}
+
+	principalID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("Unable to parse '%v' as an int64 number, please provide the principal_id for the role grant.", parts[1]),
+			err.Error(),
+		)
+		return
+	}
+	objectID, err := strconv.ParseInt(parts[3], 10, 64)
+	if err != nil {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("Unable to parse '%v' as an int64 number, please provide the object_id for the role grant.", parts[3]),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, ok := o.cfg.Principals[parts[0]]; !ok {
+		response.Diagnostics.AddError("Unable to import state for role grant, unknown principal_type.",
+			fmt.Sprintf("principal_type must be one of %s, got %q", strings.Join(sortedKeys(o.cfg.Principals), ", "), parts[0]))
+		return
+	}
+	if _, ok := o.cfg.ObjectRoles[parts[2]]; !ok {
+		response.Diagnostics.AddError("Unable to import state for role grant, unknown object_type.",
+			fmt.Sprintf("object_type must be one of %s, got %q", strings.Join(sortedKeys(o.cfg.ObjectRoles), ", "), parts[2]))
+		return
+	}
+
+	state := RoleGrantModel{
+		PrincipalType: types.StringValue(parts[0]),
+		PrincipalID:   types.Int64Value(principalID),
+		ObjectType:    types.StringValue(parts[2]),
+		ObjectID:      types.Int64Value(objectID),
+		Role:          types.StringValue(parts[4]),
+		RoleID:        types.Int64Null(),
+	}
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+// Create resolves the role and associates it with the principal.
+func (o *RoleGrantResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan RoleGrantModel
+	if DiagnosticsHasError(&response.Diagnostics, request.Plan.Get(ctx, &plan)...) {
+		return
+	}
+
+	roleID, found, d := o.resolveRole(ctx, plan, false)
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	if !found {
+		response.Diagnostics.AddError("Object not found",
+			fmt.Sprintf("%s %d does not exist", plan.ObjectType.ValueString(), plan.ObjectID.ValueInt64()))
+		return
+	}
+
+	if DiagnosticsHasError(&response.Diagnostics, sendAssociation(ctx, o.Client, o.principalEndpoint(plan), roleID, false, "RoleGrant")...) {
+		return
+	}
+
+	plan.RoleID = types.Int64Value(roleID)
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+// Read resolves the role again and drops the resource from state when the
+// object, the role or the principal is gone, or when the principal no longer
+// holds the role, so Terraform grants it again on the next apply.
+func (o *RoleGrantResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state RoleGrantModel
+	if DiagnosticsHasError(&response.Diagnostics, request.State.Get(ctx, &state)...) {
+		return
+	}
+
+	roleID, found, d := o.resolveRole(ctx, state, true)
+	if DiagnosticsHasError(&response.Diagnostics, d...) {
+		return
+	}
+	if found {
+		found, d = o.holdsRole(ctx, state, roleID)
+		if DiagnosticsHasError(&response.Diagnostics, d...) {
+			return
+		}
+	}
+	if !found {
+		tflog.Debug(ctx, "[RoleGrant/read] Role grant no longer exists", map[string]any{
+			"principal_type": state.PrincipalType.ValueString(),
+			"principal_id":   state.PrincipalID.ValueInt64(),
+			"object_type":    state.ObjectType.ValueString(),
+			"object_id":      state.ObjectID.ValueInt64(),
+			"role":           state.Role.ValueString(),
+		})
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	state.RoleID = types.Int64Value(roleID)
+	response.Diagnostics.Append(response.State.Set(ctx, &state)...)
+}
+
+// Update is a no-op — every argument uses RequiresReplace.
+func (o *RoleGrantResource) Update(_ context.Context, _ resource.UpdateRequest, _ *resource.UpdateResponse) {
+}
+
+// Delete disassociates the role from the principal.
+func (o *RoleGrantResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state RoleGrantModel
+	if DiagnosticsHasError(&response.Diagnostics, request.State.Get(ctx, &state)...) {
+		return
+	}
+	response.Diagnostics.Append(sendAssociation(ctx, o.Client, o.principalEndpoint(state), state.RoleID.ValueInt64(), true, "RoleGrant")...)
+}
+
+// resolveRole looks the role up by name in the object_roles of the object.
+// found is false when the object no longer exists, and, with allowMissing,
+// when the object has no role with that name.
+func (o *RoleGrantResource) resolveRole(ctx context.Context, m RoleGrantModel, allowMissing bool) (roleID int64, found bool, diags diag.Diagnostics) {
+	endpoint := fmt.Sprintf(o.cfg.ObjectRoles[m.ObjectType.ValueString()], m.ObjectID.ValueInt64())
+	data, found, diags := ListAllAllowNotFound(ctx, o.Client, endpoint, "RoleGrant/ObjectRoles", 0)
+	if diags.HasError() || !found {
+		return 0, found, diags
+	}
+
+	var roles []models.ObjectRole
+	if err := mapstructure.Decode(data, &roles); err != nil {
+		diags.AddError("Unable to decode the object roles", err.Error())
+		return 0, false, diags
+	}
+
+	var names []string
+	for _, role := range roles {
+		if role.Name == m.Role.ValueString() {
+			return role.ID, true, diags
+		}
+		names = append(names, role.Name)
+	}
+	if !allowMissing {
+		diags.AddAttributeError(path.Root("role"), "Role not found",
+			fmt.Sprintf("%s %d has no role named %q, available roles are: %s",
+				m.ObjectType.ValueString(), m.ObjectID.ValueInt64(), m.Role.ValueString(), strings.Join(names, ", ")))
+	}
+	return 0, false, diags
+}
+
+// holdsRole reports whether the principal holds the role. A principal that
+// no longer exists holds no roles.
+func (o *RoleGrantResource) holdsRole(ctx context.Context, m RoleGrantModel, roleID int64) (bool, diag.Diagnostics) {
+	items, found, diags := ListAllAllowNotFound(ctx, o.Client, o.principalEndpoint(m), "RoleGrant", 0)
+	if diags.HasError() || !found {
+		return false, diags
+	}
+	for _, item := range items {
+		if id, err := int64FromAPI(item["id"]); err == nil && id == roleID {
+			return true, diags
+		}
+	}
+	return false, diags
+}
+
+func (o *RoleGrantResource) principalEndpoint(m RoleGrantModel) string {
+	return fmt.Sprintf(o.cfg.Principals[m.PrincipalType.ValueString()], m.PrincipalID.ValueInt64())
+}
@@ -0,0 +1,237 @@
+package framework_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+var roleGrantCfg = framework.RoleGrantConfig{
+	TypeName: "role_grant",
+	Principals: map[string]string{
+		"team": "/api/v2/teams/%d/roles/",
+		"user": "/api/v2/users/%d/roles/",
+	},
+	ObjectRoles: map[string]string{
+		"job_template": "/api/v2/job_templates/%d/object_roles/",
+	},
+}
+
+func newRoleGrantResource(t *testing.T, handler http.HandlerFunc) (*framework.RoleGrantResource, *resource.SchemaResponse) {
+	t.Helper()
+	svr := httptest.NewServer(handler)
+	t.Cleanup(svr.Close)
+
+	r := framework.NewRoleGrantResource(roleGrantCfg).(*framework.RoleGrantResource)
+	r.Client = client.NewClientWithBasicAuth("admin", "admin", svr.URL, "test", true, nil, client.RetryConfig{})
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(context.Background(), resource.SchemaRequest{}, schemaResp)
+	return r, schemaResp
+}
+
+func roleGrantValue(t *testing.T, s *resource.SchemaResponse, role string, roleID any) tftypes.Value {
+	t.Helper()
+	return tftypes.NewValue(s.Schema.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"principal_type": tftypes.NewValue(tftypes.String, "team"),
+		"principal_id":   tftypes.NewValue(tftypes.Number, 4),
+		"object_type":    tftypes.NewValue(tftypes.String, "job_template"),
+		"object_id":      tftypes.NewValue(tftypes.Number, 7),
+		"role":           tftypes.NewValue(tftypes.String, role),
+		"role_id":        tftypes.NewValue(tftypes.Number, roleID),
+	})
+}
+
+const jobTemplateObjectRoles = `{"next":null,"results":[{"id":30,"name":"Admin"},{"id":31,"name":"Execute"},{"id":32,"name":"Read"}]}`
+
+func TestRoleGrantResource_Create(t *testing.T) {
+	tests := []struct {
+		name      string
+		role      string
+		rolesCode int
+		wantError bool
+		wantRole  int64
+		wantBody  string
+	}{
+		{name: "resolves the role by name", role: "Execute", wantRole: 31, wantBody: `{"id":31}`},
+		{name: "unknown role name", role: "Use", wantError: true},
+		{name: "missing object", role: "Execute", rolesCode: http.StatusNotFound, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body string
+			r, s := newRoleGrantResource(t, func(w http.ResponseWriter, req *http.Request) {
+				switch req.URL.Path {
+				case "/api/v2/job_templates/7/object_roles/":
+					if tt.rolesCode != 0 {
+						w.WriteHeader(tt.rolesCode)
+						return
+					}
+					_, _ = w.Write([]byte(jobTemplateObjectRoles))
+				case "/api/v2/teams/4/roles/":
+					require.Equal(t, http.MethodPost, req.Method)
+					payload, _ := io.ReadAll(req.Body)
+					body = string(payload)
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+				}
+			})
+
+			ctx := context.Background()
+			plan := tfsdk.Plan{Schema: s.Schema, Raw: roleGrantValue(t, s, tt.role, tftypes.UnknownValue)}
+			resp := &resource.CreateResponse{State: tfsdk.State{Schema: s.Schema, Raw: tftypes.NewValue(s.Schema.Type().TerraformType(ctx), nil)}}
+			r.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+
+			if tt.wantError {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Empty(t, body)
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.JSONEq(t, tt.wantBody, body)
+
+			var state framework.RoleGrantModel
+			require.False(t, resp.State.Get(ctx, &state).HasError())
+			assert.Equal(t, types.Int64Value(tt.wantRole), state.RoleID)
+		})
+	}
+}
+
+func TestRoleGrantResource_Read(t *testing.T) {
+	tests := []struct {
+		name        string
+		role        string
+		objectRoles int
+		roles       string
+		rolesCode   int
+		wantRemoved bool
+		wantError   bool
+		wantRoleID  int64
+	}{
+		{name: "principal holds the role", role: "Execute", roles: `{"next":null,"results":[{"id":31}]}`, wantRoleID: 31},
+		{name: "role revoked outside terraform", role: "Execute", roles: `{"next":null,"results":[{"id":30}]}`, wantRemoved: true},
+		{name: "role no longer exists", role: "Use", wantRemoved: true},
+		{name: "object deleted", role: "Execute", objectRoles: http.StatusNotFound, wantRemoved: true},
+		{name: "principal deleted", role: "Execute", rolesCode: http.StatusNotFound, wantRemoved: true},
+		{name: "api errors fail the read", role: "Execute", objectRoles: http.StatusInternalServerError, wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, s := newRoleGrantResource(t, func(w http.ResponseWriter, req *http.Request) {
+				switch req.URL.Path {
+				case "/api/v2/job_templates/7/object_roles/":
+					if tt.objectRoles != 0 {
+						w.WriteHeader(tt.objectRoles)
+						return
+					}
+					_, _ = w.Write([]byte(jobTemplateObjectRoles))
+				case "/api/v2/teams/4/roles/":
+					if tt.rolesCode != 0 {
+						w.WriteHeader(tt.rolesCode)
+						return
+					}
+					_, _ = w.Write([]byte(tt.roles))
+				default:
+					t.Errorf("unexpected request %s %s", req.Method, req.URL.Path)
+				}
+			})
+
+			ctx := context.Background()
+			state := tfsdk.State{Schema: s.Schema, Raw: roleGrantValue(t, s, tt.role, 31)}
+			resp := &resource.ReadResponse{State: state}
+			r.Read(ctx, resource.ReadRequest{State: state}, resp)
+
+			if tt.wantError {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+			assert.Equal(t, tt.wantRemoved, resp.State.Raw.IsNull())
+			if !tt.wantRemoved {
+				var got framework.RoleGrantModel
+				require.False(t, resp.State.Get(ctx, &got).HasError())
+				assert.Equal(t, types.Int64Value(tt.wantRoleID), got.RoleID)
+			}
+		})
+	}
+}
+
+func TestRoleGrantResource_Delete(t *testing.T) {
+	var body map[string]any
+	r, s := newRoleGrantResource(t, func(w http.ResponseWriter, req *http.Request) {
+		require.Equal(t, "/api/v2/teams/4/roles/", req.URL.Path)
+		require.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := context.Background()
+	state := tfsdk.State{Schema: s.Schema, Raw: roleGrantValue(t, s, "Execute", 31)}
+	resp := &resource.DeleteResponse{State: state}
+	r.Delete(ctx, resource.DeleteRequest{State: state}, resp)
+
+	require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+	assert.Equal(t, map[string]any{"id": float64(31), "disassociate": true}, body)
+}
+
+func TestRoleGrantResource_ImportState(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		wantError bool
+		want      framework.RoleGrantModel
+	}{
+		{
+			name: "valid",
+			id:   "team/4/job_template/7/Execute",
+			want: framework.RoleGrantModel{
+				PrincipalType: types.StringValue("team"),
+				PrincipalID:   types.Int64Value(4),
+				ObjectType:    types.StringValue("job_template"),
+				ObjectID:      types.Int64Value(7),
+				Role:          types.StringValue("Execute"),
+				RoleID:        types.Int64Null(),
+			},
+		},
+		{name: "too few parts", id: "team/4/job_template/7", wantError: true},
+		{name: "invalid principal id", id: "team/x/job_template/7/Execute", wantError: true},
+		{name: "invalid object id", id: "team/4/job_template/x/Execute", wantError: true},
+		{name: "unknown principal type", id: "group/4/job_template/7/Execute", wantError: true},
+		{name: "unknown object type", id: "team/4/project/7/Use", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r := framework.NewRoleGrantResource(roleGrantCfg).(*framework.RoleGrantResource)
+			s := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, s)
+
+			resp := &resource.ImportStateResponse{State: tfsdk.State{Schema: s.Schema, Raw: tftypes.NewValue(s.Schema.Type().TerraformType(ctx), nil)}}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, resp)
+			if tt.wantError {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var got framework.RoleGrantModel
+			require.False(t, resp.State.Get(ctx, &got).HasError())
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
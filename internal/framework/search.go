@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	p "path"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -25,6 +26,42 @@ type SearchGroup struct {
 	Name      string        // Group identifier (e.g., "by_id", "by_name").
 	URLSuffix string        // fmt pattern appended to the endpoint (e.g., "%d/", "?name__exact=%s").
 	Fields    []SearchField // Attributes that must all be set for this group to match.
+	// NamedURL marks a group whose URLSuffix is an AWX named URL (e.g.
+	// "%s++%s/"). Values are escaped with NamedURLEscape instead of URLEscape.
+	NamedURL bool
+}
+
+// value formats a configured string value for use in the group's URLSuffix.
+func (g SearchGroup) value(field SearchField, v string) string {
+	switch {
+	case g.NamedURL:
+		return NamedURLEscape(v)
+	case field.URLEscape:
+		return url.PathEscape(v)
+	}
+	return v
+}
+
+// namedURLReserved are the characters AWX expects percent-encoded inside a
+// named URL part, on top of the ones that are not valid in a URL path.
+const namedURLReserved = ";/?:@=&[]"
+
+// NamedURLEscape escapes one part of an AWX named URL. Reserved characters
+// are percent-encoded and `+`, which AWX uses to join the parts, is written
+// as `[+]`.
+func NamedURLEscape(v string) string {
+	var b strings.Builder
+	for _, r := range v {
+		switch {
+		case r == '+':
+			b.WriteString("[+]")
+		case strings.ContainsRune(namedURLReserved, r):
+			fmt.Fprintf(&b, "%%%02X", r)
+		default:
+			b.WriteString(url.PathEscape(string(r)))
+		}
+	}
+	return b.String()
 }
 
 // EvaluateSearchGroups checks which search group is active based on config attributes
@@ -51,11 +88,7 @@ func EvaluateSearchGroups(ctx context.Context, config tfsdk.Config, baseEndpoint
 				config.GetAttribute(ctx, path.Root(field.Name), &tv)
 				v = tv
 				if !tv.IsNull() && !tv.IsUnknown() {
-					val := tv.ValueString()
-					if field.URLEscape {
-						val = url.PathEscape(val)
-					}
-					params = append(params, val)
+					params = append(params, group.value(field, tv.ValueString()))
 				}
 			}
 
@@ -233,3 +233,81 @@ func TestEvaluateSearchGroups(t *testing.T) {
 		})
 	}
 }
+
+func TestNamedURLEscape(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "Default", want: "Default"},
+		{in: "Deploy App", want: "Deploy%20App"},
+		{in: "a+b", want: "a[+]b"},
+		{in: "[+]", want: "%5B[+]%5D"},
+		{in: ";/?:@=&[]", want: "%3B%2F%3F%3A%40%3D%26%5B%5D"},
+		{in: "100%", want: "100%25"},
+		{in: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			assert.Equal(t, tt.want, framework.NamedURLEscape(tt.in))
+		})
+	}
+}
+
+func TestEvaluateSearchGroups_NamedURL(t *testing.T) {
+	ctx := context.Background()
+	schema := dschema.Schema{
+		Attributes: map[string]dschema.Attribute{
+			"id":                dschema.Int64Attribute{Optional: true},
+			"name":              dschema.StringAttribute{Optional: true},
+			"organization_name": dschema.StringAttribute{Optional: true},
+		},
+	}
+	groups := []framework.SearchGroup{
+		{Name: "by_id", URLSuffix: "%d/", Fields: []framework.SearchField{{Name: "id", Type: "int64"}}},
+		{Name: "by_named_url", URLSuffix: "%s++%s/", NamedURL: true, Fields: []framework.SearchField{
+			{Name: "name", Type: "string"},
+			{Name: "organization_name", Type: "string"},
+		}},
+		{Name: "by_name", URLSuffix: "?name__exact=%s", Fields: []framework.SearchField{{Name: "name", Type: "string", URLEscape: true}}},
+	}
+	config := func(name, org tftypes.Value) tfsdk.Config {
+		return tfsdk.Config{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id": nullNumber(), "name": name, "organization_name": org,
+		})}
+	}
+
+	tests := []struct {
+		name             string
+		config           tfsdk.Config
+		expectedEndpoint string
+	}{
+		{
+			name:             "named url before by_name",
+			config:           config(tftypes.NewValue(tftypes.String, "Deploy App"), tftypes.NewValue(tftypes.String, "Default")),
+			expectedEndpoint: "/api/v2/job_templates/Deploy%20App++Default",
+		},
+		{
+			name:             "parts are escaped",
+			config:           config(tftypes.NewValue(tftypes.String, "a+b/c"), tftypes.NewValue(tftypes.String, "R&D")),
+			expectedEndpoint: "/api/v2/job_templates/a[+]b%2Fc++R%26D",
+		},
+		{
+			name:             "no organization",
+			config:           config(tftypes.NewValue(tftypes.String, "Deploy"), tftypes.NewValue(tftypes.String, "")),
+			expectedEndpoint: "/api/v2/job_templates/Deploy++",
+		},
+		{
+			name:             "falls back to by_name",
+			config:           config(tftypes.NewValue(tftypes.String, "Deploy"), nullString()),
+			expectedEndpoint: "/api/v2/job_templates/?name__exact=Deploy",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			endpoint, diags := framework.EvaluateSearchGroups(ctx, tt.config, "/api/v2/job_templates", groups)
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, tt.expectedEndpoint, endpoint)
+		})
+	}
+}
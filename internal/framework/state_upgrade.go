@@ -0,0 +1,66 @@
+package framework
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// RawStateUpgraders returns the state upgraders of every schema version below
+// version. A prior state is read from its raw JSON like an API response: fix
+// adapts the attributes that changed shape (nil for none) and the model is
+// loaded with UpdateFromApiData. This works as long as the attribute names of
+// the schema match the API keys, as they do for generated resources.
+func RawStateUpgraders[T any, B any, PT ResourceModel[T, B]](version int64, fix func(data map[string]any)) map[int64]resource.StateUpgrader {
+	upgraders := make(map[int64]resource.StateUpgrader, version)
+	for prior := range version {
+		upgraders[prior] = resource.StateUpgrader{
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil {
+					resp.Diagnostics.AddError("Unable to upgrade the resource state", "the prior state is missing")
+					return
+				}
+				var data map[string]any
+				dec := json.NewDecoder(bytes.NewReader(req.RawState.JSON))
+				dec.UseNumber()
+				if err := dec.Decode(&data); err != nil {
+					resp.Diagnostics.AddError("Unable to upgrade the resource state", fmt.Sprintf("failed to decode the state of version %d: %s", prior, err))
+					return
+				}
+				if fix != nil {
+					fix(data)
+				}
+				var state T
+				if d, _ := PT(&state).UpdateFromApiData(data); DiagnosticsHasError(&resp.Diagnostics, d...) {
+					return
+				}
+				resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			},
+		}
+	}
+	return upgraders
+}
+
+// DecodeJSONStrings returns a RawStateUpgraders fix for attributes that a
+// prior schema held as a JSON string: their values are decoded in place, and
+// empty or invalid JSON becomes null.
+func DecodeJSONStrings(keys ...string) func(data map[string]any) {
+	return func(data map[string]any) {
+		for _, key := range keys {
+			s, ok := data[key].(string)
+			if !ok {
+				continue
+			}
+			var v any
+			dec := json.NewDecoder(bytes.NewReader([]byte(s)))
+			dec.UseNumber()
+			if err := dec.Decode(&v); err != nil {
+				v = nil
+			}
+			data[key] = v
+		}
+	}
+}
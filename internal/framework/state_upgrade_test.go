@@ -0,0 +1,129 @@
+package framework_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
+)
+
+// lookupModel holds an object attribute that version 0 of its schema held as
+// a JSON string.
+type lookupModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Metadata types.Object `tfsdk:"metadata"`
+}
+
+type lookupBody struct{}
+
+var lookupAttrTypes = map[string]attr.Type{"secret_path": types.StringType, "secret_key": types.StringType}
+
+func (m *lookupModel) Clone() lookupModel { return *m }
+
+func (m *lookupModel) BodyRequest() *lookupBody { return &lookupBody{} }
+
+func (m *lookupModel) UpdateFromApiData(data map[string]any) (diag.Diagnostics, error) {
+	diags := diag.Diagnostics{}
+	if data == nil {
+		return diags, fmt.Errorf("no data passed")
+	}
+	collect := func(d diag.Diagnostics, _ error) { diags.Append(d...) }
+	collect(helpers.AttrValueSetInt64(&m.ID, data["id"]))
+	collect(helpers.AttrValueSetObject(&m.Metadata, data["metadata"], lookupAttrTypes))
+	return diags, nil
+}
+
+var lookupSchema = rschema.Schema{
+	Version: 1,
+	Attributes: map[string]rschema.Attribute{
+		"id": rschema.Int64Attribute{Computed: true},
+		"metadata": rschema.SingleNestedAttribute{
+			Required: true,
+			Attributes: map[string]rschema.Attribute{
+				"secret_path": rschema.StringAttribute{Optional: true},
+				"secret_key":  rschema.StringAttribute{Optional: true},
+			},
+		},
+	},
+}
+
+func TestRawStateUpgraders(t *testing.T) {
+	upgraders := framework.RawStateUpgraders[lookupModel, lookupBody, *lookupModel](2, framework.DecodeJSONStrings("metadata"))
+	require.Len(t, upgraders, 2)
+	require.Contains(t, upgraders, int64(0))
+	require.Contains(t, upgraders, int64(1))
+
+	tests := []struct {
+		name      string
+		raw       string
+		want      map[string]attr.Value
+		wantNull  bool
+		wantError bool
+	}{
+		{
+			name: "json string becomes an object",
+			raw:  `{"id":7,"metadata":"{\"secret_path\":\"/kv/app\",\"secret_key\":\"password\"}"}`,
+			want: map[string]attr.Value{"secret_path": types.StringValue("/kv/app"), "secret_key": types.StringValue("password")},
+		},
+		{
+			name: "missing keys are null",
+			raw:  `{"id":7,"metadata":"{\"secret_path\":\"/kv/app\"}"}`,
+			want: map[string]attr.Value{"secret_path": types.StringValue("/kv/app"), "secret_key": types.StringNull()},
+		},
+		{
+			name:     "invalid json becomes null",
+			raw:      `{"id":7,"metadata":"not json"}`,
+			wantNull: true,
+		},
+		{
+			name:      "invalid state",
+			raw:       `[]`,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: lookupSchema}}
+			upgraders[0].StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(tt.raw)}}, resp)
+			if tt.wantError {
+				assert.True(t, resp.Diagnostics.HasError())
+				return
+			}
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var got lookupModel
+			require.False(t, resp.State.Get(ctx, &got).HasError())
+			assert.Equal(t, types.Int64Value(7), got.ID)
+			if tt.wantNull {
+				assert.True(t, got.Metadata.IsNull())
+				return
+			}
+			assert.Equal(t, tt.want, got.Metadata.Attributes())
+		})
+	}
+}
+
+func TestGenericResource_UpgradeState(t *testing.T) {
+	r := &framework.GenericResource[lookupModel, lookupBody, *lookupModel]{
+		Cfg: framework.ResourceCfg[lookupModel, lookupBody]{
+			Schema:         lookupSchema,
+			StateUpgraders: framework.RawStateUpgraders[lookupModel, lookupBody, *lookupModel](1, nil),
+		},
+	}
+	var _ resource.ResourceWithUpgradeState = r
+	assert.Len(t, r.UpgradeState(context.Background()), 1)
+}
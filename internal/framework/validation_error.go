@@ -0,0 +1,86 @@
+package framework
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
+)
+
+// AttributeResolver maps the API field path of an AWX validation message onto
+// a Terraform attribute. rest holds the trailing path segments that live inside
+// the attribute (e.g. keys of a JSON string attribute). ok=false means the
+// message is reported as a resource-wide error.
+type AttributeResolver func(field []string) (attr path.Path, rest []string, ok bool)
+
+// NewAttributeResolver builds an AttributeResolver for a generated model type.
+// API keys are matched against the model's `json` struct tags first and then,
+// case-insensitively, against the schema attribute names in attrs. When the
+// outermost key is unknown the innermost one is tried, which covers typed
+// credentials that flatten `inputs.<field>` into top-level attributes.
+func NewAttributeResolver[T any, A any](attrs map[string]A) AttributeResolver {
+	byKey := make(map[string]string, len(attrs))
+	for name := range attrs {
+		byKey[strings.ToLower(name)] = name
+	}
+	rt := reflect.TypeFor[T]()
+	if rt.Kind() == reflect.Struct {
+		for i := range rt.NumField() {
+			f := rt.Field(i)
+			tf := f.Tag.Get("tfsdk")
+			js, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if _, ok := attrs[tf]; !ok || js == "" || js == "-" {
+				continue
+			}
+			byKey[strings.ToLower(js)] = tf
+		}
+	}
+
+	return func(field []string) (path.Path, []string, bool) {
+		if len(field) == 0 {
+			return path.Empty(), nil, false
+		}
+		if name, ok := byKey[strings.ToLower(field[0])]; ok {
+			if len(field) == 1 {
+				return path.Root(name), nil, true
+			}
+			return path.Root(name), field[1:], true
+		}
+		if len(field) > 1 {
+			if name, ok := byKey[strings.ToLower(field[len(field)-1])]; ok {
+				return path.Root(name), nil, true
+			}
+		}
+		return path.Empty(), nil, false
+	}
+}
+
+// ValidationDiagnostics converts an AWX validation error into diagnostics.
+// Messages that resolve to an attribute become attribute errors so Terraform
+// points at the offending argument; the rest (non_field_errors, unknown keys)
+// become resource-wide errors.
+func ValidationDiagnostics(verr *client.ValidationError, summary string, resolve AttributeResolver) (diags diag.Diagnostics) {
+	for _, fe := range verr.Errors {
+		attr, rest, ok := resolve(fe.Field)
+		msg := fe.Message
+		switch {
+		case ok && len(rest) > 0:
+			msg = fmt.Sprintf("%s: %s", strings.Join(rest, "."), fe.Message)
+		case !ok && len(fe.Field) > 0:
+			msg = fmt.Sprintf("%s: %s", strings.Join(fe.Field, "."), fe.Message)
+		}
+		if ok {
+			diags.AddAttributeError(attr, summary, msg)
+		} else {
+			diags.AddError(summary, msg)
+		}
+	}
+	if len(verr.Errors) == 0 {
+		diags.AddError(summary, verr.Error())
+	}
+	return diags
+}
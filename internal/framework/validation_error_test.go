@@ -0,0 +1,113 @@
+package framework_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+type resolverModel struct {
+	ID          types.Int64  `tfsdk:"id" json:"id"`
+	BindDN      types.String `tfsdk:"auth_ldap_bind_dn" json:"AUTH_LDAP_BIND_DN"`
+	Password    types.String `tfsdk:"password" json:"password"`
+	ExtraVars   types.String `tfsdk:"extra_vars" json:"extra_vars"`
+	TerraformOn types.Bool   `tfsdk:"terraform_only"`
+}
+
+func TestNewAttributeResolver(t *testing.T) {
+	attrs := map[string]rschema.Attribute{
+		"id":                rschema.Int64Attribute{Computed: true},
+		"auth_ldap_bind_dn": rschema.StringAttribute{Optional: true},
+		"password":          rschema.StringAttribute{Optional: true},
+		"extra_vars":        rschema.StringAttribute{Optional: true},
+		"terraform_only":    rschema.BoolAttribute{Optional: true},
+	}
+	resolve := framework.NewAttributeResolver[resolverModel](attrs)
+
+	tests := []struct {
+		field []string
+		attr  path.Path
+		rest  []string
+		ok    bool
+	}{
+		{field: []string{"AUTH_LDAP_BIND_DN"}, attr: path.Root("auth_ldap_bind_dn"), ok: true},
+		{field: []string{"password"}, attr: path.Root("password"), ok: true},
+		{field: []string{"Terraform_Only"}, attr: path.Root("terraform_only"), ok: true},
+		{field: []string{"extra_vars", "hosts", "0"}, attr: path.Root("extra_vars"), rest: []string{"hosts", "0"}, ok: true},
+		{field: []string{"inputs", "password"}, attr: path.Root("password"), ok: true},
+		{field: []string{"inputs", "username"}, ok: false},
+		{field: []string{"unknown"}, ok: false},
+		{field: nil, ok: false},
+	}
+	for _, test := range tests {
+		attr, rest, ok := resolve(test.field)
+		require.Equal(t, test.ok, ok, "%v", test.field)
+		if ok {
+			assert.Equal(t, test.attr, attr, "%v", test.field)
+			assert.Equal(t, test.rest, rest, "%v", test.field)
+		}
+	}
+}
+
+func TestValidationDiagnostics(t *testing.T) {
+	resolve := framework.NewAttributeResolver[resolverModel](map[string]rschema.Attribute{
+		"password":   rschema.StringAttribute{Optional: true},
+		"extra_vars": rschema.StringAttribute{Optional: true},
+	})
+
+	t.Run("attribute and resource wide errors", func(t *testing.T) {
+		verr := &client.ValidationError{Errors: []client.FieldError{
+			{Field: []string{"password"}, Message: "Too short."},
+			{Field: []string{"extra_vars", "limit"}, Message: "Not allowed."},
+			{Message: "Cannot assign both."},
+			{Field: []string{"organization"}, Message: "Invalid pk."},
+		}}
+		diags := framework.ValidationDiagnostics(verr, "rejected", resolve)
+		require.Len(t, diags, 4)
+
+		assert.Equal(t, diag.NewAttributeErrorDiagnostic(path.Root("password"), "rejected", "Too short."), diags[0])
+		assert.Equal(t, diag.NewAttributeErrorDiagnostic(path.Root("extra_vars"), "rejected", "limit: Not allowed."), diags[1])
+		assert.Equal(t, diag.NewErrorDiagnostic("rejected", "Cannot assign both."), diags[2])
+		assert.Equal(t, diag.NewErrorDiagnostic("rejected", "organization: Invalid pk."), diags[3])
+	})
+
+	t.Run("empty body falls back to the raw error", func(t *testing.T) {
+		verr := &client.ValidationError{StatusCode: http.StatusBadRequest, RequestURI: "/api/v2/x/", Body: []byte(`{}`)}
+		diags := framework.ValidationDiagnostics(verr, "rejected", resolve)
+		require.Len(t, diags, 1)
+		assert.Equal(t, verr.Error(), diags[0].Detail())
+	})
+}
+
+func TestGenericResource_CreateValidationError(t *testing.T) {
+	r := newNamedResource(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"name": ["Organization with this Name already exists."], "non_field_errors": ["Something else."]}`))
+	})
+
+	plan := tfsdk.Plan{Schema: namedSchema}
+	require.False(t, plan.Set(context.Background(), &namedModel{ID: types.Int64Unknown(), Name: types.StringValue("dup")}).HasError())
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: namedSchema}}
+	r.Create(context.Background(), resource.CreateRequest{Plan: plan}, resp)
+
+	require.Len(t, resp.Diagnostics, 2)
+	attrDiag, ok := resp.Diagnostics[0].(diag.DiagnosticWithPath)
+	require.True(t, ok, "expected an attribute diagnostic")
+	assert.Equal(t, path.Root("name"), attrDiag.Path())
+	assert.Equal(t, "Organization with this Name already exists.", resp.Diagnostics[0].Detail())
+	_, ok = resp.Diagnostics[1].(diag.DiagnosticWithPath)
+	assert.False(t, ok, "non_field_errors are resource-wide")
+	assert.Equal(t, "Something else.", resp.Diagnostics[1].Detail())
+}
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ilijamt/terraform-provider-awx/internal/client"
 	"github.com/ilijamt/terraform-provider-awx/internal/framework"
 )
 
@@ -30,6 +31,14 @@ func (s *scriptedRequester) Do(_ context.Context, _ *http.Request) (map[string]a
 	return s.responses[i], nil
 }
 
+func (s *scriptedRequester) DoRaw(_ context.Context, _ *http.Request) (*client.RawResponse, error) {
+	return nil, errors.New("scriptedRequester only serves JSON")
+}
+
+func (s *scriptedRequester) DoRawLimit(_ context.Context, _ *http.Request, _ int64) (*client.RawResponse, error) {
+	return nil, errors.New("scriptedRequester only serves JSON")
+}
+
 func TestWaitForFieldValue(t *testing.T) {
 	t.Parallel()
 
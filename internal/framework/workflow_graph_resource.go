@@ -0,0 +1,1031 @@
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	p "path"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                   = (*WorkflowGraphResource)(nil)
+	_ resource.ResourceWithConfigure      = (*WorkflowGraphResource)(nil)
+	_ resource.ResourceWithImportState    = (*WorkflowGraphResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*WorkflowGraphResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*WorkflowGraphResource)(nil)
+)
+
+// workflowGraphEdges are the node sub-collections holding the edges of a
+// workflow, in the order AWX lists them.
+var workflowGraphEdges = []string{"success_nodes", "failure_nodes", "always_nodes"}
+
+// WorkflowGraphModel is the state model of WorkflowGraphResource.
+type WorkflowGraphModel struct {
+	WorkflowJobTemplateID types.Int64 `tfsdk:"workflow_job_template_id"`
+	Nodes                 types.List  `tfsdk:"nodes"`
+	NodeIDs               types.Map   `tfsdk:"node_ids"`
+}
+
+// WorkflowGraphNodeModel is one node of a WorkflowGraphModel. Edges point to
+// other nodes of the same graph by identifier.
+type WorkflowGraphNodeModel struct {
+	Identifier             types.String `tfsdk:"identifier"`
+	UnifiedJobTemplate     types.Int64  `tfsdk:"unified_job_template"`
+	AllParentsMustConverge types.Bool   `tfsdk:"all_parents_must_converge"`
+	Inventory              types.Int64  `tfsdk:"inventory"`
+	Limit                  types.String `tfsdk:"limit"`
+	ScmBranch              types.String `tfsdk:"scm_branch"`
+	JobTags                types.String `tfsdk:"job_tags"`
+	SkipTags               types.String `tfsdk:"skip_tags"`
+	ExtraData              types.String `tfsdk:"extra_data"`
+	CredentialIDs          types.Set    `tfsdk:"credential_ids"`
+	SuccessNodes           types.Set    `tfsdk:"success_nodes"`
+	FailureNodes           types.Set    `tfsdk:"failure_nodes"`
+	AlwaysNodes            types.Set    `tfsdk:"always_nodes"`
+	ApprovalTemplate       types.Object `tfsdk:"approval_template"`
+}
+
+// WorkflowGraphApprovalModel is the approval template of an approval node.
+type WorkflowGraphApprovalModel struct {
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Timeout     types.Int64  `tfsdk:"timeout"`
+}
+
+func (a WorkflowGraphApprovalModel) body() map[string]any {
+	return map[string]any{
+		"name":        a.Name.ValueString(),
+		"description": a.Description.ValueString(),
+		"timeout":     a.Timeout.ValueInt64(),
+	}
+}
+
+func (n WorkflowGraphNodeModel) edge(name string) types.Set {
+	switch name {
+	case "success_nodes":
+		return n.SuccessNodes
+	case "failure_nodes":
+		return n.FailureNodes
+	default:
+		return n.AlwaysNodes
+	}
+}
+
+func (n *WorkflowGraphNodeModel) setEdge(name string, value types.Set) {
+	switch name {
+	case "success_nodes":
+		n.SuccessNodes = value
+	case "failure_nodes":
+		n.FailureNodes = value
+	default:
+		n.AlwaysNodes = value
+	}
+}
+
+// sameSettings reports whether the node fields sent in the node body match,
+// edges and credentials aside.
+func (n WorkflowGraphNodeModel) sameSettings(o WorkflowGraphNodeModel) bool {
+	return n.UnifiedJobTemplate.Equal(o.UnifiedJobTemplate) &&
+		n.AllParentsMustConverge.Equal(o.AllParentsMustConverge) &&
+		n.Inventory.Equal(o.Inventory) &&
+		n.Limit.Equal(o.Limit) &&
+		n.ScmBranch.Equal(o.ScmBranch) &&
+		n.JobTags.Equal(o.JobTags) &&
+		n.SkipTags.Equal(o.SkipTags) &&
+		n.ExtraData.Equal(o.ExtraData)
+}
+
+// approval returns the approval template of the node, if it has one.
+func (n WorkflowGraphNodeModel) approval(ctx context.Context, diags *diag.Diagnostics) (WorkflowGraphApprovalModel, bool) {
+	var approval WorkflowGraphApprovalModel
+	if n.ApprovalTemplate.IsNull() || n.ApprovalTemplate.IsUnknown() {
+		return approval, false
+	}
+	diags.Append(n.ApprovalTemplate.As(ctx, &approval, basetypes.ObjectAsOptions{})...)
+	return approval, !diags.HasError()
+}
+
+// body is the payload that creates or updates the node in AWX. Null prompts
+// are sent as null so a prompt removed from the configuration is cleared.
+// The unified job template of an approval node is its approval template, so
+// it is left out for those.
+func (n WorkflowGraphNodeModel) body() (map[string]any, error) {
+	body := map[string]any{
+		"identifier":                n.Identifier.ValueString(),
+		"unified_job_template":      nullableInt64(n.UnifiedJobTemplate),
+		"all_parents_must_converge": n.AllParentsMustConverge.ValueBool(),
+		"inventory":                 nullableInt64(n.Inventory),
+		"limit":                     nullableString(n.Limit),
+		"scm_branch":                nullableString(n.ScmBranch),
+		"job_tags":                  nullableString(n.JobTags),
+		"skip_tags":                 nullableString(n.SkipTags),
+		"extra_data":                map[string]any{},
+	}
+	if !n.ExtraData.IsNull() {
+		var extraData map[string]any
+		if err := json.Unmarshal([]byte(n.ExtraData.ValueString()), &extraData); err != nil {
+			return nil, fmt.Errorf("extra_data of %q is not a JSON object: %w", n.Identifier.ValueString(), err)
+		}
+		body["extra_data"] = extraData
+	}
+	if !n.ApprovalTemplate.IsNull() {
+		delete(body, "unified_job_template")
+	}
+	return body, nil
+}
+
+func nullableInt64(v types.Int64) any {
+	if v.IsNull() {
+		return nil
+	}
+	return v.ValueInt64()
+}
+
+func nullableString(v types.String) any {
+	if v.IsNull() {
+		return nil
+	}
+	return v.ValueString()
+}
+
+// WorkflowGraphResource manages every node and edge of a workflow job
+// template as a single resource. Nodes are matched by identifier, so apply
+// only creates, updates and deletes the nodes that changed, and nodes added
+// to the workflow outside of Terraform show up as drift.
+type WorkflowGraphResource struct {
+	ResourceBase
+	nodeEndpoint     string
+	approvalEndpoint string
+}
+
+// NewWorkflowGraphResource constructs a WorkflowGraphResource. endpoint is the
+// workflow nodes sub-collection with %d for the workflow job template ID
+// (e.g. "/api/v2/workflow_job_templates/%d/workflow_nodes/"), nodeEndpoint
+// is the workflow job template nodes endpoint and approvalEndpoint the
+// workflow approval templates endpoint.
+func NewWorkflowGraphResource(typeName, endpoint, nodeEndpoint, approvalEndpoint string) resource.Resource {
+	return &WorkflowGraphResource{
+		ResourceBase: ResourceBase{
+			ProviderBase: ProviderBase{TypeName: typeName, Endpoint: endpoint},
+		},
+		nodeEndpoint:     nodeEndpoint,
+		approvalEndpoint: approvalEndpoint,
+	}
+}
+
+// Schema defines the schema for the resource.
+func (o *WorkflowGraphResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	edgeAttribute := func(description string) schema.SetAttribute {
+		return schema.SetAttribute{
+			Description: description,
+			ElementType: types.StringType,
+			Optional:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages every node and edge of a workflow job template. Nodes are matched by identifier, nodes and edges that are not configured are removed from the workflow.",
+		Attributes: map[string]schema.Attribute{
+			"workflow_job_template_id": schema.Int64Attribute{
+				Description: "Database ID for this WorkflowJobTemplate.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"nodes": schema.ListNestedAttribute{
+				Description: "Every node of the workflow. The graph must not have cycles and edges must reference the identifier of a node in this list.",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"identifier": schema.StringAttribute{
+							Description: "An identifier for this node that is unique within its workflow. It is used to match the node with the one in AWX and to reference it from edges.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 512),
+							},
+						},
+						"unified_job_template": schema.Int64Attribute{
+							Description: "Database ID of the job template, project, inventory source or workflow job template the node runs.",
+							Optional:    true,
+						},
+						"all_parents_must_converge": schema.BoolAttribute{
+							Description: "If enabled then the node will only run if all of the parent nodes have met the criteria to reach this node.",
+							Optional:    true,
+						},
+						"inventory": schema.Int64Attribute{
+							Description: "Inventory applied as a prompt, assuming job template prompts for inventory.",
+							Optional:    true,
+						},
+						"limit": schema.StringAttribute{
+							Description: "Limit applied as a prompt.",
+							Optional:    true,
+						},
+						"scm_branch": schema.StringAttribute{
+							Description: "SCM branch applied as a prompt.",
+							Optional:    true,
+						},
+						"job_tags": schema.StringAttribute{
+							Description: "Job tags applied as a prompt.",
+							Optional:    true,
+						},
+						"skip_tags": schema.StringAttribute{
+							Description: "Skip tags applied as a prompt.",
+							Optional:    true,
+						},
+						"extra_data": schema.StringAttribute{
+							Description: "Extra variables applied as a prompt, as a JSON object.",
+							Optional:    true,
+						},
+						"credential_ids": schema.SetAttribute{
+							Description: "Database IDs of the credentials applied as a prompt.",
+							ElementType: types.Int64Type,
+							Optional:    true,
+						},
+						"success_nodes": edgeAttribute("Identifiers of the nodes that run when this node succeeds."),
+						"failure_nodes": edgeAttribute("Identifiers of the nodes that run when this node fails."),
+						"always_nodes":  edgeAttribute("Identifiers of the nodes that run whatever the outcome of this node."),
+						"approval_template": schema.SingleNestedAttribute{
+							Description: "Makes the node an approval node that waits for a user to approve or deny it. Conflicts with unified_job_template.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: "Name of the approval.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.LengthBetween(1, 512),
+									},
+								},
+								"description": schema.StringAttribute{
+									Description: "Optional description of the approval.",
+									Optional:    true,
+								},
+								"timeout": schema.Int64Attribute{
+									Description: "The amount of time (in seconds) before the approval expires, 0 for no timeout.",
+									Optional:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"node_ids": schema.MapAttribute{
+				Description: "Database ID of every node, by identifier.",
+				ElementType: types.Int64Type,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks the graph before it is sent to AWX: identifiers must
+// be unique, edges must reference a node of the graph, two nodes may only be
+// joined by a single edge, the graph must not have cycles and approval nodes
+// must not have a unified job template. Values that are
+// unknown at plan time are skipped, AWX validates them on apply.
+func (o *WorkflowGraphResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var nodesValue types.List
+	if DiagnosticsHasError(&resp.Diagnostics, req.Config.GetAttribute(ctx, path.Root("nodes"), &nodesValue)...) {
+		return
+	}
+	nodes, ok := workflowGraphNodes(ctx, nodesValue, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(validateWorkflowGraph(ctx, nodes)...)
+}
+
+func validateWorkflowGraph(ctx context.Context, nodes []WorkflowGraphNodeModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	nodesPath := path.Root("nodes")
+
+	index := make(map[string]int, len(nodes))
+	var order []string
+	complete := true
+	for i, node := range nodes {
+		if node.Identifier.IsUnknown() || node.Identifier.IsNull() {
+			complete = false
+			continue
+		}
+		identifier := node.Identifier.ValueString()
+		if j, ok := index[identifier]; ok {
+			diags.AddAttributeError(nodesPath.AtListIndex(i).AtName("identifier"), "Duplicate workflow node identifier",
+				fmt.Sprintf("%q is already the identifier of nodes[%d]. Every node of a workflow needs a unique identifier.", identifier, j))
+			continue
+		}
+		index[identifier] = i
+		order = append(order, identifier)
+	}
+
+	for i, node := range nodes {
+		if !node.ApprovalTemplate.IsNull() && !node.UnifiedJobTemplate.IsNull() {
+			diags.AddAttributeError(nodesPath.AtListIndex(i).AtName("approval_template"), "Conflicting workflow node settings",
+				"A node runs either an approval or a unified job template, approval_template and unified_job_template cannot be set together.")
+		}
+	}
+
+	children := make(map[string][]string, len(nodes))
+	edgeOf := map[[2]string]string{}
+	for i, node := range nodes {
+		parent := node.Identifier.ValueString()
+		for _, edge := range workflowGraphEdges {
+			targets, ok := workflowGraphEdgeTargets(ctx, node.edge(edge), &diags)
+			if !ok {
+				continue
+			}
+			for _, target := range targets {
+				if _, exists := index[target]; !exists && complete {
+					diags.AddAttributeError(nodesPath.AtListIndex(i).AtName(edge), "Unknown workflow node identifier",
+						fmt.Sprintf("%q is not the identifier of any node of the workflow.", target))
+					continue
+				}
+				if node.Identifier.IsUnknown() || node.Identifier.IsNull() {
+					continue
+				}
+				pair := [2]string{parent, target}
+				if other, exists := edgeOf[pair]; exists {
+					diags.AddAttributeError(nodesPath.AtListIndex(i).AtName(edge), "Conflicting workflow edges",
+						fmt.Sprintf("%q is in both %s and %s of %q. AWX allows a single edge between two nodes.", target, other, edge, parent))
+					continue
+				}
+				edgeOf[pair] = edge
+				children[parent] = append(children[parent], target)
+			}
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	for parent := range children {
+		slices.Sort(children[parent])
+	}
+	if cycle := workflowGraphCycle(order, children); cycle != nil {
+		diags.AddAttributeError(nodesPath, "Workflow graph has a cycle",
+			fmt.Sprintf("The edges %s form a cycle. A workflow must be a directed acyclic graph.", strings.Join(cycle, " -> ")))
+	}
+	return diags
+}
+
+// workflowGraphCycle returns the first cycle found walking the graph depth
+// first from the nodes in order, as a path starting and ending on the same
+// identifier, or nil when the graph is acyclic.
+func workflowGraphCycle(order []string, children map[string][]string) []string {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(order))
+	var stack []string
+
+	var visit func(identifier string) []string
+	visit = func(identifier string) []string {
+		state[identifier] = visiting
+		stack = append(stack, identifier)
+		for _, child := range children[identifier] {
+			switch state[child] {
+			case visiting:
+				start := slices.Index(stack, child)
+				return append(slices.Clone(stack[start:]), child)
+			case unvisited:
+				if cycle := visit(child); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[identifier] = visited
+		return nil
+	}
+
+	for _, identifier := range order {
+		if state[identifier] != unvisited {
+			continue
+		}
+		if cycle := visit(identifier); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// workflowGraphEdgeTargets returns the known identifiers of an edge set.
+func workflowGraphEdgeTargets(ctx context.Context, edge types.Set, diags *diag.Diagnostics) ([]string, bool) {
+	if edge.IsNull() || edge.IsUnknown() {
+		return nil, false
+	}
+	var values []types.String
+	if DiagnosticsHasError(diags, edge.ElementsAs(ctx, &values, false)...) {
+		return nil, false
+	}
+	targets := make([]string, 0, len(values))
+	for _, v := range values {
+		if !v.IsUnknown() && !v.IsNull() {
+			targets = append(targets, v.ValueString())
+		}
+	}
+	return targets, true
+}
+
+// ModifyPlan keeps node_ids from state when the plan has the same node
+// identifiers, so changing a node does not mark every node ID unknown.
+func (o *WorkflowGraphResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state WorkflowGraphModel
+	if DiagnosticsHasError(&resp.Diagnostics, req.Plan.Get(ctx, &plan)...) {
+		return
+	}
+	if DiagnosticsHasError(&resp.Diagnostics, req.State.Get(ctx, &state)...) {
+		return
+	}
+	if !plan.WorkflowJobTemplateID.Equal(state.WorkflowJobTemplateID) || state.NodeIDs.IsNull() {
+		return
+	}
+
+	nodes, ok := workflowGraphNodes(ctx, plan.Nodes, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+	identifiers := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		if node.Identifier.IsUnknown() {
+			return
+		}
+		identifiers = append(identifiers, node.Identifier.ValueString())
+	}
+	slices.Sort(identifiers)
+
+	current := make([]string, 0, len(state.NodeIDs.Elements()))
+	for identifier := range state.NodeIDs.Elements() {
+		current = append(current, identifier)
+	}
+	slices.Sort(current)
+
+	if slices.Equal(identifiers, current) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("node_ids"), state.NodeIDs)...)
+	}
+}
+
+// ImportState imports the graph of a workflow job template by its ID.
+func (o *WorkflowGraphResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(request.ID, 10, 64)
+	if err != nil {
+		response.Diagnostics.AddError(
+			fmt.Sprintf("Unable to parse '%v' as an int64 number, please provide the workflow_job_template_id for the WorkflowJobTemplate graph.", request.ID),
+			err.Error(),
+		)
+		return
+	}
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("workflow_job_template_id"), types.Int64Value(id))...)
+}
+
+// Create makes the nodes and edges of the workflow match the plan.
+func (o *WorkflowGraphResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var plan WorkflowGraphModel
+	if DiagnosticsHasError(&response.Diagnostics, request.Plan.Get(ctx, &plan)...) {
+		return
+	}
+	o.apply(ctx, plan, &response.State, &response.Diagnostics)
+}
+
+// Update makes the nodes and edges of the workflow match the plan.
+func (o *WorkflowGraphResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan WorkflowGraphModel
+	if DiagnosticsHasError(&response.Diagnostics, request.Plan.Get(ctx, &plan)...) {
+		return
+	}
+	o.apply(ctx, plan, &response.State, &response.Diagnostics)
+}
+
+// Read replaces the nodes in state with the nodes of the workflow in AWX,
+// keeping the order of the nodes in state, and drops the resource from state
+// when the workflow job template no longer exists.
+func (o *WorkflowGraphResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var state WorkflowGraphModel
+	if DiagnosticsHasError(&response.Diagnostics, request.State.Get(ctx, &state)...) {
+		return
+	}
+	prior, ok := workflowGraphNodes(ctx, state.Nodes, &response.Diagnostics)
+	if !ok {
+		return
+	}
+
+	parentID := state.WorkflowJobTemplateID.ValueInt64()
+	graph, found, ok := o.load(ctx, parentID, &response.Diagnostics)
+	if !ok {
+		return
+	}
+	if !found {
+		tflog.Debug(ctx, "[WorkflowJobTemplate/read] Workflow job template no longer exists", map[string]any{
+			"workflow_job_template_id": parentID,
+		})
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	var identifiers []string
+	priorByIdentifier := make(map[string]WorkflowGraphNodeModel, len(prior))
+	for _, node := range prior {
+		identifier := node.Identifier.ValueString()
+		priorByIdentifier[identifier] = node
+		if _, ok := graph.nodes[identifier]; ok {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+	var added []string
+	for identifier := range graph.nodes {
+		if _, ok := priorByIdentifier[identifier]; !ok {
+			added = append(added, identifier)
+		}
+	}
+	slices.Sort(added)
+	identifiers = append(identifiers, added...)
+
+	nodes := make([]WorkflowGraphNodeModel, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		node, ok := graph.model(ctx, identifier, priorByIdentifier[identifier], &response.Diagnostics)
+		if !ok {
+			return
+		}
+		nodes = append(nodes, node)
+	}
+	o.setState(ctx, &response.State, parentID, nodes, graph.ids(), &response.Diagnostics)
+}
+
+// Delete deletes every node in state, AWX removes their edges with them.
+func (o *WorkflowGraphResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var state WorkflowGraphModel
+	if DiagnosticsHasError(&response.Diagnostics, request.State.Get(ctx, &state)...) {
+		return
+	}
+	ids := map[string]int64{}
+	if DiagnosticsHasError(&response.Diagnostics, state.NodeIDs.ElementsAs(ctx, &ids, false)...) {
+		return
+	}
+	for _, identifier := range sortedKeys(ids) {
+		if DiagnosticsHasError(&response.Diagnostics, DeleteRequest(ctx, o.Client, EndpointWithID(o.nodeEndpoint, ids[identifier]), "WorkflowJobTemplateNode")...) {
+			return
+		}
+	}
+}
+
+// apply reconciles the workflow with plan. Nodes missing from the plan are
+// deleted first, then nodes are created or updated, and finally edges are
+// disassociated before new ones are associated so AWX never sees a
+// transient cycle or two edges between the same nodes.
+func (o *WorkflowGraphResource) apply(ctx context.Context, plan WorkflowGraphModel, state attributeWriter, diags *diag.Diagnostics) {
+	desired, ok := workflowGraphNodes(ctx, plan.Nodes, diags)
+	if !ok {
+		return
+	}
+	parentID := plan.WorkflowJobTemplateID.ValueInt64()
+
+	graph, found, ok := o.load(ctx, parentID, diags)
+	if !ok {
+		return
+	}
+	if !found {
+		diags.AddAttributeError(path.Root("workflow_job_template_id"), "Workflow job template not found",
+			fmt.Sprintf("AWX has no workflow job template with ID %d.", parentID))
+		return
+	}
+
+	wanted := make(map[string]bool, len(desired))
+	for _, node := range desired {
+		wanted[node.Identifier.ValueString()] = true
+	}
+	for _, identifier := range sortedKeys(graph.ids()) {
+		if wanted[identifier] {
+			continue
+		}
+		if DiagnosticsHasError(diags, DeleteRequest(ctx, o.Client, EndpointWithID(o.nodeEndpoint, graph.nodes[identifier].id), "WorkflowJobTemplateNode")...) {
+			return
+		}
+		graph.remove(identifier)
+	}
+
+	for _, node := range desired {
+		if !o.applyNode(ctx, parentID, node, graph, diags) {
+			return
+		}
+	}
+
+	for _, disassociate := range []bool{true, false} {
+		for _, node := range desired {
+			current := graph.nodes[node.Identifier.ValueString()]
+			for _, edge := range workflowGraphEdges {
+				targets, _ := workflowGraphEdgeTargets(ctx, node.edge(edge), diags)
+				want := make([]int64, 0, len(targets))
+				for _, target := range targets {
+					child, ok := graph.nodes[target]
+					if !ok {
+						diags.AddAttributeError(path.Root("nodes"), "Unknown workflow node identifier",
+							fmt.Sprintf("%q in the %s of %q is not the identifier of any node of the workflow.", target, edge, node.Identifier.ValueString()))
+						return
+					}
+					want = append(want, child.id)
+				}
+				have := graph.edgeIDs(current, edge)
+				if !o.associate(ctx, o.subEndpoint(current.id, edge), have, want, disassociate, diags) {
+					return
+				}
+			}
+		}
+	}
+
+	o.setState(ctx, state, parentID, desired, graph.ids(), diags)
+}
+
+// applyNode creates the node, or updates it when its settings differ from
+// AWX, and reconciles its credentials.
+func (o *WorkflowGraphResource) applyNode(ctx context.Context, parentID int64, node WorkflowGraphNodeModel, graph *workflowGraph, diags *diag.Diagnostics) bool {
+	identifier := node.Identifier.ValueString()
+	body, err := node.body()
+	if err != nil {
+		diags.AddAttributeError(path.Root("nodes"), "Invalid workflow node", err.Error())
+		return false
+	}
+
+	approval, hasApproval := node.approval(ctx, diags)
+	if diags.HasError() {
+		return false
+	}
+
+	var currentApproval map[string]any
+	current, exists := graph.nodes[identifier]
+	if exists {
+		currentApproval = nodeApprovalTemplate(current.data)
+		observed, ok := graph.model(ctx, identifier, node, diags)
+		if !ok {
+			return false
+		}
+		if !observed.sameSettings(node) {
+			data, d := CreateUpdateRequest(ctx, o.Client, http.MethodPatch, EndpointWithID(o.nodeEndpoint, current.id), body, "WorkflowJobTemplateNode", "update")
+			if DiagnosticsHasError(diags, d...) {
+				return false
+			}
+			current.data = data
+		}
+	} else {
+		data, d := CreateUpdateRequest(ctx, o.Client, http.MethodPost, o.endpoint(parentID), body, "WorkflowJobTemplateNode", "create")
+		if DiagnosticsHasError(diags, d...) {
+			return false
+		}
+		id, err := int64FromAPI(data["id"])
+		if err != nil {
+			diags.AddError("Unexpected response while creating WorkflowJobTemplateNode", err.Error())
+			return false
+		}
+		// A new node has no edges yet, whatever AWX echoes back.
+		for _, edge := range workflowGraphEdges {
+			delete(data, edge)
+		}
+		current = &workflowGraphNode{id: id, data: data}
+		graph.add(identifier, current)
+	}
+
+	if !o.applyApproval(ctx, current.id, currentApproval, approval, hasApproval, diags) {
+		return false
+	}
+
+	var want []int64
+	if !node.CredentialIDs.IsNull() {
+		if DiagnosticsHasError(diags, node.CredentialIDs.ElementsAs(ctx, &want, false)...) {
+			return false
+		}
+	}
+	endpoint := o.subEndpoint(current.id, "credentials")
+	return o.associate(ctx, endpoint, current.credentials, want, true, diags) &&
+		o.associate(ctx, endpoint, current.credentials, want, false, diags)
+}
+
+// applyApproval creates, updates or deletes the approval template of a node.
+// Deleting the approval template clears the unified job template of the node.
+func (o *WorkflowGraphResource) applyApproval(ctx context.Context, nodeID int64, current map[string]any, want WorkflowGraphApprovalModel, wanted bool, diags *diag.Diagnostics) bool {
+	var d diag.Diagnostics
+	switch {
+	case wanted && current == nil:
+		_, d = CreateUpdateRequest(ctx, o.Client, http.MethodPost, o.subEndpoint(nodeID, "create_approval_template"), want.body(), "WorkflowApprovalTemplate", "create")
+	case wanted && !sameApproval(current, want.body()):
+		_, d = CreateUpdateRequest(ctx, o.Client, http.MethodPatch, EndpointWithID(o.approvalEndpoint, current["id"]), want.body(), "WorkflowApprovalTemplate", "update")
+	case !wanted && current != nil:
+		d = DeleteRequest(ctx, o.Client, EndpointWithID(o.approvalEndpoint, current["id"]), "WorkflowApprovalTemplate")
+	}
+	return !DiagnosticsHasError(diags, d...)
+}
+
+func sameApproval(current, want map[string]any) bool {
+	description, _ := current["description"].(string)
+	timeout, _ := int64FromAPI(current["timeout"])
+	return current["name"] == want["name"] && description == want["description"] && timeout == want["timeout"]
+}
+
+// associate disassociates the IDs in have that are not in want, or
+// associates the IDs in want that are not in have.
+func (o *WorkflowGraphResource) associate(ctx context.Context, endpoint string, have, want []int64, disassociate bool, diags *diag.Diagnostics) bool {
+	from, to := want, have
+	if disassociate {
+		from, to = have, want
+	}
+	for _, id := range from {
+		if slices.Contains(to, id) {
+			continue
+		}
+		if DiagnosticsHasError(diags, sendAssociation(ctx, o.Client, endpoint, id, disassociate, "WorkflowJobTemplateNode")...) {
+			return false
+		}
+	}
+	return true
+}
+
+// load lists the nodes of the workflow with their credentials. found is false
+// when the workflow job template no longer exists.
+func (o *WorkflowGraphResource) load(ctx context.Context, parentID int64, diags *diag.Diagnostics) (*workflowGraph, bool, bool) {
+	items, found, d := ListAllAllowNotFound(ctx, o.Client, o.endpoint(parentID), "WorkflowJobTemplate", 0)
+	if DiagnosticsHasError(diags, d...) {
+		return nil, false, false
+	}
+	if !found {
+		return nil, false, true
+	}
+
+	graph := &workflowGraph{nodes: map[string]*workflowGraphNode{}, identifiers: map[int64]string{}}
+	for _, item := range items {
+		id, err := int64FromAPI(item["id"])
+		if err != nil {
+			diags.AddError("Unexpected response while listing WorkflowJobTemplate nodes", err.Error())
+			return nil, false, false
+		}
+		credentials, d := ListAll(ctx, o.Client, o.subEndpoint(id, "credentials"), "WorkflowJobTemplateNode", 0)
+		if DiagnosticsHasError(diags, d...) {
+			return nil, false, false
+		}
+		node := &workflowGraphNode{id: id, data: item}
+		for _, credential := range credentials {
+			credentialID, err := int64FromAPI(credential["id"])
+			if err != nil {
+				diags.AddError("Unexpected response while listing WorkflowJobTemplateNode credentials", err.Error())
+				return nil, false, false
+			}
+			node.credentials = append(node.credentials, credentialID)
+		}
+		identifier, _ := item["identifier"].(string)
+		graph.add(identifier, node)
+	}
+	return graph, true, true
+}
+
+func (o *WorkflowGraphResource) setState(ctx context.Context, state attributeWriter, parentID int64, nodes []WorkflowGraphNodeModel, ids map[string]int64, diags *diag.Diagnostics) {
+	nodesValue, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: workflowGraphNodeAttrTypes()}, nodes)
+	if DiagnosticsHasError(diags, d...) {
+		return
+	}
+	idsValue, d := types.MapValueFrom(ctx, types.Int64Type, ids)
+	if DiagnosticsHasError(diags, d...) {
+		return
+	}
+	if DiagnosticsHasError(diags, state.SetAttribute(ctx, path.Root("workflow_job_template_id"), types.Int64Value(parentID))...) {
+		return
+	}
+	if DiagnosticsHasError(diags, state.SetAttribute(ctx, path.Root("nodes"), nodesValue)...) {
+		return
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root("node_ids"), idsValue)...)
+}
+
+func (o *WorkflowGraphResource) endpoint(parentID int64) string {
+	return p.Clean(fmt.Sprintf(o.Endpoint, parentID)) + "/"
+}
+
+func (o *WorkflowGraphResource) subEndpoint(nodeID int64, name string) string {
+	return p.Clean(fmt.Sprintf("%s/%d/%s", o.nodeEndpoint, nodeID, name)) + "/"
+}
+
+// workflowGraphNodes decodes the nodes of a plan, state or config. A null
+// list, as right after an import, has no nodes.
+func workflowGraphNodes(ctx context.Context, nodes types.List, diags *diag.Diagnostics) ([]WorkflowGraphNodeModel, bool) {
+	if nodes.IsNull() || nodes.IsUnknown() {
+		return nil, !nodes.IsUnknown()
+	}
+	var out []WorkflowGraphNodeModel
+	if DiagnosticsHasError(diags, nodes.ElementsAs(ctx, &out, false)...) {
+		return nil, false
+	}
+	return out, true
+}
+
+func workflowGraphNodeAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"identifier":                types.StringType,
+		"unified_job_template":      types.Int64Type,
+		"all_parents_must_converge": types.BoolType,
+		"inventory":                 types.Int64Type,
+		"limit":                     types.StringType,
+		"scm_branch":                types.StringType,
+		"job_tags":                  types.StringType,
+		"skip_tags":                 types.StringType,
+		"extra_data":                types.StringType,
+		"credential_ids":            types.SetType{ElemType: types.Int64Type},
+		"success_nodes":             types.SetType{ElemType: types.StringType},
+		"failure_nodes":             types.SetType{ElemType: types.StringType},
+		"always_nodes":              types.SetType{ElemType: types.StringType},
+		"approval_template":         types.ObjectType{AttrTypes: workflowGraphApprovalAttrTypes()},
+	}
+}
+
+func workflowGraphApprovalAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":        types.StringType,
+		"description": types.StringType,
+		"timeout":     types.Int64Type,
+	}
+}
+
+type workflowGraphNode struct {
+	id          int64
+	data        map[string]any
+	credentials []int64
+}
+
+// workflowGraph is the workflow as it is in AWX, by node identifier.
+type workflowGraph struct {
+	nodes       map[string]*workflowGraphNode
+	identifiers map[int64]string
+}
+
+func (g *workflowGraph) add(identifier string, node *workflowGraphNode) {
+	g.nodes[identifier] = node
+	g.identifiers[node.id] = identifier
+}
+
+func (g *workflowGraph) remove(identifier string) {
+	delete(g.identifiers, g.nodes[identifier].id)
+	delete(g.nodes, identifier)
+}
+
+func (g *workflowGraph) ids() map[string]int64 {
+	ids := make(map[string]int64, len(g.nodes))
+	for identifier, node := range g.nodes {
+		ids[identifier] = node.id
+	}
+	return ids
+}
+
+// edgeIDs returns the IDs of the children of node through edge, leaving out
+// the nodes that were deleted since the workflow was loaded.
+func (g *workflowGraph) edgeIDs(node *workflowGraphNode, edge string) []int64 {
+	values, _ := node.data[edge].([]any)
+	ids := make([]int64, 0, len(values))
+	for _, v := range values {
+		id, err := int64FromAPI(v)
+		if _, ok := g.identifiers[id]; err == nil && ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// model converts the node with identifier into a node model. Empty AWX values
+// are kept as they are in prior, so an unset prompt is null and one set to
+// its empty value stays empty.
+func (g *workflowGraph) model(ctx context.Context, identifier string, prior WorkflowGraphNodeModel, diags *diag.Diagnostics) (WorkflowGraphNodeModel, bool) {
+	node := g.nodes[identifier]
+	data := node.data
+
+	extraData, err := workflowGraphJSON(data["extra_data"], prior.ExtraData)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Unable to read the extra_data of workflow node %q", identifier), err.Error())
+		return WorkflowGraphNodeModel{}, false
+	}
+	out := WorkflowGraphNodeModel{
+		Identifier:             types.StringValue(identifier),
+		UnifiedJobTemplate:     workflowGraphInt64(data["unified_job_template"]),
+		AllParentsMustConverge: workflowGraphBool(data["all_parents_must_converge"], prior.AllParentsMustConverge),
+		Inventory:              workflowGraphInt64(data["inventory"]),
+		Limit:                  workflowGraphString(data["limit"], prior.Limit),
+		ScmBranch:              workflowGraphString(data["scm_branch"], prior.ScmBranch),
+		JobTags:                workflowGraphString(data["job_tags"], prior.JobTags),
+		SkipTags:               workflowGraphString(data["skip_tags"], prior.SkipTags),
+		ExtraData:              extraData,
+	}
+
+	var d diag.Diagnostics
+	out.ApprovalTemplate = types.ObjectNull(workflowGraphApprovalAttrTypes())
+	if approval := nodeApprovalTemplate(data); approval != nil {
+		priorApproval, _ := prior.approval(ctx, diags)
+		out.UnifiedJobTemplate = types.Int64Null()
+		out.ApprovalTemplate, d = types.ObjectValueFrom(ctx, workflowGraphApprovalAttrTypes(), WorkflowGraphApprovalModel{
+			Name:        types.StringValue(fmt.Sprint(approval["name"])),
+			Description: workflowGraphString(approval["description"], priorApproval.Description),
+			Timeout:     workflowGraphCount(approval["timeout"], priorApproval.Timeout),
+		})
+		if DiagnosticsHasError(diags, d...) {
+			return WorkflowGraphNodeModel{}, false
+		}
+	}
+
+	if len(node.credentials) == 0 && prior.CredentialIDs.IsNull() {
+		out.CredentialIDs = types.SetNull(types.Int64Type)
+	} else {
+		out.CredentialIDs, d = types.SetValueFrom(ctx, types.Int64Type, node.credentials)
+		if DiagnosticsHasError(diags, d...) {
+			return WorkflowGraphNodeModel{}, false
+		}
+	}
+
+	for _, edge := range workflowGraphEdges {
+		var targets []string
+		for _, id := range g.edgeIDs(node, edge) {
+			targets = append(targets, g.identifiers[id])
+		}
+		value := types.SetNull(types.StringType)
+		if len(targets) > 0 || !prior.edge(edge).IsNull() {
+			value, d = types.SetValueFrom(ctx, types.StringType, targets)
+			if DiagnosticsHasError(diags, d...) {
+				return WorkflowGraphNodeModel{}, false
+			}
+		}
+		out.setEdge(edge, value)
+	}
+	return out, true
+}
+
+func workflowGraphInt64(v any) types.Int64 {
+	id, err := int64FromAPI(v)
+	if err != nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(id)
+}
+
+func workflowGraphCount(v any, prior types.Int64) types.Int64 {
+	n, _ := int64FromAPI(v)
+	if n == 0 && prior.IsNull() {
+		return types.Int64Null()
+	}
+	return types.Int64Value(n)
+}
+
+func workflowGraphBool(v any, prior types.Bool) types.Bool {
+	b, _ := v.(bool)
+	if !b && prior.IsNull() {
+		return types.BoolNull()
+	}
+	return types.BoolValue(b)
+}
+
+func workflowGraphString(v any, prior types.String) types.String {
+	s, _ := v.(string)
+	if s == "" && (prior.IsNull() || prior.ValueString() != "") {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// workflowGraphJSON returns the JSON of v, or prior when it is the same JSON
+// object written differently.
+func workflowGraphJSON(v any, prior types.String) (types.String, error) {
+	if m, ok := v.(map[string]any); v == nil || (ok && len(m) == 0) {
+		if prior.IsNull() || prior.ValueString() == "" {
+			return types.StringNull(), nil
+		}
+		v = map[string]any{}
+	}
+	encoded, err := json.Marshal(v)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	if !prior.IsNull() {
+		var a, b any
+		if json.Unmarshal(encoded, &a) == nil && json.Unmarshal([]byte(prior.ValueString()), &b) == nil && reflect.DeepEqual(a, b) {
+			return prior, nil
+		}
+	}
+	return types.StringValue(string(encoded)), nil
+}
@@ -0,0 +1,669 @@
+package framework_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// workflowAWX serves the nodes of workflow job template 7 and records every
+// call that changes them.
+type workflowAWX struct {
+	*fakeAWX
+	nodes       map[int64]map[string]any
+	credentials map[int64][]int64
+	approvals   map[int64]map[string]any
+	nextID      int64
+}
+
+var workflowEdges = []string{"success_nodes", "failure_nodes", "always_nodes"}
+
+func newWorkflowAWX(t *testing.T, nodes ...map[string]any) *workflowAWX {
+	f := &workflowAWX{fakeAWX: newFakeAWX(t), nodes: map[int64]map[string]any{}, credentials: map[int64][]int64{}, approvals: map[int64]map[string]any{}, nextID: 100}
+	for _, node := range nodes {
+		for _, edge := range workflowEdges {
+			if _, ok := node[edge]; !ok {
+				node[edge] = []any{}
+			}
+		}
+		f.nodes[int64(node["id"].(int))] = node
+	}
+
+	f.handle("GET /api/v2/workflow_job_templates/7/workflow_nodes/", func(w http.ResponseWriter, _ *http.Request) {
+		ids := slices.Sorted(maps.Keys(f.nodes))
+		results := make([]any, 0, len(ids))
+		for _, id := range ids {
+			results = append(results, f.node(id))
+		}
+		writeList(w, results)
+	})
+	f.handle("POST /api/v2/workflow_job_templates/7/workflow_nodes/", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		body["id"] = f.nextID
+		for _, edge := range workflowEdges {
+			body[edge] = []any{}
+		}
+		f.nodes[f.nextID] = body
+		if _, ok := body["unified_job_template"]; ok {
+			f.record("create %s", body["identifier"])
+		} else {
+			f.record("create %s without unified_job_template", body["identifier"])
+		}
+		f.nextID++
+		writeJSON(w, body)
+	})
+
+	f.handle("GET /api/v2/workflow_job_template_nodes/{id}/", f.withNode(func(w http.ResponseWriter, _ *http.Request, id int64, _ map[string]any) {
+		writeJSON(w, f.node(id))
+	}))
+	f.handle("PATCH /api/v2/workflow_job_template_nodes/{id}/", f.withNode(func(w http.ResponseWriter, r *http.Request, _ int64, node map[string]any) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&node))
+		f.record("update %s", node["identifier"])
+		writeJSON(w, node)
+	}))
+	f.handle("DELETE /api/v2/workflow_job_template_nodes/{id}/", f.withNode(func(w http.ResponseWriter, _ *http.Request, id int64, node map[string]any) {
+		f.record("delete %s", node["identifier"])
+		delete(f.nodes, id)
+		for _, other := range f.nodes {
+			for _, edge := range workflowEdges {
+				other[edge] = slices.DeleteFunc(other[edge].([]any), func(v any) bool { return toInt64(v) == id })
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	f.handle("POST /api/v2/workflow_job_template_nodes/{id}/create_approval_template/", f.withNode(func(w http.ResponseWriter, r *http.Request, _ int64, node map[string]any) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		body["id"] = f.nextID
+		f.approvals[f.nextID] = body
+		node["unified_job_template"] = f.nextID
+		f.record("%s approval %s", node["identifier"], body["name"])
+		f.nextID++
+		writeJSON(w, body)
+	}))
+	f.handle("GET /api/v2/workflow_job_template_nodes/{id}/credentials/", f.withNode(func(w http.ResponseWriter, _ *http.Request, id int64, _ map[string]any) {
+		results := []any{}
+		for _, credential := range f.credentials[id] {
+			results = append(results, map[string]any{"id": credential})
+		}
+		writeList(w, results)
+	}))
+	f.handle("POST /api/v2/workflow_job_template_nodes/{id}/{sub}/", f.withNode(func(w http.ResponseWriter, r *http.Request, id int64, node map[string]any) {
+		sub := r.PathValue("sub")
+		child, op := association(t, r)
+		f.record("%s %s %s%d", node["identifier"], sub, op, child)
+		switch {
+		case sub == "credentials" && op == "-":
+			f.credentials[id] = slices.DeleteFunc(f.credentials[id], func(v int64) bool { return v == child })
+		case sub == "credentials":
+			f.credentials[id] = append(f.credentials[id], child)
+		default:
+			children, _ := node[sub].([]any)
+			if op == "-" {
+				node[sub] = slices.DeleteFunc(children, func(v any) bool { return toInt64(v) == child })
+			} else {
+				node[sub] = append(children, child)
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	f.handle("GET /api/v2/workflow_approval_templates/{id}/", f.withApproval(func(w http.ResponseWriter, _ *http.Request, _ int64, approval map[string]any) {
+		writeJSON(w, approval)
+	}))
+	f.handle("PATCH /api/v2/workflow_approval_templates/{id}/", f.withApproval(func(w http.ResponseWriter, r *http.Request, id int64, approval map[string]any) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&approval))
+		f.record("update approval %d", id)
+		writeJSON(w, approval)
+	}))
+	f.handle("DELETE /api/v2/workflow_approval_templates/{id}/", f.withApproval(func(w http.ResponseWriter, _ *http.Request, id int64, _ map[string]any) {
+		f.record("delete approval %d", id)
+		delete(f.approvals, id)
+		for _, node := range f.nodes {
+			if toInt64(node["unified_job_template"]) == id {
+				node["unified_job_template"] = nil
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	return f
+}
+
+// withNode looks up the node of the {id} path value, answering 404 for
+// unknown nodes.
+func (f *workflowAWX) withNode(h func(w http.ResponseWriter, r *http.Request, id int64, node map[string]any)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := toInt64(r.PathValue("id"))
+		node, ok := f.nodes[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		h(w, r, id, node)
+	}
+}
+
+// withApproval is withNode for approval templates.
+func (f *workflowAWX) withApproval(h func(w http.ResponseWriter, r *http.Request, id int64, approval map[string]any)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id := toInt64(r.PathValue("id"))
+		approval, ok := f.approvals[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		h(w, r, id, approval)
+	}
+}
+
+// node returns a node with the summary AWX adds for approval nodes.
+func (f *workflowAWX) node(id int64) map[string]any {
+	node := f.nodes[id]
+	delete(node, "summary_fields")
+	if approval, ok := f.approvals[toInt64(node["unified_job_template"])]; ok {
+		summary := map[string]any{"unified_job_type": "workflow_approval"}
+		maps.Copy(summary, approval)
+		node["summary_fields"] = map[string]any{"unified_job_template": summary}
+	}
+	return node
+}
+
+func toInt64(v any) int64 {
+	n, _ := strconv.ParseInt(fmt.Sprint(v), 10, 64)
+	return n
+}
+
+func newWorkflowGraphResource(t *testing.T, f *workflowAWX) (*framework.WorkflowGraphResource, schema.Schema) {
+	t.Helper()
+	r := framework.NewWorkflowGraphResource("workflow_job_template_graph", "/api/v2/workflow_job_templates/%d/workflow_nodes/", "/api/v2/workflow_job_template_nodes/", "/api/v2/workflow_approval_templates/").(*framework.WorkflowGraphResource)
+	r.Client = f.requester()
+	return r, resourceSchema(t, r)
+}
+
+// graphNode builds a node with every optional attribute null.
+func graphNode(identifier string) framework.WorkflowGraphNodeModel {
+	return framework.WorkflowGraphNodeModel{
+		Identifier:             types.StringValue(identifier),
+		UnifiedJobTemplate:     types.Int64Null(),
+		AllParentsMustConverge: types.BoolNull(),
+		Inventory:              types.Int64Null(),
+		Limit:                  types.StringNull(),
+		ScmBranch:              types.StringNull(),
+		JobTags:                types.StringNull(),
+		SkipTags:               types.StringNull(),
+		ExtraData:              types.StringNull(),
+		CredentialIDs:          types.SetNull(types.Int64Type),
+		SuccessNodes:           types.SetNull(types.StringType),
+		FailureNodes:           types.SetNull(types.StringType),
+		AlwaysNodes:            types.SetNull(types.StringType),
+		ApprovalTemplate:       types.ObjectNull(approvalAttrTypes),
+	}
+}
+
+var approvalAttrTypes = map[string]attr.Type{
+	"name":        types.StringType,
+	"description": types.StringType,
+	"timeout":     types.Int64Type,
+}
+
+func approvalTemplate(name string, timeout int64) types.Object {
+	return types.ObjectValueMust(approvalAttrTypes, map[string]attr.Value{
+		"name":        types.StringValue(name),
+		"description": types.StringNull(),
+		"timeout":     types.Int64Value(timeout),
+	})
+}
+
+func identifiers(values ...string) types.Set {
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func workflowGraphValue(t *testing.T, s schema.Schema, nodes []framework.WorkflowGraphNodeModel, ids map[string]int64) tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+	nodeType := s.Attributes["nodes"].GetType().(types.ListType).ElemType
+
+	model := framework.WorkflowGraphModel{
+		WorkflowJobTemplateID: types.Int64Value(7),
+		Nodes:                 types.ListNull(nodeType),
+		NodeIDs:               types.MapNull(types.Int64Type),
+	}
+	if nodes != nil {
+		var diags diag.Diagnostics
+		model.Nodes, diags = types.ListValueFrom(ctx, nodeType, nodes)
+		require.False(t, diags.HasError(), "%v", diags)
+	}
+	if ids != nil {
+		model.NodeIDs = types.MapValueMust(types.Int64Type, func() map[string]attr.Value {
+			out := map[string]attr.Value{}
+			for k, v := range ids {
+				out[k] = types.Int64Value(v)
+			}
+			return out
+		}())
+	}
+	state := tfsdk.State{Schema: s}
+	require.False(t, state.Set(ctx, &model).HasError())
+	return state.Raw
+}
+
+func workflowGraphState(t *testing.T, state tfsdk.State) ([]framework.WorkflowGraphNodeModel, map[string]int64) {
+	t.Helper()
+	ctx := context.Background()
+	var model framework.WorkflowGraphModel
+	require.False(t, state.Get(ctx, &model).HasError())
+	var nodes []framework.WorkflowGraphNodeModel
+	require.False(t, model.Nodes.ElementsAs(ctx, &nodes, false).HasError())
+	ids := map[string]int64{}
+	require.False(t, model.NodeIDs.ElementsAs(ctx, &ids, false).HasError())
+	return nodes, ids
+}
+
+func TestWorkflowGraphResource_ValidateConfig(t *testing.T) {
+	node := func(identifier string, success ...string) framework.WorkflowGraphNodeModel {
+		n := graphNode(identifier)
+		if success != nil {
+			n.SuccessNodes = identifiers(success...)
+		}
+		return n
+	}
+
+	tests := []struct {
+		name    string
+		nodes   func() []framework.WorkflowGraphNodeModel
+		summary string
+		detail  string
+	}{
+		{
+			name: "valid diamond",
+			nodes: func() []framework.WorkflowGraphNodeModel {
+				start := node("start", "left", "right")
+				right := node("right")
+				right.AlwaysNodes = identifiers("end")
+				return []framework.WorkflowGraphNodeModel{start, node("left", "end"), right, node("end")}
+			},
+		},
+		{
+			name: "duplicate identifier",
+			nodes: func() []framework.WorkflowGraphNodeModel {
+				return []framework.WorkflowGraphNodeModel{node("a"), node("b"), node("a")}
+			},
+			summary: "Duplicate workflow node identifier",
+			detail:  `"a" is already the identifier of nodes[0]`,
+		},
+		{
+			name: "dangling reference",
+			nodes: func() []framework.WorkflowGraphNodeModel {
+				return []framework.WorkflowGraphNodeModel{node("a", "missing")}
+			},
+			summary: "Unknown workflow node identifier",
+			detail:  `"missing"`,
+		},
+		{
+			name: "dangling reference is not checked with unknown identifiers",
+			nodes: func() []framework.WorkflowGraphNodeModel {
+				unknown := node("")
+				unknown.Identifier = types.StringUnknown()
+				return []framework.WorkflowGraphNodeModel{node("a", "later"), unknown}
+			},
+		},
+		{
+			name: "two edges between the same nodes",
+			nodes: func() []framework.WorkflowGraphNodeModel {
+				a := node("a", "b")
+				a.FailureNodes = identifiers("b")
+				return []framework.WorkflowGraphNodeModel{a, node("b")}
+			},
+			summary: "Conflicting workflow edges",
+			detail:  `"b" is in both success_nodes and failure_nodes of "a"`,
+		},
+		{
+			name: "approval node with a unified job template",
+			nodes: func() []framework.WorkflowGraphNodeModel {
+				approve := node("approve")
+				approve.UnifiedJobTemplate = types.Int64Value(11)
+				approve.ApprovalTemplate = approvalTemplate("Go live?", 0)
+				return []framework.WorkflowGraphNodeModel{approve}
+			},
+			summary: "Conflicting workflow node settings",
+			detail:  "approval_template and unified_job_template cannot be set together",
+		},
+		{
+			name: "self reference",
+			nodes: func() []framework.WorkflowGraphNodeModel {
+				return []framework.WorkflowGraphNodeModel{node("a", "a")}
+			},
+			summary: "Workflow graph has a cycle",
+			detail:  "a -> a",
+		},
+		{
+			name: "cycle across edge types",
+			nodes: func() []framework.WorkflowGraphNodeModel {
+				c := node("c")
+				c.AlwaysNodes = identifiers("b")
+				return []framework.WorkflowGraphNodeModel{node("a", "b"), node("b", "c"), c}
+			},
+			summary: "Workflow graph has a cycle",
+			detail:  "b -> c -> b",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, s := newWorkflowGraphResource(t, newWorkflowAWX(t))
+			resp := &resource.ValidateConfigResponse{}
+			r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Schema: s, Raw: workflowGraphValue(t, s, tt.nodes(), nil)},
+			}, resp)
+
+			if tt.summary == "" {
+				require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+				return
+			}
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Equal(t, tt.summary, resp.Diagnostics.Errors()[0].Summary())
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), tt.detail)
+		})
+	}
+}
+
+// jobNode is a node that runs unified job template ujt.
+func jobNode(identifier string, ujt int64) framework.WorkflowGraphNodeModel {
+	n := graphNode(identifier)
+	n.UnifiedJobTemplate = types.Int64Value(ujt)
+	return n
+}
+
+func TestWorkflowGraphResource_Apply(t *testing.T) {
+	tests := []struct {
+		name      string
+		nodes     []map[string]any
+		approvals map[int64]map[string]any
+		create    bool
+		prior     []framework.WorkflowGraphNodeModel
+		priorIDs  map[string]int64
+		plan      func() []framework.WorkflowGraphNodeModel
+		wantCalls []string
+		wantIDs   map[string]int64
+		check     func(t *testing.T, f *workflowAWX)
+		readBack  bool
+	}{
+		{
+			name:   "create adds the nodes before the edges",
+			create: true,
+			plan: func() []framework.WorkflowGraphNodeModel {
+				build := jobNode("build", 11)
+				build.CredentialIDs = int64Set(3)
+				build.SuccessNodes = identifiers("deploy")
+				build.FailureNodes = identifiers("notify")
+				deploy := jobNode("deploy", 12)
+				deploy.ExtraData = types.StringValue(`{"env": "prod"}`)
+				return []framework.WorkflowGraphNodeModel{build, deploy, jobNode("notify", 13)}
+			},
+			wantCalls: []string{
+				"create build", "build credentials +3",
+				"create deploy",
+				"create notify",
+				"build success_nodes +101", "build failure_nodes +102",
+			},
+			wantIDs: map[string]int64{"build": 100, "deploy": 101, "notify": 102},
+			check: func(t *testing.T, f *workflowAWX) {
+				assert.Equal(t, map[string]any{"env": "prod"}, f.nodes[101]["extra_data"])
+				assert.Nil(t, f.nodes[101]["limit"], "unset prompts are sent as null")
+			},
+		},
+		{
+			name: "update replaces, changes and keeps nodes",
+			nodes: []map[string]any{
+				{"id": 1, "identifier": "build", "unified_job_template": 11, "success_nodes": []any{2}, "failure_nodes": []any{3}, "extra_data": map[string]any{}},
+				{"id": 2, "identifier": "deploy", "unified_job_template": 12, "limit": "web", "extra_data": map[string]any{}},
+				{"id": 3, "identifier": "notify", "unified_job_template": 13, "extra_data": map[string]any{}},
+			},
+			plan: func() []framework.WorkflowGraphNodeModel {
+				// notify is replaced by cleanup, deploy gets a new limit and build is unchanged.
+				build := jobNode("build", 11)
+				build.SuccessNodes = identifiers("deploy")
+				build.FailureNodes = identifiers("cleanup")
+				deploy := jobNode("deploy", 12)
+				deploy.Limit = types.StringValue("db")
+				return []framework.WorkflowGraphNodeModel{build, deploy, jobNode("cleanup", 14)}
+			},
+			wantCalls: []string{
+				"delete notify",
+				"update deploy",
+				"create cleanup",
+				"build failure_nodes +100",
+			},
+			wantIDs: map[string]int64{"build": 1, "deploy": 2, "cleanup": 100},
+			check: func(t *testing.T, f *workflowAWX) {
+				assert.Equal(t, "db", f.nodes[2]["limit"])
+			},
+		},
+		{
+			name: "update removes old edges before adding new ones",
+			nodes: []map[string]any{
+				{"id": 1, "identifier": "a", "success_nodes": []any{2}},
+				{"id": 2, "identifier": "b"},
+			},
+			plan: func() []framework.WorkflowGraphNodeModel {
+				a := graphNode("a")
+				a.FailureNodes = identifiers("b")
+				return []framework.WorkflowGraphNodeModel{a, graphNode("b")}
+			},
+			wantCalls: []string{"a success_nodes -2", "a failure_nodes +2"},
+			wantIDs:   map[string]int64{"a": 1, "b": 2},
+		},
+		{
+			name: "update changes, replaces and creates approval nodes",
+			nodes: []map[string]any{
+				{"id": 1, "identifier": "approve", "unified_job_template": 50, "extra_data": map[string]any{}},
+				{"id": 2, "identifier": "job", "unified_job_template": 51, "extra_data": map[string]any{}},
+			},
+			approvals: map[int64]map[string]any{
+				50: {"id": 50, "name": "Old", "description": "", "timeout": 0},
+				51: {"id": 51, "name": "Replaced by a job", "description": "", "timeout": 0},
+			},
+			plan: func() []framework.WorkflowGraphNodeModel {
+				approve := graphNode("approve")
+				approve.ApprovalTemplate = approvalTemplate("Go live?", 3600)
+				gate := graphNode("gate")
+				gate.ApprovalTemplate = approvalTemplate("Gate", 0)
+				return []framework.WorkflowGraphNodeModel{approve, jobNode("job", 12), gate}
+			},
+			wantCalls: []string{
+				"update approval 50",
+				"update job", "delete approval 51",
+				"create gate without unified_job_template", "gate approval Gate",
+			},
+			wantIDs: map[string]int64{"approve": 1, "job": 2, "gate": 100},
+			check: func(t *testing.T, f *workflowAWX) {
+				assert.Equal(t, int64(12), toInt64(f.nodes[2]["unified_job_template"]))
+			},
+			readBack: true,
+		},
+		{
+			name: "delete removes only the managed nodes",
+			nodes: []map[string]any{
+				{"id": 1, "identifier": "a", "success_nodes": []any{2}},
+				{"id": 2, "identifier": "b"},
+				{"id": 3, "identifier": "unmanaged"},
+			},
+			prior:     []framework.WorkflowGraphNodeModel{graphNode("a"), graphNode("b")},
+			priorIDs:  map[string]int64{"a": 1, "b": 2},
+			wantCalls: []string{"delete a", "delete b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newWorkflowAWX(t, tt.nodes...)
+			maps.Copy(f.approvals, tt.approvals)
+			r, s := newWorkflowGraphResource(t, f)
+
+			prior, plan := workflowGraphValue(t, s, tt.prior, tt.priorIDs), nullValue(s)
+			if tt.create {
+				prior = nullValue(s)
+			}
+			var nodes []framework.WorkflowGraphNodeModel
+			if tt.plan != nil {
+				nodes = tt.plan()
+				plan = workflowGraphValue(t, s, nodes, nil)
+			}
+
+			state, diags := applyResource(r, s, prior, plan)
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Equal(t, tt.wantCalls, f.calls)
+			if tt.check != nil {
+				tt.check(t, f)
+			}
+			if tt.plan == nil {
+				return
+			}
+			got, ids := workflowGraphState(t, state)
+			assert.Equal(t, nodes, got)
+			assert.Equal(t, tt.wantIDs, ids)
+
+			if tt.readBack {
+				f.calls = nil
+				state, diags = readResource(r, s, state.Raw)
+				require.False(t, diags.HasError(), "%v", diags)
+				got, _ = workflowGraphState(t, state)
+				assert.Equal(t, nodes, got, "nodes read back without drift")
+				assert.Empty(t, f.calls)
+			}
+		})
+	}
+}
+
+func TestWorkflowGraphResource_Read(t *testing.T) {
+	deploy := jobNode("deploy", 12)
+	deploy.ExtraData = types.StringValue(`{ "env": "prod" }`)
+	deploy.AllParentsMustConverge = types.BoolValue(true)
+	build := jobNode("build", 11)
+	build.Limit = types.StringValue("")
+	build.SuccessNodes = identifiers("deploy")
+
+	// The read deploy has the credentials added outside of Terraform, the
+	// semantically equal extra_data is kept as written and empty values
+	// stay null or empty as in state.
+	readDeploy := deploy
+	readDeploy.CredentialIDs = int64Set(4)
+	readBuild := build
+	readBuild.SuccessNodes = identifiers("deploy", "manual")
+	importedA := graphNode("a")
+	importedA.AlwaysNodes = identifiers("b")
+
+	tests := []struct {
+		name        string
+		nodes       []map[string]any
+		credentials map[int64][]int64
+		missing     bool
+		prior       []framework.WorkflowGraphNodeModel
+		priorIDs    map[string]int64
+		want        []framework.WorkflowGraphNodeModel
+		wantIDs     map[string]int64
+		wantRemoved bool
+	}{
+		{
+			name: "keeps the state order and appends unmanaged nodes",
+			nodes: []map[string]any{
+				{"id": 1, "identifier": "build", "unified_job_template": 11, "success_nodes": []any{3, 2}, "all_parents_must_converge": false, "limit": "", "extra_data": map[string]any{}},
+				{"id": 2, "identifier": "manual", "unified_job_template": 15, "extra_data": map[string]any{}},
+				{"id": 3, "identifier": "deploy", "unified_job_template": 12, "extra_data": map[string]any{"env": "prod"}, "all_parents_must_converge": true},
+			},
+			credentials: map[int64][]int64{3: {4}},
+			prior:       []framework.WorkflowGraphNodeModel{deploy, build},
+			priorIDs:    map[string]int64{"deploy": 3, "build": 1},
+			want:        []framework.WorkflowGraphNodeModel{readDeploy, readBuild, jobNode("manual", 15)},
+			wantIDs:     map[string]int64{"build": 1, "manual": 2, "deploy": 3},
+		},
+		{
+			name: "after import",
+			nodes: []map[string]any{
+				{"id": 2, "identifier": "b", "extra_data": map[string]any{}},
+				{"id": 1, "identifier": "a", "always_nodes": []any{2}, "extra_data": map[string]any{}},
+			},
+			want:    []framework.WorkflowGraphNodeModel{importedA, graphNode("b")},
+			wantIDs: map[string]int64{"a": 1, "b": 2},
+		},
+		{
+			name:        "missing workflow removes the resource",
+			missing:     true,
+			prior:       []framework.WorkflowGraphNodeModel{graphNode("a")},
+			priorIDs:    map[string]int64{"a": 1},
+			wantRemoved: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newWorkflowAWX(t, tt.nodes...)
+			maps.Copy(f.credentials, tt.credentials)
+			f.missing = tt.missing
+			r, s := newWorkflowGraphResource(t, f)
+
+			state, diags := readResource(r, s, workflowGraphValue(t, s, tt.prior, tt.priorIDs))
+			require.False(t, diags.HasError(), "%v", diags)
+			assert.Empty(t, f.calls)
+			if tt.wantRemoved {
+				assert.True(t, state.Raw.IsNull())
+				return
+			}
+			got, ids := workflowGraphState(t, state)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantIDs, ids)
+		})
+	}
+}
+
+func TestWorkflowGraphResource_ModifyPlan(t *testing.T) {
+	changed := graphNode("b")
+	changed.Limit = types.StringValue("web")
+
+	tests := []struct {
+		name        string
+		nodes       []framework.WorkflowGraphNodeModel
+		wantUnknown bool
+	}{
+		{name: "same identifiers keep the IDs", nodes: []framework.WorkflowGraphNodeModel{changed, graphNode("a")}},
+		{name: "new identifiers leave the IDs unknown", nodes: []framework.WorkflowGraphNodeModel{graphNode("a"), graphNode("c")}, wantUnknown: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			r, s := newWorkflowGraphResource(t, newWorkflowAWX(t))
+			state := tfsdk.State{Schema: s, Raw: workflowGraphValue(t, s, []framework.WorkflowGraphNodeModel{graphNode("a"), graphNode("b")}, map[string]int64{"a": 1, "b": 2})}
+			plan := tfsdk.Plan{Schema: s, Raw: workflowGraphValue(t, s, tt.nodes, nil)}
+			require.False(t, plan.SetAttribute(ctx, path.Root("node_ids"), types.MapUnknown(types.Int64Type)).HasError())
+
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+			require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+			var got types.Map
+			require.False(t, resp.Plan.GetAttribute(ctx, path.Root("node_ids"), &got).HasError())
+			assert.Equal(t, tt.wantUnknown, got.IsUnknown())
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NestedObjectOrJsonAsMap converts an object with one nested object per
+// variant, or its raw JSON counterpart, into a map suitable for an AWX request
+// body. The first nested object that is set wins, without one the raw JSON
+// object is decoded. Neither set, or invalid JSON, gives an empty map.
+func NestedObjectOrJsonAsMap(obj types.Object, raw types.String) map[string]any {
+	if !obj.IsNull() && !obj.IsUnknown() {
+		for _, val := range obj.Attributes() {
+			if nested, ok := val.(types.Object); ok && !nested.IsNull() && !nested.IsUnknown() {
+				return ObjectAsMap(nested)
+			}
+		}
+	}
+	out := map[string]any{}
+	if raw.IsNull() || raw.IsUnknown() || raw.ValueString() == "" {
+		return out
+	}
+	dec := json.NewDecoder(bytes.NewReader([]byte(raw.ValueString())))
+	dec.UseNumber()
+	if err := dec.Decode(&out); err != nil {
+		return map[string]any{}
+	}
+	return out
+}
+
+// AttrValueSetNestedObjectOrJson sets the raw JSON counterpart of an object
+// with one nested object per variant from a JSON object of the API, and the
+// object to null. The API does not tell the variant, it is up to the caller
+// to move the value into the nested object it belongs to. Null values are
+// left out, an empty object gives a null string.
+func AttrValueSetNestedObjectOrJson(obj *types.Object, raw *types.String, data any, attrTypes map[string]attr.Type) (d diag.Diagnostics, err error) {
+	if obj == nil || raw == nil {
+		return nilObjErr()
+	}
+	*obj = types.ObjectNull(attrTypes)
+
+	if data == nil {
+		*raw = types.StringNull()
+		return d, nil
+	}
+
+	values, ok := data.(map[string]any)
+	if !ok {
+		err = fmt.Errorf("failed to decode and set %v of %T type", data, data)
+		d.AddError(fmt.Sprintf("failed to decode value of type %T for types.Object", data), err.Error())
+		return d, err
+	}
+
+	set := make(map[string]any, len(values))
+	for k, v := range values {
+		if v != nil {
+			set[k] = v
+		}
+	}
+	if len(set) == 0 {
+		*raw = types.StringNull()
+		return d, nil
+	}
+
+	encoded, err := json.Marshal(set)
+	if err != nil {
+		d.AddError("failed to encode the object as JSON", err.Error())
+		return d, err
+	}
+	*raw = types.StringValue(string(encoded))
+	return d, nil
+}
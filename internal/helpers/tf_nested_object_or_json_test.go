@@ -0,0 +1,108 @@
+package helpers_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+var variantAttrTypes = map[string]attr.Type{
+	"kv":  types.ObjectType{AttrTypes: map[string]attr.Type{"secret_path": types.StringType, "secret_key": types.StringType}},
+	"ssh": types.ObjectType{AttrTypes: map[string]attr.Type{"public_key": types.StringType}},
+}
+
+func variants(kv map[string]attr.Value) types.Object {
+	kvTypes := variantAttrTypes["kv"].(types.ObjectType).AttrTypes
+	sshTypes := variantAttrTypes["ssh"].(types.ObjectType).AttrTypes
+	values := map[string]attr.Value{"kv": types.ObjectNull(kvTypes), "ssh": types.ObjectNull(sshTypes)}
+	if kv != nil {
+		values["kv"] = types.ObjectValueMust(kvTypes, kv)
+	}
+	return types.ObjectValueMust(variantAttrTypes, values)
+}
+
+func TestNestedObjectOrJsonAsMap(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  types.Object
+		raw  types.String
+		want map[string]any
+	}{
+		{
+			name: "the set nested object",
+			obj:  variants(map[string]attr.Value{"secret_path": types.StringValue("/kv/app"), "secret_key": types.StringNull()}),
+			raw:  types.StringNull(),
+			want: map[string]any{"secret_path": "/kv/app"},
+		},
+		{
+			name: "raw JSON without a nested object",
+			obj:  variants(nil),
+			raw:  types.StringValue(`{"account-name": "root", "port": 22}`),
+			want: map[string]any{"account-name": "root", "port": json.Number("22")},
+		},
+		{
+			name: "raw JSON with a null object",
+			obj:  types.ObjectNull(variantAttrTypes),
+			raw:  types.StringValue(`{"system-name": "db"}`),
+			want: map[string]any{"system-name": "db"},
+		},
+		{
+			name: "neither set",
+			obj:  types.ObjectNull(variantAttrTypes),
+			raw:  types.StringNull(),
+			want: map[string]any{},
+		},
+		{
+			name: "invalid JSON",
+			obj:  types.ObjectNull(variantAttrTypes),
+			raw:  types.StringValue(`{`),
+			want: map[string]any{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, helpers.NestedObjectOrJsonAsMap(tt.obj, tt.raw))
+		})
+	}
+}
+
+func TestAttrValueSetNestedObjectOrJson(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      any
+		want      types.String
+		wantError bool
+	}{
+		{name: "null", data: nil, want: types.StringNull()},
+		{name: "empty object", data: map[string]any{}, want: types.StringNull()},
+		{name: "null values are left out", data: map[string]any{"secret_path": "/kv/app", "secret_key": nil}, want: types.StringValue(`{"secret_path":"/kv/app"}`)},
+		{name: "wrong data type", data: "{}", wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := variants(nil)
+			raw := types.StringValue("prior")
+			d, err := helpers.AttrValueSetNestedObjectOrJson(&obj, &raw, tt.data, variantAttrTypes)
+			if tt.wantError {
+				require.Error(t, err)
+				require.True(t, d.HasError())
+				return
+			}
+			require.NoError(t, err)
+			require.False(t, d.HasError())
+			require.True(t, obj.IsNull())
+			require.Equal(t, tt.want, raw)
+		})
+	}
+
+	t.Run("obj is nil error", func(t *testing.T) {
+		var raw types.String
+		d, err := helpers.AttrValueSetNestedObjectOrJson(nil, &raw, map[string]any{}, variantAttrTypes)
+		require.Error(t, err)
+		require.True(t, d.HasError())
+	})
+}
@@ -0,0 +1,33 @@
+package helpers
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ObjectAsMap converts a Terraform types.Object into a map suitable for an
+// AWX request body. Null and unknown attributes are left out, a null or
+// unknown object gives a nil map.
+func ObjectAsMap(obj types.Object) map[string]any {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	out := map[string]any{}
+	for name, val := range obj.Attributes() {
+		if val.IsNull() || val.IsUnknown() {
+			continue
+		}
+		switch v := val.(type) {
+		case types.String:
+			out[name] = v.ValueString()
+		case types.Bool:
+			out[name] = v.ValueBool()
+		case types.Int64:
+			out[name] = v.ValueInt64()
+		case types.Float64:
+			out[name] = v.ValueFloat64()
+		default:
+			out[name] = val.String()
+		}
+	}
+	return out
+}
@@ -0,0 +1,50 @@
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestObjectAsMap(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"secret_path":    types.StringType,
+		"secret_version": types.StringType,
+		"secret_key":     types.StringType,
+		"verify":         types.BoolType,
+		"port":           types.Int64Type,
+	}
+
+	t.Run("null object", func(t *testing.T) {
+		require.Nil(t, helpers.ObjectAsMap(types.ObjectNull(attrTypes)))
+	})
+
+	t.Run("unknown object", func(t *testing.T) {
+		require.Nil(t, helpers.ObjectAsMap(types.ObjectUnknown(attrTypes)))
+	})
+
+	t.Run("null and unknown attributes are left out", func(t *testing.T) {
+		obj := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+			"secret_path":    types.StringValue("/kv/app"),
+			"secret_version": types.StringNull(),
+			"secret_key":     types.StringUnknown(),
+			"verify":         types.BoolValue(false),
+			"port":           types.Int64Value(8200),
+		})
+		require.Equal(t, map[string]any{
+			"secret_path": "/kv/app",
+			"verify":      false,
+			"port":        int64(8200),
+		}, helpers.ObjectAsMap(obj))
+	})
+
+	t.Run("empty object", func(t *testing.T) {
+		obj := types.ObjectValueMust(map[string]attr.Type{"secret_path": types.StringType}, map[string]attr.Value{
+			"secret_path": types.StringNull(),
+		})
+		require.Equal(t, map[string]any{}, helpers.ObjectAsMap(obj))
+	})
+}
@@ -0,0 +1,25 @@
+package helpers
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SetAsStringSlice converts a Terraform types.Set into a []string suitable
+// for an AWX request body. Elements are converted the same way as in
+// ListAsStringSlice.
+func SetAsStringSlice(set types.Set, trim bool) []string {
+	out := []string{}
+	for _, val := range set.Elements() {
+		var s string
+		if sv, ok := val.(types.String); ok {
+			s = sv.ValueString()
+		} else {
+			s = val.String()
+		}
+		if trim {
+			s = TrimAwxString(s)
+		}
+		out = append(out, s)
+	}
+	return out
+}
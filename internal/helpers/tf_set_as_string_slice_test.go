@@ -0,0 +1,33 @@
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAsStringSlice(t *testing.T) {
+	t.Run("null set returns empty slice", func(t *testing.T) {
+		out := helpers.SetAsStringSlice(types.SetNull(types.StringType), false)
+		require.Empty(t, out)
+		require.NotNil(t, out)
+	})
+
+	t.Run("set of strings", func(t *testing.T) {
+		out := helpers.SetAsStringSlice(types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("a"),
+			types.StringValue("b"),
+		}), false)
+		require.ElementsMatch(t, []string{"a", "b"}, out)
+	})
+
+	t.Run("set of strings with trim", func(t *testing.T) {
+		out := helpers.SetAsStringSlice(types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue(" a\n"),
+		}), true)
+		require.Equal(t, []string{"a"}, out)
+	})
+}
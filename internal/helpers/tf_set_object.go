@@ -0,0 +1,64 @@
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AttrValueSetObject sets an object attribute from a JSON object of the API.
+// Only the keys of attrTypes are read, missing keys become null attributes.
+// String, bool and int64 attributes are supported.
+func AttrValueSetObject(obj *types.Object, data any, attrTypes map[string]attr.Type) (d diag.Diagnostics, err error) {
+	if obj == nil {
+		return nilObjErr()
+	}
+
+	if data == nil {
+		*obj = types.ObjectNull(attrTypes)
+		return d, nil
+	}
+
+	values, ok := data.(map[string]any)
+	if !ok {
+		err = fmt.Errorf("failed to decode and set %v of %T type", data, data)
+		d.AddError(fmt.Sprintf("failed to decode value of type %T for types.Object", data), err.Error())
+		return d, err
+	}
+
+	attrs := make(map[string]attr.Value, len(attrTypes))
+	for name, t := range attrTypes {
+		var vd diag.Diagnostics
+		switch t {
+		case types.StringType:
+			var v types.String
+			vd, err = AttrValueSetString(&v, values[name], false)
+			attrs[name] = v
+		case types.BoolType:
+			var v types.Bool
+			vd, err = AttrValueSetBool(&v, values[name])
+			attrs[name] = v
+		case types.Int64Type:
+			var v types.Int64
+			vd, err = AttrValueSetInt64(&v, values[name])
+			attrs[name] = v
+		default:
+			err = fmt.Errorf("unsupported type %s of attribute %q", t, name)
+			vd.AddError("unsupported attribute type for types.Object", err.Error())
+		}
+		d.Append(vd...)
+		if err != nil {
+			return d, err
+		}
+	}
+
+	var od diag.Diagnostics
+	*obj, od = types.ObjectValue(attrTypes, attrs)
+	d.Append(od...)
+	if od.HasError() {
+		return d, fmt.Errorf("failed to build the object")
+	}
+	return d, nil
+}
@@ -0,0 +1,66 @@
+package helpers_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttrValueSetObject(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"secret_path":    types.StringType,
+		"secret_version": types.StringType,
+		"verify":         types.BoolType,
+		"port":           types.Int64Type,
+	}
+
+	t.Run("obj is nil error", func(t *testing.T) {
+		var d, err = helpers.AttrValueSetObject(nil, map[string]any{}, attrTypes)
+		require.Error(t, err)
+		require.True(t, d.HasError())
+	})
+
+	t.Run("value is null should return a null object", func(t *testing.T) {
+		var obj types.Object
+		var d, err = helpers.AttrValueSetObject(&obj, nil, attrTypes)
+		require.NoError(t, err)
+		require.False(t, d.HasError())
+		require.True(t, obj.IsNull())
+	})
+
+	t.Run("known keys are set and the rest are null", func(t *testing.T) {
+		var obj types.Object
+		var d, err = helpers.AttrValueSetObject(&obj, map[string]any{
+			"secret_path": "/kv/app",
+			"verify":      true,
+			"port":        json.Number("8200"),
+			"unknown_key": "ignored",
+		}, attrTypes)
+		require.NoError(t, err)
+		require.False(t, d.HasError())
+		require.Equal(t, map[string]attr.Value{
+			"secret_path":    types.StringValue("/kv/app"),
+			"secret_version": types.StringNull(),
+			"verify":         types.BoolValue(true),
+			"port":           types.Int64Value(8200),
+		}, obj.Attributes())
+	})
+
+	t.Run("wrong data type", func(t *testing.T) {
+		var obj types.Object
+		var d, err = helpers.AttrValueSetObject(&obj, "{}", attrTypes)
+		require.Error(t, err)
+		require.True(t, d.HasError())
+	})
+
+	t.Run("wrong attribute type", func(t *testing.T) {
+		var obj types.Object
+		var d, err = helpers.AttrValueSetObject(&obj, map[string]any{"verify": "yes"}, attrTypes)
+		require.Error(t, err)
+		require.True(t, d.HasError())
+	})
+}
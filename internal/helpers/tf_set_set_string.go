@@ -0,0 +1,60 @@
+package helpers
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func AttrValueSetSetString(obj *types.Set, data any, trim bool) (d diag.Diagnostics, err error) {
+	if obj == nil {
+		return nilObjErr()
+	}
+
+	if data == nil {
+		*obj = types.SetValueMust(types.StringType, []attr.Value{})
+		return nil, nil
+	}
+
+	maybeTrim := func(s string) string {
+		if trim {
+			return TrimAwxString(s)
+		}
+		return s
+	}
+
+	var values []string
+	switch data := data.(type) {
+	case types.Set:
+		*obj = types.SetValueMust(types.StringType, data.Elements())
+		return d, nil
+	case []any:
+		for _, v := range data {
+			s, ok := v.(string)
+			if !ok {
+				err = fmt.Errorf("failed to decode and set %v of %T type", v, v)
+				d.AddError(fmt.Sprintf("failed to decode element of type %T for types.Set", v), err.Error())
+				return d, err
+			}
+			values = append(values, s)
+		}
+	case []string:
+		values = data
+	default:
+		err = fmt.Errorf("failed to decode and set %v of %T type", data, data)
+		d.AddError(
+			fmt.Sprintf("failed to decode value of type %T for types.Set", data),
+			err.Error(),
+		)
+		return d, err
+	}
+
+	set := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		set = append(set, types.StringValue(maybeTrim(v)))
+	}
+	*obj = types.SetValueMust(types.StringType, set)
+	return d, nil
+}
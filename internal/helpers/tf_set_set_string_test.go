@@ -0,0 +1,73 @@
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttrValueSetSetString(t *testing.T) {
+	type model struct {
+		Value types.Set `tfsdk:"value"`
+	}
+
+	t.Run("obj is nil error", func(t *testing.T) {
+		var d, err = helpers.AttrValueSetSetString(nil, "test", false)
+		require.Error(t, err)
+		require.True(t, d.HasError())
+	})
+
+	t.Run("value is null should return empty set", func(t *testing.T) {
+		var state model
+		var d, err = helpers.AttrValueSetSetString(&state.Value, nil, false)
+		require.NoError(t, err)
+		require.False(t, d.HasError())
+		require.Empty(t, state.Value.Elements())
+	})
+
+	t.Run("value is a types.Set", func(t *testing.T) {
+		var state model
+		var d, err = helpers.AttrValueSetSetString(&state.Value,
+			types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("test"),
+			}), false)
+		require.NoError(t, err)
+		require.False(t, d.HasError())
+		require.Len(t, state.Value.Elements(), 1)
+	})
+
+	t.Run("order of the values does not matter", func(t *testing.T) {
+		var a, b model
+		_, err := helpers.AttrValueSetSetString(&a.Value, []any{"awx.view_inventory", "awx.use_inventory"}, false)
+		require.NoError(t, err)
+		_, err = helpers.AttrValueSetSetString(&b.Value, []string{"awx.use_inventory", "awx.view_inventory"}, false)
+		require.NoError(t, err)
+		require.True(t, a.Value.Equal(b.Value))
+	})
+
+	t.Run("value is a []any with trim", func(t *testing.T) {
+		var state model
+		var d, err = helpers.AttrValueSetSetString(&state.Value, []any{" test "}, true)
+		require.NoError(t, err)
+		require.False(t, d.HasError())
+		require.Len(t, state.Value.Elements(), 1)
+		require.EqualValues(t, "test", state.Value.Elements()[0].(types.String).ValueString())
+	})
+
+	t.Run("non-string element is an error", func(t *testing.T) {
+		var state model
+		var d, err = helpers.AttrValueSetSetString(&state.Value, []any{1}, false)
+		require.Error(t, err)
+		require.True(t, d.HasError())
+	})
+
+	t.Run("wrong type is an error", func(t *testing.T) {
+		var state model
+		var d, err = helpers.AttrValueSetSetString(&state.Value, "test", false)
+		require.Error(t, err)
+		require.True(t, d.HasError())
+	})
+}
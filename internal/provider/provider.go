@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -15,6 +18,7 @@ import (
 	"github.com/ilijamt/terraform-provider-awx/internal/helpers"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -43,6 +47,10 @@ type Model struct {
 	Password  types.String `tfsdk:"password"`
 	Token     types.String `tfsdk:"token"`
 	VerifySSL types.Bool   `tfsdk:"verify_ssl"`
+
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin types.String `tfsdk:"retry_wait_min"`
+	RetryWaitMax types.String `tfsdk:"retry_wait_max"`
 }
 
 func (p *Provider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -87,6 +95,24 @@ func (p *Provider) Schema(ctx context.Context, req provider.SchemaRequest, resp
 				Sensitive:   true,
 				Description: "The token to use to connect to the AWX host. (defaults to TOWER_AUTH_TOKEN/AWX_AUTH_TOKEN env variable if set) [conflicts with username/password]",
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "How many times a request is retried when AWX responds with 429, 502, 503 or 504, or the connection fails. POST and PATCH requests are only retried on 429 or when the connection could not be established. Set to 0 to disable retries. (defaults to TOWER_MAX_RETRIES/AWX_MAX_RETRIES env variable if set) [default is 3]",
+				Optional:    true,
+				Required:    false,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Description: "The minimum time to wait between retries as a Go duration, e.g. 500ms or 2s. The wait doubles on every attempt, with jitter, unless AWX sends a Retry-After header. (defaults to TOWER_RETRY_WAIT_MIN/AWX_RETRY_WAIT_MIN env variable if set) [default is 1s]",
+				Optional:    true,
+				Required:    false,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Description: "The maximum time to wait between retries as a Go duration, e.g. 30s or 1m. A Retry-After header from AWX is capped at this value too. When unset it is raised to retry_wait_min if that is larger than the default. (defaults to TOWER_RETRY_WAIT_MAX/AWX_RETRY_WAIT_MAX env variable if set) [default is 30s]",
+				Optional:    true,
+				Required:    false,
+			},
 		},
 	}
 }
@@ -119,6 +145,25 @@ func configureFromEnvironment(ctx context.Context, data *Model) {
 		envConfig["VerifySSL"] = val
 	}
 
+	if val := helpers.GetFirstSetEnvVar("TOWER_MAX_RETRIES", "AWX_MAX_RETRIES"); val != "" && data.MaxRetries.IsNull() {
+		if n, err := strconv.ParseInt(val, 10, 64); err == nil {
+			data.MaxRetries = types.Int64Value(n)
+			envConfig["MaxRetries"] = val
+		} else {
+			tflog.Warn(ctx, "Ignoring invalid max retries from the environment", map[string]any{"value": val})
+		}
+	}
+
+	if val := helpers.GetFirstSetEnvVar("TOWER_RETRY_WAIT_MIN", "AWX_RETRY_WAIT_MIN"); val != "" && data.RetryWaitMin.IsNull() {
+		data.RetryWaitMin = types.StringValue(val)
+		envConfig["RetryWaitMin"] = val
+	}
+
+	if val := helpers.GetFirstSetEnvVar("TOWER_RETRY_WAIT_MAX", "AWX_RETRY_WAIT_MAX"); val != "" && data.RetryWaitMax.IsNull() {
+		data.RetryWaitMax = types.StringValue(val)
+		envConfig["RetryWaitMax"] = val
+	}
+
 	tflog.Debug(ctx, "Provider configuration from the environment", envConfig)
 }
 
@@ -128,6 +173,24 @@ func configureDefaults(ctx context.Context, data *Model) {
 		data.VerifySSL = types.BoolValue(true)
 		defaults["VerifySSL"] = data.VerifySSL.ValueBool()
 	}
+	if data.MaxRetries.IsNull() {
+		data.MaxRetries = types.Int64Value(c.DefaultMaxRetries)
+		defaults["MaxRetries"] = data.MaxRetries.ValueInt64()
+	}
+	if data.RetryWaitMin.IsNull() {
+		data.RetryWaitMin = types.StringValue(c.DefaultRetryWaitMin.String())
+		defaults["RetryWaitMin"] = data.RetryWaitMin.ValueString()
+	}
+	if data.RetryWaitMax.IsNull() {
+		// Only retry_wait_min was set, so raise the default maximum to it
+		// instead of rejecting a minimum above 30s.
+		waitMax := c.DefaultRetryWaitMax
+		if waitMin, err := time.ParseDuration(data.RetryWaitMin.ValueString()); err == nil && waitMin > waitMax {
+			waitMax = waitMin
+		}
+		data.RetryWaitMax = types.StringValue(waitMax.String())
+		defaults["RetryWaitMax"] = data.RetryWaitMax.ValueString()
+	}
 	tflog.Debug(ctx, "Defaults configured for provider", defaults)
 }
 
@@ -181,15 +244,23 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 		}
 	}
 
+	retry := c.RetryConfig{MaxRetries: int(config.MaxRetries.ValueInt64())}
+	retry.WaitMin = parseRetryWait(&resp.Diagnostics, "retry_wait_min", config.RetryWaitMin)
+	retry.WaitMax = parseRetryWait(&resp.Diagnostics, "retry_wait_max", config.RetryWaitMax)
+	if retry.WaitMin > retry.WaitMax {
+		resp.Diagnostics.AddAttributeError(path.Root("retry_wait_max"), "Invalid retry wait",
+			fmt.Sprintf("retry_wait_max (%s) must not be lower than retry_wait_min (%s).", retry.WaitMax, retry.WaitMin))
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	var client c.Client
 	if !noBasicAuth && noTokenAuth {
-		client = c.NewClientWithBasicAuth(config.Username.ValueString(), config.Password.ValueString(), config.Hostname.ValueString(), p.version, !config.VerifySSL.ValueBool(), p.httpClient)
+		client = c.NewClientWithBasicAuth(config.Username.ValueString(), config.Password.ValueString(), config.Hostname.ValueString(), p.version, !config.VerifySSL.ValueBool(), p.httpClient, retry)
 	} else {
-		client = c.NewClientWithTokenAuth(config.Token.ValueString(), config.Hostname.ValueString(), p.version, !config.VerifySSL.ValueBool(), p.httpClient)
+		client = c.NewClientWithTokenAuth(config.Token.ValueString(), config.Hostname.ValueString(), p.version, !config.VerifySSL.ValueBool(), p.httpClient, retry)
 	}
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -197,6 +268,15 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	tflog.Debug(ctx, "Provider configuration finished")
 }
 
+func parseRetryWait(diags *diag.Diagnostics, attr string, value types.String) time.Duration {
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil || d <= 0 {
+		diags.AddAttributeError(path.Root(attr), "Invalid retry wait",
+			fmt.Sprintf("%s must be a positive duration such as 1s or 500ms, got %q.", attr, value.ValueString()))
+	}
+	return d
+}
+
 func (p *Provider) Resources(ctx context.Context) []func() resource.Resource {
 	return p.fnResources
 }
@@ -11,7 +11,8 @@ import (
 )
 
 func TestProviderConfigureFromEnvironment(t *testing.T) {
-	var defaultEnvs = []string{"AWX_HOST", "AWX_USERNAME", "AWX_PASSWORD", "TOWER_HOST", "TOWER_PASSWORD", "TOWER_USERNAME", "TOWER_AUTH_TOKEN", "AWX_AUTH_TOKEN"}
+	var defaultEnvs = []string{"AWX_HOST", "AWX_USERNAME", "AWX_PASSWORD", "TOWER_HOST", "TOWER_PASSWORD", "TOWER_USERNAME", "TOWER_AUTH_TOKEN", "AWX_AUTH_TOKEN",
+		"TOWER_MAX_RETRIES", "AWX_MAX_RETRIES", "TOWER_RETRY_WAIT_MIN", "AWX_RETRY_WAIT_MIN", "TOWER_RETRY_WAIT_MAX", "AWX_RETRY_WAIT_MAX"}
 	var tests = []struct {
 		in   map[string]string
 		null []string
@@ -52,6 +53,16 @@ func TestProviderConfigureFromEnvironment(t *testing.T) {
 			out:  Model{Token: types.StringValue("awx-auth-token")},
 			null: []string{"hostname", "username", "password"},
 		},
+		{
+			in:   map[string]string{"AWX_MAX_RETRIES": "5", "TOWER_RETRY_WAIT_MIN": "2s", "AWX_RETRY_WAIT_MAX": "1m"},
+			out:  Model{MaxRetries: types.Int64Value(5), RetryWaitMin: types.StringValue("2s"), RetryWaitMax: types.StringValue("1m")},
+			null: []string{"hostname", "username", "password", "token"},
+		},
+		{
+			in:   map[string]string{"AWX_MAX_RETRIES": "many"},
+			out:  Model{},
+			null: []string{"hostname", "username", "password", "token"},
+		},
 	}
 
 	for _, test := range tests {
@@ -104,4 +115,25 @@ func TestConfigureDefaults(t *testing.T) {
 		configureDefaults(t.Context(), config)
 		require.True(t, config.VerifySSL.ValueBool())
 	})
+
+	t.Run("retry defaults", func(t *testing.T) {
+		var config = &Model{MaxRetries: types.Int64Value(0)}
+		configureDefaults(t.Context(), config)
+		require.EqualValues(t, 0, config.MaxRetries.ValueInt64())
+		require.Equal(t, "1s", config.RetryWaitMin.ValueString())
+		require.Equal(t, "30s", config.RetryWaitMax.ValueString())
+	})
+
+	t.Run("retry wait max follows a larger retry wait min", func(t *testing.T) {
+		var config = &Model{RetryWaitMin: types.StringValue("1m")}
+		configureDefaults(t.Context(), config)
+		require.Equal(t, "1m", config.RetryWaitMin.ValueString())
+		require.Equal(t, "1m0s", config.RetryWaitMax.ValueString())
+	})
+
+	t.Run("explicit retry wait max is kept", func(t *testing.T) {
+		var config = &Model{RetryWaitMin: types.StringValue("1m"), RetryWaitMax: types.StringValue("10s")}
+		configureDefaults(t.Context(), config)
+		require.Equal(t, "10s", config.RetryWaitMax.ValueString())
+	})
 }
@@ -55,16 +55,23 @@ func TestProviderConfiguration(t *testing.T) {
 			"password":   tftypes.String,
 			"verify_ssl": tftypes.Bool,
 			"token":      tftypes.String,
+
+			"max_retries":    tftypes.Number,
+			"retry_wait_min": tftypes.String,
+			"retry_wait_max": tftypes.String,
 		},
 	}
 
 	t.Run("valid configuration", func(t *testing.T) {
 		config, err := tfprotov6.NewDynamicValue(ConfigDataType, tftypes.NewValue(ConfigDataType, map[string]tftypes.Value{
-			"hostname":   tftypes.NewValue(tftypes.String, "host"),
-			"username":   tftypes.NewValue(tftypes.String, "username"),
-			"password":   tftypes.NewValue(tftypes.String, "password"),
-			"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-			"token":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"hostname":       tftypes.NewValue(tftypes.String, "host"),
+			"username":       tftypes.NewValue(tftypes.String, "username"),
+			"password":       tftypes.NewValue(tftypes.String, "password"),
+			"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+			"token":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+			"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+			"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 		}))
 		require.NoError(t, err)
 		response, err := frameworkServer.ConfigureProvider(t.Context(), &tfprotov6.ConfigureProviderRequest{
@@ -77,11 +84,14 @@ func TestProviderConfiguration(t *testing.T) {
 
 	t.Run("unknown values for configuration", func(t *testing.T) {
 		config, err := tfprotov6.NewDynamicValue(ConfigDataType, tftypes.NewValue(ConfigDataType, map[string]tftypes.Value{
-			"hostname":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-			"username":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-			"password":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-			"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-			"token":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"hostname":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"username":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"password":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+			"token":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+			"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+			"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 		}))
 		require.NoError(t, err)
 		response, err := frameworkServer.ConfigureProvider(t.Context(), &tfprotov6.ConfigureProviderRequest{
@@ -94,11 +104,14 @@ func TestProviderConfiguration(t *testing.T) {
 
 	t.Run("empty values for configuration", func(t *testing.T) {
 		config, err := tfprotov6.NewDynamicValue(ConfigDataType, tftypes.NewValue(ConfigDataType, map[string]tftypes.Value{
-			"hostname":   tftypes.NewValue(tftypes.String, ""),
-			"username":   tftypes.NewValue(tftypes.String, ""),
-			"password":   tftypes.NewValue(tftypes.String, ""),
-			"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-			"token":      tftypes.NewValue(tftypes.String, ""),
+			"hostname":       tftypes.NewValue(tftypes.String, ""),
+			"username":       tftypes.NewValue(tftypes.String, ""),
+			"password":       tftypes.NewValue(tftypes.String, ""),
+			"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+			"token":          tftypes.NewValue(tftypes.String, ""),
+			"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+			"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+			"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 		}))
 		require.NoError(t, err)
 		response, err := frameworkServer.ConfigureProvider(t.Context(), &tfprotov6.ConfigureProviderRequest{
@@ -117,121 +130,195 @@ func TestProviderConfiguration(t *testing.T) {
 		}{
 			{
 				in: map[string]tftypes.Value{
-					"hostname":   tftypes.NewValue(tftypes.String, ""),
-					"username":   tftypes.NewValue(tftypes.String, "username"),
-					"password":   tftypes.NewValue(tftypes.String, "password"),
-					"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-					"token":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"hostname":       tftypes.NewValue(tftypes.String, ""),
+					"username":       tftypes.NewValue(tftypes.String, "username"),
+					"password":       tftypes.NewValue(tftypes.String, "password"),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 				},
 				errLen:     1,
 				errSummary: []string{"Unknown AWX API Host"},
 			},
 			{
 				in: map[string]tftypes.Value{
-					"hostname":   tftypes.NewValue(tftypes.String, "hostname"),
-					"username":   tftypes.NewValue(tftypes.String, ""),
-					"password":   tftypes.NewValue(tftypes.String, "password"),
-					"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-					"token":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"hostname":       tftypes.NewValue(tftypes.String, "hostname"),
+					"username":       tftypes.NewValue(tftypes.String, ""),
+					"password":       tftypes.NewValue(tftypes.String, "password"),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 				},
 				errLen:     1,
 				errSummary: []string{"Unknown AWX API Username"},
 			},
 			{
 				in: map[string]tftypes.Value{
-					"hostname":   tftypes.NewValue(tftypes.String, "hostname"),
-					"username":   tftypes.NewValue(tftypes.String, "username"),
-					"password":   tftypes.NewValue(tftypes.String, ""),
-					"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-					"token":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"hostname":       tftypes.NewValue(tftypes.String, "hostname"),
+					"username":       tftypes.NewValue(tftypes.String, "username"),
+					"password":       tftypes.NewValue(tftypes.String, ""),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 				},
 				errLen:     1,
 				errSummary: []string{"Unknown AWX API Password"},
 			},
 			{
 				in: map[string]tftypes.Value{
-					"hostname":   tftypes.NewValue(tftypes.String, "hostname"),
-					"username":   tftypes.NewValue(tftypes.String, ""),
-					"password":   tftypes.NewValue(tftypes.String, ""),
-					"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-					"token":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"hostname":       tftypes.NewValue(tftypes.String, "hostname"),
+					"username":       tftypes.NewValue(tftypes.String, ""),
+					"password":       tftypes.NewValue(tftypes.String, ""),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 				},
 				errLen:     1,
 				errSummary: []string{`must provide one of ["username", "password"] or "token".`},
 			},
 			{
 				in: map[string]tftypes.Value{
-					"hostname":   tftypes.NewValue(tftypes.String, ""),
-					"username":   tftypes.NewValue(tftypes.String, "username"),
-					"password":   tftypes.NewValue(tftypes.String, ""),
-					"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-					"token":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"hostname":       tftypes.NewValue(tftypes.String, ""),
+					"username":       tftypes.NewValue(tftypes.String, "username"),
+					"password":       tftypes.NewValue(tftypes.String, ""),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 				},
 				errLen:     2,
 				errSummary: []string{"Unknown AWX API Host", "Unknown AWX API Password"},
 			},
 			{
 				in: map[string]tftypes.Value{
-					"hostname":   tftypes.NewValue(tftypes.String, ""),
-					"username":   tftypes.NewValue(tftypes.String, ""),
-					"password":   tftypes.NewValue(tftypes.String, ""),
-					"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-					"token":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"hostname":       tftypes.NewValue(tftypes.String, ""),
+					"username":       tftypes.NewValue(tftypes.String, ""),
+					"password":       tftypes.NewValue(tftypes.String, ""),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 				},
 				errLen:     2,
 				errSummary: []string{"Unknown AWX API Host", `must provide one of ["username", "password"] or "token".`},
 			},
 			{
 				in: map[string]tftypes.Value{
-					"hostname":   tftypes.NewValue(tftypes.String, "hostname"),
-					"username":   tftypes.NewValue(tftypes.String, ""),
-					"password":   tftypes.NewValue(tftypes.String, ""),
-					"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-					"token":      tftypes.NewValue(tftypes.String, "token"),
+					"hostname":       tftypes.NewValue(tftypes.String, "hostname"),
+					"username":       tftypes.NewValue(tftypes.String, ""),
+					"password":       tftypes.NewValue(tftypes.String, ""),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, "token"),
+					"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 				},
 				errLen: 0,
 			},
 			{
 				in: map[string]tftypes.Value{
-					"hostname":   tftypes.NewValue(tftypes.String, "hostname"),
-					"username":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-					"password":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-					"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-					"token":      tftypes.NewValue(tftypes.String, "token"),
+					"hostname":       tftypes.NewValue(tftypes.String, "hostname"),
+					"username":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"password":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, "token"),
+					"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 				},
 				errLen: 0,
 			},
 			{
 				in: map[string]tftypes.Value{
-					"hostname":   tftypes.NewValue(tftypes.String, "hostname"),
-					"username":   tftypes.NewValue(tftypes.String, "username"),
-					"password":   tftypes.NewValue(tftypes.String, "password"),
-					"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-					"token":      tftypes.NewValue(tftypes.String, ""),
+					"hostname":       tftypes.NewValue(tftypes.String, "hostname"),
+					"username":       tftypes.NewValue(tftypes.String, "username"),
+					"password":       tftypes.NewValue(tftypes.String, "password"),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, ""),
+					"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 				},
 				errLen: 0,
 			},
 			{
 				in: map[string]tftypes.Value{
-					"hostname":   tftypes.NewValue(tftypes.String, "hostname"),
-					"username":   tftypes.NewValue(tftypes.String, "username"),
-					"password":   tftypes.NewValue(tftypes.String, "password"),
-					"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-					"token":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"hostname":       tftypes.NewValue(tftypes.String, "hostname"),
+					"username":       tftypes.NewValue(tftypes.String, "username"),
+					"password":       tftypes.NewValue(tftypes.String, "password"),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 				},
 				errLen: 0,
 			},
 			{
 				in: map[string]tftypes.Value{
-					"hostname":   tftypes.NewValue(tftypes.String, "hostname"),
-					"username":   tftypes.NewValue(tftypes.String, "username"),
-					"password":   tftypes.NewValue(tftypes.String, "password"),
-					"verify_ssl": tftypes.NewValue(tftypes.Bool, true),
-					"token":      tftypes.NewValue(tftypes.String, "token"),
+					"hostname":       tftypes.NewValue(tftypes.String, "hostname"),
+					"username":       tftypes.NewValue(tftypes.String, "username"),
+					"password":       tftypes.NewValue(tftypes.String, "password"),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, "token"),
+					"max_retries":    tftypes.NewValue(tftypes.Number, nil),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, nil),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
 				},
 				errLen:     1,
 				errSummary: []string{`must provide one of ["username", "password"] or "token".`},
 			},
+			{
+				in: map[string]tftypes.Value{
+					"hostname":       tftypes.NewValue(tftypes.String, "hostname"),
+					"username":       tftypes.NewValue(tftypes.String, "username"),
+					"password":       tftypes.NewValue(tftypes.String, "password"),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"max_retries":    tftypes.NewValue(tftypes.Number, 5),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, "fast"),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, nil),
+				},
+				errLen:     1,
+				errSummary: []string{"Invalid retry wait"},
+			},
+			{
+				in: map[string]tftypes.Value{
+					"hostname":       tftypes.NewValue(tftypes.String, "hostname"),
+					"username":       tftypes.NewValue(tftypes.String, "username"),
+					"password":       tftypes.NewValue(tftypes.String, "password"),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"max_retries":    tftypes.NewValue(tftypes.Number, 5),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, "10s"),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, "1s"),
+				},
+				errLen:     1,
+				errSummary: []string{"Invalid retry wait"},
+			},
+			{
+				in: map[string]tftypes.Value{
+					"hostname":       tftypes.NewValue(tftypes.String, "hostname"),
+					"username":       tftypes.NewValue(tftypes.String, "username"),
+					"password":       tftypes.NewValue(tftypes.String, "password"),
+					"verify_ssl":     tftypes.NewValue(tftypes.Bool, true),
+					"token":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"max_retries":    tftypes.NewValue(tftypes.Number, 5),
+					"retry_wait_min": tftypes.NewValue(tftypes.String, "500ms"),
+					"retry_wait_max": tftypes.NewValue(tftypes.String, "5s"),
+				},
+				errLen: 0,
+			},
 		}
 
 		for _, test := range tests {
@@ -84,11 +84,11 @@ resource "awx_credential_input_source" "gitlab" {
   input_field_name  = "ssh_key_data"
   target_credential = awx_credential.gitlab.id
   source_credential = awx_credential.vault.id
-  metadata = jsonencode({
+  metadata = {
     "auth_path"      = ""
     "secret_key"     = "private_key"
     "secret_path"    = "secrets/keys/awx-gitlab"
     "secret_backend" = "secrets"
     "secret_version" = ""
-  })
+  }
 }
@@ -22,6 +22,7 @@ var templateCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) (err error) {
 		var tpl *template.Template
 		var deprecated internal.Deprecated
+		var externalCredentialTypes []internal.Item
 
 		var configResource, apiResourcePath, resourcePath string
 		apiResourcePath = args[0]
@@ -72,6 +73,9 @@ var templateCmd = &cobra.Command{
 					if err = internal.GenerateCredentialTypeTfDefinition(tpl, cfg, item, resourcePath, payload); err != nil {
 						return err
 					}
+					if payload["kind"] == "external" {
+						externalCredentialTypes = append(externalCredentialTypes, item)
+					}
 					continue
 				}
 
@@ -79,10 +83,23 @@ var templateCmd = &cobra.Command{
 					cfg.GeneratedApiResources = append(cfg.GeneratedApiResources, item.Name)
 					for _, adg := range item.AssociateDisassociateGroups {
 						cfg.GeneratedApiResources = append(cfg.GeneratedApiResources, fmt.Sprintf("%sAssociateDisassociate%s", item.Name, adg.Type))
+						cfg.GeneratedApiResources = append(cfg.GeneratedApiResources, fmt.Sprintf("%s%ss", item.Name, adg.Type))
 					}
 					if item.HasSurveySpec {
 						cfg.GeneratedApiResources = append(cfg.GeneratedApiResources, fmt.Sprintf("%sSurvey", item.Name))
 					}
+					if item.HasWorkflowGraph {
+						cfg.GeneratedApiResources = append(cfg.GeneratedApiResources, fmt.Sprintf("%sGraph", item.Name))
+					}
+					if item.HasApprovalTemplate {
+						cfg.GeneratedApiResources = append(cfg.GeneratedApiResources, fmt.Sprintf("%sApprovalTemplate", item.Name))
+					}
+					if item.HasMembership {
+						cfg.GeneratedApiResources = append(cfg.GeneratedApiResources, fmt.Sprintf("%sMembership", item.Name))
+					}
+					if item.Launch != nil {
+						cfg.GeneratedApiResources = append(cfg.GeneratedApiResources, fmt.Sprintf("%sLaunch", item.Name))
+					}
 				}
 
 				if !item.NoTerraformDataSource {
@@ -90,6 +107,13 @@ var templateCmd = &cobra.Command{
 					if item.HasObjectRoles {
 						cfg.GeneratedDataSourceResources = append(cfg.GeneratedDataSourceResources, fmt.Sprintf("%sObjectRoles", item.Name))
 					}
+					if item.ListDataSourceTypeName() != "" {
+						cfg.GeneratedDataSourceResources = append(cfg.GeneratedDataSourceResources, fmt.Sprintf("%sList", item.Name))
+					}
+				}
+
+				if item.HasObjectRoles {
+					cfg.RoleGrantObjects = append(cfg.RoleGrantObjects, internal.RoleGrantObject{TypeName: item.RoleGrantObjectTypeName(), Endpoint: item.Endpoint})
 				}
 
 				if objmap, ok := apiResource.Resources[item.Name]; ok {
@@ -132,6 +156,19 @@ var templateCmd = &cobra.Command{
 			}
 		}
 
+		if len(externalCredentialTypes) > 0 {
+			if err = internal.GenerateCredentialInputMetadata(tpl, cfg, resourcePath, externalCredentialTypes, apiResource.CredentialTypes); err != nil {
+				return err
+			}
+		}
+
+		if len(cfg.RoleGrantObjects) > 0 {
+			if err = internal.GenerateRoleGrantForProvider(tpl, cfg, resourcePath, cfg.RoleGrantObjects); err != nil {
+				return err
+			}
+			cfg.GeneratedApiResources = append(cfg.GeneratedApiResources, "RoleGrant")
+		}
+
 		return internal.GenerateApiSourcesForProvider(tpl, cfg, resourcePath, cfg.GeneratedApiResources, cfg.GeneratedDataSourceResources)
 	},
 }
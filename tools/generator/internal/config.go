@@ -3,6 +3,8 @@ package internal
 import (
 	"encoding/json"
 	"os"
+	"path"
+	"strings"
 )
 
 type PropertyOverride struct {
@@ -23,6 +25,26 @@ type PropertyOverride struct {
 	// the server keeps its existing default, causing "Provider produced
 	// inconsistent result after apply".
 	OmitEmpty *bool `json:"omit_empty,omitempty" yaml:"omit_empty,omitempty"`
+	// RequiresReplace forces a replacement of the resource when the value
+	// changes, for fields AWX does not allow to update.
+	RequiresReplace bool `json:"requires_replace,omitempty" yaml:"requires_replace,omitempty"`
+	// ObjectAttributes is the prefix of the generated Go symbols describing
+	// the attributes of an "object" property: <prefix>AttrTypes,
+	// <prefix>ResourceAttributes() and <prefix>DataSourceAttributes(), e.g.
+	// "credentialInputMetadata" for the metadata of credential input sources.
+	ObjectAttributes string `json:"object_attributes,omitempty" yaml:"object_attributes,omitempty"`
+	// JsonAttribute adds a Terraform-only string attribute next to an
+	// "object" property whose object attributes are one nested object per
+	// variant. It holds the value as raw JSON when no variant describes it,
+	// e.g. "metadata_json" for the metadata of credential input sources.
+	JsonAttribute *JsonAttribute `json:"json_attribute,omitempty" yaml:"json_attribute,omitempty"`
+}
+
+// JsonAttribute is the raw JSON attribute of an "object" property, see
+// PropertyOverride.JsonAttribute.
+type JsonAttribute struct {
+	Name        string `json:"name" yaml:"name"`
+	Description string `json:"description" yaml:"description"`
 }
 
 type SearchField struct {
@@ -35,6 +57,12 @@ type SearchGroup struct {
 	Name            string        `json:"name" yaml:"name"`
 	Fields          []SearchField `json:"fields" yaml:"fields"`
 	MultipleResults bool          `json:"multiple_results,omitempty" yaml:"multiple_results"`
+	// NamedURL marks a group that looks the object up by its AWX named URL.
+	// UrlSuffix joins the fields with the separators of the named URL format,
+	// e.g. "%s++%s/" for <name>++<organization.name>. Fields that are not
+	// attributes of the model (e.g. organization_name) are added to the data
+	// source as search-only attributes.
+	NamedURL bool `json:"named_url,omitempty" yaml:"named_url"`
 }
 
 type AssociateDisassociateGroup struct {
@@ -42,6 +70,12 @@ type AssociateDisassociateGroup struct {
 	Endpoint      string `json:"endpoint" yaml:"endpoint"`
 	Type          string `json:"type" yaml:"type"`
 	AssociateType string `json:"associate_type" yaml:"associate_type"`
+	// Ordered generates the authoritative resource with a list of child IDs,
+	// for sub-collections where AWX keeps the association order.
+	Ordered bool `json:"ordered,omitempty" yaml:"ordered"`
+	// IdsAttribute overrides the name of the child IDs attribute of the
+	// authoritative resource, which defaults to <type>_ids.
+	IdsAttribute string `json:"ids_attribute,omitempty" yaml:"ids_attribute"`
 }
 
 func (a AssociateDisassociateGroup) Map(deprecated bool) map[string]any {
@@ -50,6 +84,8 @@ func (a AssociateDisassociateGroup) Map(deprecated bool) map[string]any {
 		"Endpoint":      a.Endpoint,
 		"Type":          a.Type,
 		"AssociateType": a.AssociateType,
+		"Ordered":       a.Ordered,
+		"IdsAttribute":  a.IdsAttribute,
 		"Deprecated":    deprecated,
 	}
 }
@@ -70,6 +106,10 @@ type Item struct {
 	Enabled                     bool                         `json:"enabled" yaml:"enabled"`
 	HasObjectRoles              bool                         `json:"has_object_roles" yaml:"has_object_roles"`
 	HasSurveySpec               bool                         `json:"has_survey_spec" yaml:"has_survey_spec"`
+	HasWorkflowGraph            bool                         `json:"has_workflow_graph" yaml:"has_workflow_graph"`
+	HasApprovalTemplate         bool                         `json:"has_approval_template" yaml:"has_approval_template"`
+	HasMembership               bool                         `json:"has_membership" yaml:"has_membership"`
+	Launch                      *LaunchConfig                `json:"launch,omitempty" yaml:"launch"`
 	AssociateDisassociateGroups []AssociateDisassociateGroup `json:"associate_disassociate_groups" yaml:"associate_disassociate_groups"`
 	FieldConstraints            []FieldConstraint            `json:"field_constraints" yaml:"field_constraints"`
 	SkipWriteOnly               bool                         `json:"skip_write_only" yaml:"skip_write_only"`
@@ -88,6 +128,37 @@ type Item struct {
 	CredentialTypes             []CredentialTypes            `json:"credential_types" yaml:"credential_types"`
 	WaitLifecycle               *WaitLifecycleConfig         `json:"wait_lifecycle,omitempty" yaml:"wait_lifecycle,omitempty"`
 
+	// Immutable marks an object AWX cannot update (no PUT/PATCH), e.g. a role
+	// assignment. Every argument of the resource forces a replacement and an
+	// update only stores the Terraform-only attributes.
+	Immutable bool `json:"immutable,omitempty" yaml:"immutable,omitempty"`
+
+	// PlanValidatorFunction names a Go function checking a planned create or
+	// update against the AWX API, see framework.ResourceCfg.ValidatePlan.
+	PlanValidatorFunction string `json:"plan_validator_function,omitempty" yaml:"plan_validator_function,omitempty"`
+
+	// SchemaVersion is the version of the resource schema, bumped when an
+	// attribute changes shape. Prior states are upgraded by reading them like
+	// an API response, after decoding the StateUpgradeJsonFields that a prior
+	// version held as a JSON string.
+	SchemaVersion          int64    `json:"schema_version,omitempty" yaml:"schema_version,omitempty"`
+	StateUpgradeJsonFields []string `json:"state_upgrade_json_fields,omitempty" yaml:"state_upgrade_json_fields,omitempty"`
+
+	// ImportIdFields names the fields of the `value/value` import ID form,
+	// e.g. ["workflow_job_template", "identifier"] for `12/deploy`. One of
+	// the search groups must cover them.
+	ImportIdFields []string `json:"import_id_fields,omitempty" yaml:"import_id_fields,omitempty"`
+
+	// ListTypeName overrides the type name of the plural data source that
+	// lists every object of the collection (e.g. "hosts" for awx_hosts). It
+	// defaults to the last segment of Endpoint.
+	ListTypeName string `json:"list_type_name,omitempty" yaml:"list_type_name,omitempty"`
+
+	// RoleGrantObjectType overrides the object_type of awx_role_grant for the
+	// item, singular like the other object types (e.g. "constructed_inventory"
+	// for awx_constructed_inventories). It defaults to TypeName.
+	RoleGrantObjectType string `json:"role_grant_object_type,omitempty" yaml:"role_grant_object_type,omitempty"`
+
 	// CredentialType, when non-empty, marks this item as a typed credential
 	// resource generated from resources/api/<VERSION>/payload/credential_type_<value>.json
 	// rather than from the regular API actions metadata. The value is the
@@ -96,6 +167,27 @@ type Item struct {
 	CredentialType string `json:"credential_type,omitempty" yaml:"credential_type,omitempty"`
 }
 
+// ListDataSourceTypeName returns the type name of the plural data source for
+// the item, or an empty string when the item has no collection to list.
+func (i Item) ListDataSourceTypeName() string {
+	if !i.Enabled || i.NoId || i.NoTerraformDataSource || IsCredentialTypeItem(i) {
+		return ""
+	}
+	if i.ListTypeName != "" {
+		return i.ListTypeName
+	}
+	return path.Base(strings.TrimSuffix(i.Endpoint, "/"))
+}
+
+// RoleGrantObjectTypeName returns the object_type of awx_role_grant for the
+// item.
+func (i Item) RoleGrantObjectTypeName() string {
+	if i.RoleGrantObjectType != "" {
+		return i.RoleGrantObjectType
+	}
+	return i.TypeName
+}
+
 // WaitLifecycleConfig opts a resource into post-Create/Update polling. The
 // generator emits a Terraform-only bool toggle (WaitAttribute), a timeouts
 // block, and the WaitLifecycle wiring on the generated resource so the
@@ -118,6 +210,93 @@ type WaitLifecycleConfig struct {
 	DefaultTimeout string `json:"default_timeout" yaml:"default_timeout"`
 	// PollInterval between status reads. Go duration string.
 	PollInterval string `json:"poll_interval" yaml:"poll_interval"`
+	// RefreshAfterWait re-reads the resource once the wait succeeded, so the
+	// terminal status and the other fields set by the run land in state.
+	RefreshAfterWait bool `json:"refresh_after_wait" yaml:"refresh_after_wait"`
+	// Output captures the plain text output of the run once the wait succeeded.
+	Output *WaitOutputConfig `json:"output,omitempty" yaml:"output,omitempty"`
+	// Launch starts a job for the resource and polls that job instead.
+	Launch *WaitLaunchConfig `json:"launch,omitempty" yaml:"launch,omitempty"`
+	// UnknownAfterWait are API fields the wait changes (e.g. "scm_revision").
+	// They are planned as unknown when an update is going to wait, and keep
+	// their prior value when it only launches a job.
+	UnknownAfterWait []string `json:"unknown_after_wait,omitempty" yaml:"unknown_after_wait,omitempty"`
+}
+
+// WaitLaunchConfig makes the wait lifecycle launch a job for the resource,
+// e.g. an inventory source update, and poll it. The generator emits computed
+// attributes holding the ID and the status of the last launched job, and one
+// per entry of Counts.
+//
+// Without TriggersAttribute the job is launched whenever the plan waits. With
+// it, the generator emits a Terraform-only map attribute instead, and the job
+// is launched on the updates that change it.
+type WaitLaunchConfig struct {
+	// TriggersAttribute is the schema attribute name of the triggers map (e.g. "sync_triggers").
+	TriggersAttribute string `json:"triggers_attribute,omitempty" yaml:"triggers_attribute,omitempty"`
+	// TriggersDescription is the schema attribute description of the triggers map.
+	TriggersDescription string `json:"triggers_description,omitempty" yaml:"triggers_description,omitempty"`
+	// EndpointSuffix is appended to the resource's base endpoint with the ID
+	// substituted via Sprintf (e.g. "%d/update/").
+	EndpointSuffix string `json:"endpoint_suffix" yaml:"endpoint_suffix"`
+	// IDField is the field of the launch response holding the job ID (e.g. "inventory_update").
+	IDField string `json:"id_field" yaml:"id_field"`
+	// JobEndpoint is the endpoint of the launched jobs (e.g. "/api/v2/inventory_updates/").
+	JobEndpoint string `json:"job_endpoint" yaml:"job_endpoint"`
+	// IDAttribute is the schema attribute name of the job ID (e.g.
+	// "last_update_id"). Empty → the job is not recorded.
+	IDAttribute string `json:"id_attribute,omitempty" yaml:"id_attribute,omitempty"`
+	// IDDescription is the schema attribute description of the job ID.
+	IDDescription string `json:"id_description,omitempty" yaml:"id_description,omitempty"`
+	// StatusAttribute is the schema attribute name of the job status (e.g.
+	// "last_update_status"). Set together with IDAttribute.
+	StatusAttribute string `json:"status_attribute,omitempty" yaml:"status_attribute,omitempty"`
+	// StatusDescription is the schema attribute description of the job status.
+	StatusDescription string `json:"status_description,omitempty" yaml:"status_description,omitempty"`
+	// Counts are read once the job succeeded.
+	Counts []WaitCountConfig `json:"counts,omitempty" yaml:"counts,omitempty"`
+}
+
+// WaitCountConfig emits a computed attribute holding the number of objects on
+// a list endpoint of the resource.
+type WaitCountConfig struct {
+	// Attribute is the schema attribute name (e.g. "last_update_host_count").
+	Attribute string `json:"attribute" yaml:"attribute"`
+	// Description is the schema attribute description.
+	Description string `json:"description" yaml:"description"`
+	// EndpointSuffix is appended to the resource's base endpoint with the ID
+	// substituted via Sprintf (e.g. "%d/hosts/").
+	EndpointSuffix string `json:"endpoint_suffix" yaml:"endpoint_suffix"`
+}
+
+// WaitOutputConfig emits a computed attribute (Attribute) holding the plain
+// text output of the run, and an attribute (MaxBytesAttribute) limiting its
+// size in state.
+type WaitOutputConfig struct {
+	// Attribute is the schema attribute name (e.g. "stdout").
+	Attribute string `json:"attribute" yaml:"attribute"`
+	// Description is the schema attribute description.
+	Description string `json:"description" yaml:"description"`
+	// MaxBytesAttribute is the schema attribute name of the size limit (e.g. "stdout_max_bytes").
+	MaxBytesAttribute string `json:"max_bytes_attribute" yaml:"max_bytes_attribute"`
+	// MaxBytesDescription is the schema attribute description of the size limit.
+	MaxBytesDescription string `json:"max_bytes_description" yaml:"max_bytes_description"`
+	// DefaultMaxBytes is the size limit when the user doesn't set one.
+	DefaultMaxBytes int64 `json:"default_max_bytes" yaml:"default_max_bytes"`
+	// EndpointSuffix is appended to the resource's base endpoint with the ID
+	// substituted via Sprintf (e.g. "%d/stdout/?format=txt_download").
+	EndpointSuffix string `json:"endpoint_suffix" yaml:"endpoint_suffix"`
+}
+
+// LaunchConfig opts a template into a resource that launches it. The
+// generator emits a wrapper around the framework constructor.
+type LaunchConfig struct {
+	// TypeName is the resource type name (e.g. "job_launch").
+	TypeName string `json:"type_name" yaml:"type_name"`
+	// JobEndpoint is the endpoint of the launched jobs (e.g. "/api/v2/jobs/").
+	JobEndpoint string `json:"job_endpoint" yaml:"job_endpoint"`
+	// Constructor is the framework constructor (e.g. "NewJobLaunchResource").
+	Constructor string `json:"constructor" yaml:"constructor"`
 }
 
 type CredentialTypes struct {
@@ -128,13 +307,14 @@ type CredentialTypes struct {
 }
 
 type Config struct {
-	DefaultRemoveApiDataSource   []string `json:"default_remove_api_data_source"`
-	DefaultRemoveApiResource     []string `json:"default_remove_api_resource"`
-	Items                        []Item   `json:"items"`
-	ApiVersion                   string   `json:"api_version"`
-	RenderApiDocs                bool     `json:"render_api_docs"`
-	GeneratedApiResources        []string `json:"-"`
-	GeneratedDataSourceResources []string `json:"-"`
+	DefaultRemoveApiDataSource   []string          `json:"default_remove_api_data_source"`
+	DefaultRemoveApiResource     []string          `json:"default_remove_api_resource"`
+	Items                        []Item            `json:"items"`
+	ApiVersion                   string            `json:"api_version"`
+	RenderApiDocs                bool              `json:"render_api_docs"`
+	GeneratedApiResources        []string          `json:"-"`
+	GeneratedDataSourceResources []string          `json:"-"`
+	RoleGrantObjects             []RoleGrantObject `json:"-"`
 }
 
 func (c *Config) PackageName(name string) string {
@@ -124,6 +124,30 @@ func GenerateApiTfDefinition(tpl *template.Template, config Config, val Item, re
 			Render:   item.HasSurveySpec,
 			IsNew:    true,
 		},
+		{
+			Filename: fmt.Sprintf("%s/gen_obj_%s_graph.go", resourcePath, strings.ToLower(val.TypeName)),
+			Template: "tf_workflow_graph.go.tpl",
+			Render:   item.HasWorkflowGraph,
+			IsNew:    true,
+		},
+		{
+			Filename: fmt.Sprintf("%s/gen_obj_%s_approval_template.go", resourcePath, strings.ToLower(val.TypeName)),
+			Template: "tf_approval_template.go.tpl",
+			Render:   item.HasApprovalTemplate,
+			IsNew:    true,
+		},
+		{
+			Filename: fmt.Sprintf("%s/gen_obj_%s_membership.go", resourcePath, strings.ToLower(val.TypeName)),
+			Template: "tf_membership.go.tpl",
+			Render:   item.HasMembership,
+			IsNew:    true,
+		},
+		{
+			Filename: fmt.Sprintf("%s/gen_obj_%s_launch.go", resourcePath, strings.ToLower(val.TypeName)),
+			Template: "tf_launch.go.tpl",
+			Render:   item.Launch != nil,
+			IsNew:    true,
+		},
 		{
 			Filename: fmt.Sprintf("resources/api/%s/docs/%s.md", config.ApiVersion, strings.ToLower(val.TypeName)),
 			Template: "tf_api_description.md.tpl",
@@ -146,6 +170,20 @@ func GenerateApiTfDefinition(tpl *template.Template, config Config, val Item, re
 			Template: "tf_associate_disassociate.go.tpl",
 			Render:   true,
 			Data:     adg.Map(deprecated),
+		}, struct {
+			Filename string
+			Template string
+			Render   bool
+			IsNew    bool
+			Data     map[string]any
+		}{
+			Filename: fmt.Sprintf("%s/gen_obj_%s_adg_%s_set.go", resourcePath,
+				strings.ToLower(val.TypeName), strings.ToLower(adg.Type)),
+			Template: "tf_associate_set.go.tpl",
+			Render:   true,
+			// The authoritative variant replaces the pair resources, it is
+			// only deprecated with the legacy roles it manages.
+			Data: adg.Map(deprecated && adg.Type == "Role"),
 		})
 
 		if deprecated && val.Enabled {
@@ -158,6 +196,14 @@ func GenerateApiTfDefinition(tpl *template.Template, config Config, val Item, re
 					), '_',
 				),
 			)
+			if adg.Type == "Role" {
+				dr.Resources = append(
+					dr.Resources,
+					strcase.ToDelimited(
+						fmt.Sprintf("%s%ss", strcase.ToLowerCamel(adg.Name), adg.Type), '_',
+					),
+				)
+			}
 			dr.DataSources = append(
 				dr.DataSources,
 				strcase.ToDelimited(
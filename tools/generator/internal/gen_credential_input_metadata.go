@@ -0,0 +1,100 @@
+package internal
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// CredentialInputMetadataBlock is the nested metadata object of
+// awx_credential_input_source for the lookups of one external credential
+// type.
+type CredentialInputMetadataBlock struct {
+	Name        string // the namespace of the credential type, e.g. hashivault_kv
+	Description string
+	Attributes  []CredentialInputMetadataAttribute
+}
+
+// CredentialInputMetadataAttribute is one lookup metadata of an external
+// credential type.
+type CredentialInputMetadataAttribute struct {
+	ID          string
+	Type        string // "string" or "boolean"
+	Description string
+	Required    bool
+	Choices     []string
+}
+
+// IsBool reports whether the attribute is a boolean.
+func (a CredentialInputMetadataAttribute) IsBool() bool {
+	return a.Type == "boolean"
+}
+
+// BuildCredentialInputMetadata builds a nested metadata object of
+// awx_credential_input_source for every external credential type with lookup
+// metadata, ordered by name. Credential types without a block are covered by
+// the raw JSON metadata_json attribute.
+func BuildCredentialInputMetadata(credentialTypes []*CredentialTypeTplData) ([]CredentialInputMetadataBlock, error) {
+	sort.Slice(credentialTypes, func(i, j int) bool { return credentialTypes[i].Namespace < credentialTypes[j].Namespace })
+
+	out := make([]CredentialInputMetadataBlock, 0, len(credentialTypes))
+	for _, ct := range credentialTypes {
+		if len(ct.Metadata) == 0 {
+			continue
+		}
+		if ct.Namespace == "" {
+			return nil, fmt.Errorf("credential type %s has no namespace", ct.TypeName)
+		}
+		block := CredentialInputMetadataBlock{
+			Name:        ct.Namespace,
+			Description: fmt.Sprintf("The lookup metadata for a source credential of the %q credential type, see `awx_%s`.", ct.DisplayName, ct.TypeName),
+		}
+		for _, f := range ct.Metadata {
+			if f.Type != "string" && f.Type != "boolean" {
+				return nil, fmt.Errorf("metadata %q of %s is a %s, only string and boolean are supported", f.ID, ct.TypeName, f.Type)
+			}
+			text := strings.TrimSpace(f.HelpText)
+			if text == "" {
+				text = f.Label
+			}
+			block.Attributes = append(block.Attributes, CredentialInputMetadataAttribute{
+				ID:          f.ID,
+				Type:        f.Type,
+				Description: strings.TrimSuffix(text, ".") + ".",
+				Required:    f.Required,
+				Choices:     f.Choices,
+			})
+		}
+		sort.Slice(block.Attributes, func(i, j int) bool { return block.Attributes[i].ID < block.Attributes[j].ID })
+		out = append(out, block)
+	}
+	return out, nil
+}
+
+// GenerateCredentialInputMetadata renders the nested metadata objects of
+// awx_credential_input_source from the external credential type items. The
+// credential input source config refers to them through the
+// "credentialInputMetadata" object attributes of its metadata property.
+func GenerateCredentialInputMetadata(tpl *template.Template, config Config, resourcePath string, items []Item, payloads map[string]map[string]any) error {
+	credentialTypes := make([]*CredentialTypeTplData, 0, len(items))
+	for _, item := range items {
+		data, err := buildCredentialTypeTplData(config, item, payloads[item.CredentialType])
+		if err != nil {
+			return err
+		}
+		credentialTypes = append(credentialTypes, data)
+	}
+
+	blocks, err := BuildCredentialInputMetadata(credentialTypes)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Generating the credential input source metadata from %d external credential types", len(credentialTypes))
+	return renderTemplate(tpl, fmt.Sprintf("%s/gen_credential_input_metadata.go", resourcePath), "tf_credential_input_metadata.go.tpl", map[string]any{
+		"PackageName": config.PackageName("awx"),
+		"Blocks":      blocks,
+	})
+}
@@ -0,0 +1,97 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildCredentialInputMetadata(t *testing.T) {
+	cfg := Config{ApiVersion: "24.6.1"}
+	var credentialTypes []*CredentialTypeTplData
+	for _, ns := range []string{"hashivault_kv", "conjur", "aim"} {
+		item := Item{Name: ns, TypeName: "credential_" + ns, CredentialType: ns, Enabled: true}
+		data, err := buildCredentialTypeTplData(cfg, item, loadCredentialTypePayload(t, ns))
+		require.NoError(t, err)
+		credentialTypes = append(credentialTypes, data)
+	}
+
+	blocks, err := BuildCredentialInputMetadata(credentialTypes)
+	require.NoError(t, err)
+
+	byName := map[string]CredentialInputMetadataBlock{}
+	names := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		byName[b.Name] = b
+		names = append(names, b.Name)
+	}
+	assert.Equal(t, []string{"aim", "conjur", "hashivault_kv"}, names)
+
+	attrs := func(name string) map[string]CredentialInputMetadataAttribute {
+		out := map[string]CredentialInputMetadataAttribute{}
+		for _, a := range byName[name].Attributes {
+			out[a.ID] = a
+		}
+		return out
+	}
+	aim := attrs("aim")
+	assert.Equal(t, []string{"Exact", "Regexp"}, aim["object_query_format"].Choices)
+	assert.True(t, aim["object_query"].Required)
+	assert.False(t, aim["reason"].Required)
+	assert.Contains(t, byName["aim"].Description, "see `awx_credential_aim`.")
+
+	// secret_path is in both, each type has its own requirements.
+	assert.False(t, attrs("conjur")["secret_path"].Required)
+	assert.True(t, attrs("hashivault_kv")["secret_path"].Required)
+	assert.False(t, attrs("hashivault_kv")["secret_path"].IsBool())
+}
+
+func TestBuildCredentialInputMetadata_Blocks(t *testing.T) {
+	field := func(id, typ string, choices ...string) CredentialTypeField {
+		return CredentialTypeField{ID: id, Label: "Format", Type: typ, Choices: choices}
+	}
+
+	tests := []struct {
+		name      string
+		types     []*CredentialTypeTplData
+		want      []CredentialInputMetadataBlock
+		wantError string
+	}{
+		{
+			name: "types without metadata have no block",
+			types: []*CredentialTypeTplData{
+				{TypeName: "credential_b", Namespace: "b", DisplayName: "B", Metadata: []CredentialTypeField{field("format", "string", "json", "yaml")}},
+				{TypeName: "credential_a", Namespace: "a", DisplayName: "A"},
+			},
+			want: []CredentialInputMetadataBlock{
+				{
+					Name:        "b",
+					Description: "The lookup metadata for a source credential of the \"B\" credential type, see `awx_credential_b`.",
+					Attributes: []CredentialInputMetadataAttribute{
+						{ID: "format", Type: "string", Description: "Format.", Choices: []string{"json", "yaml"}},
+					},
+				},
+			},
+		},
+		{
+			name: "unsupported type",
+			types: []*CredentialTypeTplData{
+				{TypeName: "credential_a", Namespace: "a", Metadata: []CredentialTypeField{field("format", "integer")}},
+			},
+			wantError: `metadata "format" of credential_a is a integer, only string and boolean are supported`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blocks, err := BuildCredentialInputMetadata(tt.types)
+			if tt.wantError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, blocks)
+		})
+	}
+}
@@ -20,7 +20,23 @@ type CredentialTypeField struct {
 	Secret       bool
 	Required     bool
 	Multiline    bool
-	Format       string // e.g. "ssh_private_key"
+	Format       string   // e.g. "ssh_private_key"
+	Type         string   // "string" or "boolean"
+	Default      any      // default value from the spec, nil when there is none
+	Choices      []string // allowed values of a string field, empty when any value goes
+	DependsOn    []string // fields that must be set alongside this one, from inputs.dependencies
+}
+
+// IsBool reports whether the field is a boolean input.
+func (f CredentialTypeField) IsBool() bool {
+	return f.Type == "boolean"
+}
+
+// credentialBaseAttributes are the attributes every typed credential shares,
+// see framework.CredentialBaseResourceAttrs. An input field with the same name
+// would shadow one of them.
+var credentialBaseAttributes = []string{
+	"id", "name", "description", "organization", "team", "user", "kind", "managed", "credential_type",
 }
 
 // CredentialTypeTplData is the template payload for generated typed credential
@@ -35,6 +51,7 @@ type CredentialTypeTplData struct {
 	DisplayName string                // Amazon Web Services
 	Kind        string                // cloud / ssh / vault
 	Fields      []CredentialTypeField // typed inputs from credential_type spec
+	Metadata    []CredentialTypeField // lookup metadata of an external credential type, see awx_credential_input_source
 	HasSecrets  bool
 	Enabled     bool
 }
@@ -98,6 +115,18 @@ func buildCredentialTypeTplData(config Config, item Item, payload map[string]any
 		}
 	}
 
+	dependencies := map[string][]string{}
+	if deps, ok := inputs["dependencies"].(map[string]any); ok {
+		for id, v := range deps {
+			list, _ := v.([]any)
+			for _, d := range list {
+				if s, ok := d.(string); ok {
+					dependencies[id] = append(dependencies[id], s)
+				}
+			}
+		}
+	}
+
 	fieldsAny, ok := inputs["fields"].([]any)
 	if !ok {
 		return nil, fmt.Errorf("credential_type %q has no inputs.fields array", item.CredentialType)
@@ -109,45 +138,111 @@ func buildCredentialTypeTplData(config Config, item Item, payload map[string]any
 		if !ok {
 			continue
 		}
-		id, _ := fm["id"].(string)
-		if id == "" {
-			continue
-		}
-
-		ft := CredentialTypeField{
-			ID:           id,
-			PropertyName: strcase.ToCamel(id),
-			Required:     requiredSet[id],
-		}
-		if v, ok := fm["label"].(string); ok {
-			ft.Label = v
-		}
-		if v, ok := fm["help_text"].(string); ok {
-			ft.HelpText = v
+		ft, err := parseCredentialTypeField(item.CredentialType, fm)
+		if err != nil {
+			return nil, err
 		}
-		if v, ok := fm["secret"].(bool); ok {
-			ft.Secret = v
-		}
-		if v, ok := fm["multiline"].(bool); ok {
-			ft.Multiline = v
+		if ft.ID == "" {
+			continue
 		}
-		if v, ok := fm["format"].(string); ok {
-			ft.Format = v
+		if slices.Contains(credentialBaseAttributes, ft.ID) {
+			return nil, fmt.Errorf("credential_type %q input %q clashes with a base credential attribute", item.CredentialType, ft.ID)
 		}
+		ft.Required = requiredSet[ft.ID]
+		ft.DependsOn = dependencies[ft.ID]
 		if ft.Secret {
 			out.HasSecrets = true
 		}
 		fields = append(fields, ft)
 	}
 
+	metadataAny, _ := inputs["metadata"].([]any)
+	metadata := make([]CredentialTypeField, 0, len(metadataAny))
+	for _, m := range metadataAny {
+		mm, ok := m.(map[string]any)
+		if !ok {
+			continue
+		}
+		mt, err := parseCredentialTypeField(item.CredentialType, mm)
+		if err != nil {
+			return nil, err
+		}
+		if mt.ID == "" {
+			continue
+		}
+		mt.Required = requiredSet[mt.ID]
+		metadata = append(metadata, mt)
+	}
+
 	// Stable order — sorting by ID keeps generated diffs deterministic across
 	// runs even if AWX reshuffles its inputs array.
 	sort.SliceStable(fields, func(i, j int) bool { return fields[i].ID < fields[j].ID })
+	sort.SliceStable(metadata, func(i, j int) bool { return metadata[i].ID < metadata[j].ID })
 	out.Fields = fields
+	out.Metadata = metadata
 
 	return out, nil
 }
 
+// parseCredentialTypeField reads one entry of inputs.fields or inputs.metadata.
+// Entries without a type are strings, as in AWX. An entry without an id is
+// returned as is for the caller to skip.
+func parseCredentialTypeField(namespace string, fm map[string]any) (CredentialTypeField, error) {
+	id, _ := fm["id"].(string)
+	ft := CredentialTypeField{
+		ID:           id,
+		PropertyName: strcase.ToCamel(id),
+		Type:         "string",
+		Default:      fm["default"],
+	}
+	if id == "" {
+		return ft, nil
+	}
+	if v, ok := fm["type"].(string); ok {
+		ft.Type = v
+	}
+	switch ft.Type {
+	case "string":
+		if _, ok := ft.Default.(string); ft.Default != nil && !ok {
+			return ft, fmt.Errorf("credential_type %q input %q has a non-string default", namespace, id)
+		}
+	case "boolean":
+		if _, ok := ft.Default.(bool); ft.Default != nil && !ok {
+			return ft, fmt.Errorf("credential_type %q input %q has a non-boolean default", namespace, id)
+		}
+	default:
+		return ft, fmt.Errorf("credential_type %q input %q has unsupported type %q", namespace, id, ft.Type)
+	}
+	if choices, ok := fm["choices"].([]any); ok {
+		if ft.IsBool() {
+			return ft, fmt.Errorf("credential_type %q input %q has choices on a boolean", namespace, id)
+		}
+		for _, c := range choices {
+			v, ok := c.(string)
+			if !ok {
+				return ft, fmt.Errorf("credential_type %q input %q has a non-string choice", namespace, id)
+			}
+			ft.Choices = append(ft.Choices, v)
+		}
+	}
+	if v, ok := fm["label"].(string); ok {
+		ft.Label = v
+	}
+	if v, ok := fm["help_text"].(string); ok {
+		ft.HelpText = v
+	}
+	if v, ok := fm["secret"].(bool); ok {
+		ft.Secret = v
+	}
+	if v, ok := fm["multiline"].(bool); ok {
+		ft.Multiline = v
+	}
+	if v, ok := fm["format"].(string); ok {
+		ft.Format = v
+	}
+	return ft, nil
+}
+
 // IsCredentialTypeItem reports whether an item should be routed through
 // GenerateCredentialTypeTfDefinition rather than the standard pipeline.
 func IsCredentialTypeItem(item Item) bool {
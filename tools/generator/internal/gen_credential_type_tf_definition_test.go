@@ -125,3 +125,122 @@ func TestBuildCredentialTypeTplData_RequiresInputs(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "no inputs object")
 }
+
+func TestBuildCredentialTypeTplData_Net(t *testing.T) {
+	// Network has a boolean input and an inputs.dependencies entry.
+	cfg := Config{ApiVersion: "24.6.1"}
+	item := Item{
+		Name:           "CredentialNet",
+		TypeName:       "credential_net",
+		Endpoint:       "/api/v2/credentials/",
+		CredentialType: "net",
+		Enabled:        true,
+	}
+
+	data, err := buildCredentialTypeTplData(cfg, item, loadCredentialTypePayload(t, "net"))
+	require.NoError(t, err)
+
+	byID := map[string]CredentialTypeField{}
+	for _, f := range data.Fields {
+		byID[f.ID] = f
+	}
+
+	authorize := byID["authorize"]
+	assert.True(t, authorize.IsBool())
+	assert.Nil(t, authorize.Default)
+
+	authorizePassword := byID["authorize_password"]
+	assert.False(t, authorizePassword.IsBool())
+	assert.True(t, authorizePassword.Secret)
+	assert.Equal(t, []string{"authorize"}, authorizePassword.DependsOn)
+
+	assert.True(t, byID["username"].Required)
+	assert.Empty(t, byID["username"].DependsOn)
+}
+
+func TestBuildCredentialTypeTplData_Defaults(t *testing.T) {
+	cfg := Config{ApiVersion: "24.6.1"}
+	item := Item{
+		Name:           "CredentialRegistry",
+		TypeName:       "credential_registry",
+		Endpoint:       "/api/v2/credentials/",
+		CredentialType: "registry",
+		Enabled:        true,
+	}
+
+	data, err := buildCredentialTypeTplData(cfg, item, loadCredentialTypePayload(t, "registry"))
+	require.NoError(t, err)
+
+	byID := map[string]CredentialTypeField{}
+	for _, f := range data.Fields {
+		byID[f.ID] = f
+	}
+	assert.Equal(t, "quay.io", byID["host"].Default)
+	assert.True(t, byID["host"].Required)
+	assert.Equal(t, true, byID["verify_ssl"].Default)
+	assert.True(t, byID["verify_ssl"].IsBool())
+	assert.Nil(t, byID["username"].Default)
+}
+
+func TestBuildCredentialTypeTplData_InvalidFields(t *testing.T) {
+	cfg := Config{ApiVersion: "24.6.1"}
+	item := Item{Name: "Custom", TypeName: "custom", CredentialType: "custom", Enabled: true}
+
+	tests := []struct {
+		name    string
+		field   map[string]any
+		wantErr string
+	}{
+		{name: "base attribute clash", field: map[string]any{"id": "name", "type": "string"}, wantErr: "clashes with a base credential attribute"},
+		{name: "unsupported type", field: map[string]any{"id": "port", "type": "integer"}, wantErr: `unsupported type "integer"`},
+		{name: "non-boolean default", field: map[string]any{"id": "verify", "type": "boolean", "default": "yes"}, wantErr: "non-boolean default"},
+		{name: "non-string default", field: map[string]any{"id": "host", "type": "string", "default": true}, wantErr: "non-string default"},
+		{name: "choices on a boolean", field: map[string]any{"id": "verify", "type": "boolean", "choices": []any{"yes"}}, wantErr: "choices on a boolean"},
+		{name: "non-string choice", field: map[string]any{"id": "tld", "choices": []any{1}}, wantErr: "non-string choice"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			payload := map[string]any{"inputs": map[string]any{"fields": []any{tt.field}}}
+			_, err := buildCredentialTypeTplData(cfg, item, payload)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestBuildCredentialTypeTplData_External(t *testing.T) {
+	cfg := Config{ApiVersion: "24.6.1"}
+	item := Item{
+		Name:           "CredentialHashivaultKv",
+		TypeName:       "credential_hashivault_kv",
+		Endpoint:       "/api/v2/credentials/",
+		CredentialType: "hashivault_kv",
+		Enabled:        true,
+	}
+
+	data, err := buildCredentialTypeTplData(cfg, item, loadCredentialTypePayload(t, "hashivault_kv"))
+	require.NoError(t, err)
+	assert.Equal(t, "external", data.Kind)
+
+	byID := map[string]CredentialTypeField{}
+	for _, f := range data.Fields {
+		byID[f.ID] = f
+	}
+	// api_version has choices but no type, AWX treats it as a string.
+	assert.Equal(t, "string", byID["api_version"].Type)
+	assert.Equal(t, []string{"v1", "v2"}, byID["api_version"].Choices)
+	assert.Equal(t, "v1", byID["api_version"].Default)
+	assert.True(t, byID["url"].Required)
+	assert.Empty(t, byID["url"].Choices)
+
+	ids := make([]string, 0, len(data.Metadata))
+	required := map[string]bool{}
+	for _, m := range data.Metadata {
+		ids = append(ids, m.ID)
+		required[m.ID] = m.Required
+	}
+	assert.Equal(t, []string{"auth_path", "secret_backend", "secret_key", "secret_path", "secret_version"}, ids)
+	assert.True(t, required["secret_path"])
+	assert.False(t, required["secret_version"])
+}
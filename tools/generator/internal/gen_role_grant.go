@@ -0,0 +1,24 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+	"text/template"
+)
+
+// RoleGrantObject is an object type a role can be granted on through the
+// role grant resource.
+type RoleGrantObject struct {
+	TypeName string
+	Endpoint string
+}
+
+// GenerateRoleGrantForProvider renders the role grant resource covering every
+// object type with object roles.
+func GenerateRoleGrantForProvider(tpl *template.Template, config Config, resourcePath string, objects []RoleGrantObject) error {
+	sort.Slice(objects, func(i, j int) bool { return objects[i].TypeName < objects[j].TypeName })
+	return renderTemplate(tpl, fmt.Sprintf("%s/gen_role_grant.go", resourcePath), "tf_role_grant.go.tpl", map[string]any{
+		"PackageName": config.PackageName("awx"),
+		"Objects":     objects,
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"os"
 	p "path"
+	"slices"
 	"strconv"
 	"strings"
 	"text/template"
@@ -27,35 +28,40 @@ func generateAttributeValidationData(fields []SearchGroup, needle string) (attrs
 	}
 
 	if hasMultiFieldSearch {
-		var sourceFieldName string
-		// 1. Find the one this needle belongs to and create a AlsoRequires for all of them
+		// 1. The groups the needle belongs to; a field can be part of several
+		// (e.g. name in both by_name and by_named_url).
+		var groups []SearchGroup
 		for _, field := range fields {
-			fieldHasKey := false
-			for _, v := range field.Fields {
-				fieldHasKey = v.Name == needle
-				if fieldHasKey {
-					sourceFieldName = field.Name
-					break
-				}
+			if slices.ContainsFunc(field.Fields, func(v SearchField) bool { return v.Name == needle }) {
+				groups = append(groups, field)
 			}
+		}
 
-			if fieldHasKey {
-				for _, v := range field.Fields {
-					if v.Name == needle {
-						continue
-					}
+		// 2. AlsoRequires for the fields shared by every one of those groups
+		if len(groups) > 0 {
+			for _, v := range groups[0].Fields {
+				if v.Name == needle || slices.Contains(attrs["AlsoRequires"], v.Name) {
+					continue
+				}
+				inAll := true
+				for _, group := range groups[1:] {
+					inAll = inAll && slices.ContainsFunc(group.Fields, func(f SearchField) bool { return f.Name == v.Name })
+				}
+				if inAll {
 					attrs["AlsoRequires"] = append(attrs["AlsoRequires"], v.Name)
 				}
 			}
 		}
 
-		// 2. Add all the other fields as Conflicts With
+		// 3. Add all the fields that can not be used together with the needle as Conflicts With
 		for _, field := range fields {
-			if sourceFieldName == field.Name {
-				continue
-			}
 			for _, v := range field.Fields {
-				attrs["ConflictsWith"] = append(attrs["ConflictsWith"], v.Name)
+				usable := slices.ContainsFunc(groups, func(group SearchGroup) bool {
+					return slices.ContainsFunc(group.Fields, func(f SearchField) bool { return f.Name == v.Name })
+				})
+				if !usable && !slices.Contains(attrs["ConflictsWith"], v.Name) {
+					attrs["ConflictsWith"] = append(attrs["ConflictsWith"], v.Name)
+				}
 			}
 		}
 	} else {
@@ -123,6 +129,10 @@ func awxGoValue(t string) string {
 		return "types.BoolValue"
 	case "list":
 		return "types.ListValueMust(types.StringType, val.Elements())"
+	case "set":
+		return "types.SetValueMust(types.StringType, val.Elements())"
+	case "object":
+		return "types.ObjectValue"
 	}
 	return t
 }
@@ -139,6 +149,10 @@ func awxGoType(t string) string {
 		return "types.Bool"
 	case "list":
 		return "types.List"
+	case "set":
+		return "types.Set"
+	case "object":
+		return "types.Object"
 	}
 	return t
 }
@@ -160,9 +174,9 @@ func awxPrimitiveType(t string) string {
 		return "string"
 	case "boolean", "bool":
 		return "bool"
-	case "list":
+	case "list", "set":
 		return "[]string"
-	case "nested object":
+	case "nested object", "object":
 		return "map[string]any"
 	}
 	return t
@@ -180,6 +194,10 @@ func tfAttributeType(t string) string {
 		return "Bool"
 	case "list":
 		return "List"
+	case "set":
+		return "Set"
+	case "object":
+		return "Object"
 	}
 	return t
 }
@@ -197,8 +215,10 @@ func tfGoPrimitiveValue(t string, postWrap bool) string {
 		return "ValueString"
 	case "boolean", "bool":
 		return "ValueBool"
-	case "list":
+	case "list", "set":
 		return "Elements"
+	case "object":
+		return "Attributes"
 	}
 	return t
 }
@@ -6,6 +6,7 @@ import (
 	"slices"
 	"strings"
 
+	"github.com/iancoleman/strcase"
 	"github.com/mitchellh/mapstructure"
 )
 
@@ -18,6 +19,10 @@ type ModelConfig struct {
 	Description                 string                       `json:"description" yaml:"description"`
 	HasObjectRoles              bool                         `json:"has_object_roles" yaml:"has_object_roles"`
 	HasSurveySpec               bool                         `json:"has_survey_spec" yaml:"has_survey_spec"`
+	HasWorkflowGraph            bool                         `json:"has_workflow_graph" yaml:"has_workflow_graph"`
+	HasApprovalTemplate         bool                         `json:"has_approval_template" yaml:"has_approval_template"`
+	HasMembership               bool                         `json:"has_membership" yaml:"has_membership"`
+	Launch                      *LaunchConfig                `json:"launch,omitempty" yaml:"launch"`
 	RenderApiDocs               bool                         `json:"render_api_docs" yaml:"render_api_docs"`
 	NoTerraformDataSource       bool                         `json:"no_terraform_data_source" yaml:"no_terraform_data_source"`
 	NoTerraformResource         bool                         `json:"no_terraform_resource" yaml:"no_terraform_resource"`
@@ -32,6 +37,7 @@ type ModelConfig struct {
 	IdProperty                  *Property                    `json:"id_property" yaml:"id_property"`
 	IdKey                       string                       `json:"id_key" yaml:"id_key"`
 	UnDeletable                 bool                         `json:"un_deletable" yaml:"un_deletable"`
+	Immutable                   bool                         `json:"immutable" yaml:"immutable"`
 	PreStateSetHookFunction     string                       `json:"pre_state_set_hook_function" yaml:"pre_state_set_hook_function"`
 	FieldConstraints            []FieldConstraint            `json:"field_constraints" yaml:"field_constraints" mapstructure:"field_constraints"`
 	AssociateDisassociateGroups []AssociateDisassociateGroup `json:"associate_disassociate_groups" yaml:"associate_disassociate_groups"`
@@ -41,6 +47,19 @@ type ModelConfig struct {
 	DeprecatedReadProperties    []string                     `json:"deprecated_read_properties" yaml:"deprecated_read_properties"`
 	DeprecatedWriteProperties   []string                     `json:"deprecated_write_properties" yaml:"deprecated_write_properties"`
 	WaitLifecycle               *WaitLifecycleConfig         `json:"wait_lifecycle,omitempty" yaml:"wait_lifecycle,omitempty"`
+	ListTypeName                string                       `json:"list_type_name" yaml:"list_type_name"`
+	SearchOnlyFields            []SearchOnlyField            `json:"search_only_fields" yaml:"search_only_fields"`
+	ImportIdFields              []string                     `json:"import_id_fields" yaml:"import_id_fields"`
+	PlanValidatorFunction       string                       `json:"plan_validator_function" yaml:"plan_validator_function"`
+	SchemaVersion               int64                        `json:"schema_version" yaml:"schema_version"`
+	StateUpgradeJsonFields      []string                     `json:"state_upgrade_json_fields" yaml:"state_upgrade_json_fields"`
+}
+
+// SearchOnlyField is a data source attribute that only exists to look the
+// object up, such as organization_name in a named URL search group.
+type SearchOnlyField struct {
+	Name                    string              `json:"name" yaml:"name"`
+	AttributeValidationData map[string][]string `json:"attribute_validation_data" yaml:"attribute_validation_data"`
 }
 
 // Property represents a single property in the model
@@ -72,6 +91,9 @@ type Property struct {
 	ValidatorData     map[string]any    `json:"validator_data" yaml:"validator_data"`
 	Constraints       []FieldConstraint `json:"constraints" yaml:"constraints"`
 	Deprecated        bool              `json:"deprecated" yaml:"deprecated"`
+	RequiresReplace   bool              `json:"requires_replace,omitempty" yaml:"requires_replace,omitempty"`   // Indicates if a change of the property replaces the resource
+	ObjectAttributes  string            `json:"object_attributes,omitempty" yaml:"object_attributes,omitempty"` // The prefix of the generated attributes of an object property
+	JsonAttribute     *JsonAttribute    `json:"json_attribute,omitempty" yaml:"json_attribute,omitempty"`       // The raw JSON attribute of an object property
 }
 
 type PropertyGenerated struct {
@@ -99,6 +121,9 @@ func (p *Property) Update(vt AwxKeyValueType, override PropertyOverride, values
 	p.IsTypeWrite = vt == TypeWrite
 	p.Trim = override.Trim
 	p.PostWrap = override.PostWrap
+	p.RequiresReplace = override.RequiresReplace || item.Immutable
+	p.ObjectAttributes = override.ObjectAttributes
+	p.JsonAttribute = override.JsonAttribute
 	p.OmitEmpty = true
 	if override.OmitEmpty != nil {
 		p.OmitEmpty = *override.OmitEmpty
@@ -180,13 +205,19 @@ func (p *Property) setGenerated(values map[string]any, override PropertyOverride
 	if slices.Contains([]string{"json", "json-yaml"}, p.Type) {
 		p.Generated.BodyRequestModelType = "json.RawMessage"
 		p.Generated.ModelBodyRequestValue = fmt.Sprintf("json.RawMessage(o.%s.%s())", p.Generated.PropertyName, p.Generated.TfGoPrimitiveValue)
+	} else if p.Type == "object" {
+		p.Generated.BodyRequestModelType = awxPrimitiveType(p.Type)
+		p.Generated.ModelBodyRequestValue = fmt.Sprintf("helpers.ObjectAsMap(o.%s)", p.Generated.PropertyName)
+		if p.JsonAttribute != nil {
+			p.Generated.ModelBodyRequestValue = fmt.Sprintf("helpers.NestedObjectOrJsonAsMap(o.%s, o.%s)", p.Generated.PropertyName, strcase.ToCamel(p.JsonAttribute.Name))
+		}
 	} else {
 		p.Generated.BodyRequestModelType = awxPrimitiveType(p.Type)
 		p.Generated.ModelBodyRequestValue = fmt.Sprintf("o.%s.%s()", p.Generated.PropertyName, p.Generated.TfGoPrimitiveValue)
 	}
 
 	switch p.Type {
-	case "choice", "list":
+	case "choice", "list", "set":
 		if v, ok := p.ValidatorData["choices"].([]any); ok {
 			p.Generated.ValidationAvailableChoiceData = availableChoicesData(v)
 		}
@@ -215,13 +246,20 @@ func (p *Property) setDefaultValue(values map[string]any, override PropertyOverr
 		values["default"] = override.DefaultValue
 	}
 
+	// An object has no static default, its attributes are set one by one.
 	var hasDefault bool
-	if _, ok := values["default"]; ok {
+	if _, ok := values["default"]; ok && p.Type != "object" {
 		hasDefault = cmp.Or(values["default"], nil) != nil
 	}
 
 	values["computed"] = !p.IsRequired || hasDefault
 	p.IsComputed = !p.IsRequired || hasDefault
+	if p.JsonAttribute != nil {
+		// Only the configuration tells whether the value goes in the object or
+		// in its raw JSON attribute, neither is computed.
+		values["computed"] = false
+		p.IsComputed = false
+	}
 
 	if hasDefault {
 		values["required"] = false
@@ -304,18 +342,28 @@ func (c *ModelConfig) Update(config Config, item Item) error {
 	c.Endpoint = item.Endpoint
 	c.HasObjectRoles = item.HasObjectRoles
 	c.HasSurveySpec = item.HasSurveySpec
+	c.HasWorkflowGraph = item.HasWorkflowGraph
+	c.HasApprovalTemplate = item.HasApprovalTemplate
+	c.HasMembership = item.HasMembership
+	c.Launch = item.Launch
 	c.NoTerraformDataSource = item.NoTerraformDataSource
 	c.NoTerraformResource = item.NoTerraformResource
 	c.TypeName = item.TypeName
+	c.ListTypeName = item.ListDataSourceTypeName()
 	c.Enabled = item.Enabled
 	c.UnDeletable = item.Undeletable
+	c.Immutable = item.Immutable
 	c.PreStateSetHookFunction = item.PreStateSetHookFunction
+	c.PlanValidatorFunction = item.PlanValidatorFunction
+	c.SchemaVersion = item.SchemaVersion
+	c.StateUpgradeJsonFields = item.StateUpgradeJsonFields
 	c.WaitLifecycle = item.WaitLifecycle
 	c.PackageName = config.PackageName("awx")
 	c.ApiVersion = config.ApiVersion
 	c.RenderApiDocs = config.RenderApiDocs
 	c.NoId = item.NoId
 	c.NoImport = item.NoImport
+	c.ImportIdFields = item.ImportIdFields
 	c.IdKey = item.IdKey
 	c.FieldConstraints = item.FieldConstraints
 	c.AssociateDisassociateGroups = item.AssociateDisassociateGroups
@@ -363,6 +411,19 @@ func (c *ModelConfig) Process(config Config, item Item) (_ error) {
 	slices.Sort(c.DeprecatedReadProperties)
 	slices.Sort(c.DeprecatedWriteProperties)
 	c.IdProperty = c.ReadProperties[c.IdKey]
+
+	c.SearchOnlyFields = make([]SearchOnlyField, 0)
+	for _, group := range c.SearchFields {
+		for _, field := range group.Fields {
+			if _, ok := c.ReadProperties[field.Name]; ok || slices.ContainsFunc(c.SearchOnlyFields, func(f SearchOnlyField) bool { return f.Name == field.Name }) {
+				continue
+			}
+			c.SearchOnlyFields = append(c.SearchOnlyFields, SearchOnlyField{
+				Name:                    field.Name,
+				AttributeValidationData: generateAttributeValidationData(c.SearchFields, field.Name),
+			})
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,16 @@
+package {{ .PackageName }}
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// New{{ .Name }}ApprovalTemplateResource returns the resource managing the approval template of a {{ .Name }}.
+func New{{ .Name }}ApprovalTemplateResource() resource.Resource {
+	return framework.NewApprovalTemplateResource(
+		"{{ $.TypeName }}_approval_template",
+		"{{ .Endpoint }}",
+		"/api/v2/workflow_approval_templates/",
+	)
+}
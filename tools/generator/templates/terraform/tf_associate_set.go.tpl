@@ -0,0 +1,22 @@
+package {{ .PackageName }}
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// New{{ .Name }}{{ .Type }}sResource returns the authoritative {{ .Name }} ↔ {{ .Type }} association resource.
+func New{{ .Name }}{{ .Type }}sResource() resource.Resource {
+	return framework.NewAssociateSetResource(framework.AssociateSetConfig{
+		TypeName:      "{{ .Name | snakeCase }}_{{ .Type | snakeCase }}s",
+		Endpoint:      "{{ .Endpoint }}",
+		ParentName:    "{{ .Name }}",
+		ParentIDAttr:  "{{ .Name | snakeCase }}_id",
+		ChildName:     "{{ .Type }}",
+		ChildIDsAttr:  "{{ or .IdsAttribute (printf "%s_ids" (snakeCase .Type)) }}",
+		AssociateType: "{{ .AssociateType }}",
+		Ordered:       {{ .Ordered }},
+		Deprecated:    {{ .Deprecated }},
+	})
+}
@@ -0,0 +1,87 @@
+package {{ .PackageName }}
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// credentialInputMetadataAttrTypes are the attributes of the metadata of a
+// credential input source, one nested object per external credential type
+// with the lookup metadata of that type. At most one of them is set, the one
+// of the type of the source credential, see
+// validateCredentialInputSourceMetadata.
+var credentialInputMetadataAttrTypes = map[string]attr.Type{
+{{- range .Blocks }}
+	"{{ .Name }}": types.ObjectType{AttrTypes: map[string]attr.Type{
+{{- range .Attributes }}
+		"{{ .ID }}": types.{{ if .IsBool }}Bool{{ else }}String{{ end }}Type,
+{{- end }}
+	}},
+{{- end }}
+}
+
+// credentialInputMetadataResourceAttributes returns the resource schema of the
+// attributes in credentialInputMetadataAttrTypes.
+func credentialInputMetadataResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+{{- range $i, $block := .Blocks }}
+		"{{ $block.Name }}": schema.SingleNestedAttribute{
+			Description: {{ $block.Description | escape_quotes }},
+			Optional:    true,
+			Validators: []validator.Object{
+				objectvalidator.ConflictsWith(
+{{- range $.Blocks }}
+{{- if ne .Name $block.Name }}
+					path.MatchRelative().AtParent().AtName("{{ .Name }}"),
+{{- end }}
+{{- end }}
+				),
+			},
+			Attributes: map[string]schema.Attribute{
+{{- range .Attributes }}
+				"{{ .ID }}": schema.{{ if .IsBool }}Bool{{ else }}String{{ end }}Attribute{
+					Description: {{ .Description | escape_quotes }},
+{{- if .Required }}
+					Required:    true,
+{{- else }}
+					Optional:    true,
+{{- end }}
+{{- if .Choices }}
+					Validators: []validator.String{
+						stringvalidator.OneOf({{ range .Choices }}{{ . | quote }}, {{ end }}),
+					},
+{{- end }}
+				},
+{{- end }}
+			},
+		},
+{{- end }}
+	}
+}
+
+// credentialInputMetadataDataSourceAttributes returns the data source schema
+// of the attributes in credentialInputMetadataAttrTypes.
+func credentialInputMetadataDataSourceAttributes() map[string]dschema.Attribute {
+	return map[string]dschema.Attribute{
+{{- range .Blocks }}
+		"{{ .Name }}": dschema.SingleNestedAttribute{
+			Description: {{ .Description | escape_quotes }},
+			Computed:    true,
+			Attributes: map[string]dschema.Attribute{
+{{- range .Attributes }}
+				"{{ .ID }}": dschema.{{ if .IsBool }}Bool{{ else }}String{{ end }}Attribute{
+					Description: {{ .Description | escape_quotes }},
+					Computed:    true,
+				},
+{{- end }}
+			},
+		},
+{{- end }}
+	}
+}
@@ -33,7 +33,7 @@ type {{ .Name | lowerCamelCase }}TerraformModel struct {
 	Managed        types.Bool   `tfsdk:"managed" json:"managed"`
 	CredentialType types.Int64  `tfsdk:"credential_type" json:"credential_type"`
 {{- range .Fields }}
-	{{ .PropertyName }} types.String `tfsdk:"{{ .ID }}" json:"-"`
+	{{ .PropertyName }} types.{{ if .IsBool }}Bool{{ else }}String{{ end }} `tfsdk:"{{ .ID }}" json:"-"`
 {{- end }}
 }
 
@@ -65,7 +65,7 @@ func (o *{{ .Name | lowerCamelCase }}TerraformModel) BodyRequest() *{{ .Name | l
 	inputs := map[string]any{}
 {{- range .Fields }}
 	if !o.{{ .PropertyName }}.IsNull() && !o.{{ .PropertyName }}.IsUnknown() {
-		inputs["{{ .ID }}"] = o.{{ .PropertyName }}.ValueString()
+		inputs["{{ .ID }}"] = o.{{ .PropertyName }}.{{ if .IsBool }}ValueBool{{ else }}ValueString{{ end }}()
 	}
 {{- end }}
 	if len(inputs) > 0 {
@@ -94,7 +94,11 @@ func (o *{{ .Name | lowerCamelCase }}TerraformModel) UpdateFromApiData(data map[
 
 	if inputs, ok := data["inputs"].(map[string]any); ok {
 {{- range .Fields }}
+{{- if .IsBool }}
+		collect(helpers.AttrValueSetBool(&o.{{ .PropertyName }}, inputs["{{ .ID }}"]))
+{{- else }}
 		collect(helpers.AttrValueSetString(&o.{{ .PropertyName }}, inputs["{{ .ID }}"], false))
+{{- end }}
 {{- end }}
 	}
 	return diags, nil
@@ -144,19 +148,38 @@ type {{ .Name | lowerCamelCase }}Resource = framework.GenericResource[{{ .Name |
 func New{{ .Name }}Resource() resource.Resource {
 	attrs := framework.CredentialBaseResourceAttrs()
 {{- range .Fields }}
-	attrs["{{ .ID }}"] = schema.StringAttribute{
+{{- $type := "String" }}{{ if .IsBool }}{{ $type = "Bool" }}{{ end }}
+	attrs["{{ .ID }}"] = schema.{{ $type }}Attribute{
 		Description: {{ if .HelpText }}{{ .HelpText | escape_quotes }}{{ else }}{{ .Label | escape_quotes }}{{ end }},
-{{- if .Required }}
+{{- if ne .Default nil }}
+		Optional:    true,
+		Computed:    true,
+{{- if .IsBool }}
+		Default:     booldefault.StaticBool({{ .Default }}),
+{{- else }}
+		Default:     stringdefault.StaticString({{ .Default | quote }}),
+{{- end }}
+{{- else if .Required }}
 		Required:    true,
 {{- else }}
 		Optional:    true,
 		Computed:    true,
-		PlanModifiers: []planmodifier.String{
-			stringplanmodifier.UseStateForUnknown(),
+		PlanModifiers: []planmodifier.{{ $type }}{
+			{{ $type | lowerCase }}planmodifier.UseStateForUnknown(),
 		},
 {{- end }}
 {{- if .Secret }}
 		Sensitive:   true,
+{{- end }}
+{{- if or .DependsOn .Choices }}
+		Validators: []validator.{{ $type }}{
+{{- if .Choices }}
+			stringvalidator.OneOf({{ range .Choices }}{{ . | quote }}, {{ end }}),
+{{- end }}
+{{- if .DependsOn }}
+			{{ $type | lowerCase }}validator.AlsoRequires({{ range .DependsOn }}path.MatchRoot({{ . | quote }}), {{ end }}),
+{{- end }}
+		},
 {{- end }}
 	}
 {{- end }}
@@ -197,7 +220,7 @@ type {{ .Name | lowerCamelCase }}DataSource = framework.GenericDataSource[{{ .Na
 func New{{ .Name }}DataSource() datasource.DataSource {
 	attrs := framework.CredentialBaseDataSourceAttrs()
 {{- range .Fields }}
-	attrs["{{ .ID }}"] = dschema.StringAttribute{
+	attrs["{{ .ID }}"] = dschema.{{ if .IsBool }}Bool{{ else }}String{{ end }}Attribute{
 		Description: {{ if .HelpText }}{{ .HelpText | escape_quotes }}{{ else }}{{ .Label | escape_quotes }}{{ end }},
 		Computed:    true,
 {{- if .Secret }}
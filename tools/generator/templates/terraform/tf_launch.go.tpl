@@ -0,0 +1,16 @@
+package {{ .PackageName }}
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// New{{ .Name }}LaunchResource returns the resource launching a {{ .Name }}.
+func New{{ .Name }}LaunchResource() resource.Resource {
+	return framework.{{ .Launch.Constructor }}(
+		"{{ .Launch.TypeName }}",
+		"{{ .Endpoint }}",
+		"{{ .Launch.JobEndpoint }}",
+	)
+}
@@ -0,0 +1,17 @@
+package {{ .PackageName }}
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// New{{ .Name }}MembershipResource returns the authoritative member and admin users resource of a {{ .Name }}.
+func New{{ .Name }}MembershipResource() resource.Resource {
+	return framework.NewMembershipResource(
+		"{{ $.TypeName }}_membership",
+		"{{ .Endpoint }}",
+		"/api/v2/roles/",
+		"{{ .Name }}",
+	)
+}
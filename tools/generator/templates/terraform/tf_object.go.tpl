@@ -2,11 +2,12 @@
 tf_object.go.tpl is the entry template for a single regular (non-credential)
 generated AWX object. It emits the package declaration, a kitchen-sink import
 block (goimports trims unused entries after generation), and stitches together
-three partials:
+four partials:
 
   - model_section        — typed model + body request struct + reader
   - resource_section     — schema + GenericResource binding (gated by NoTerraformResource)
   - data_source_section  — schema + GenericDataSource binding (gated by NoTerraformDataSource)
+  - list_data_source_section — GenericListDataSource binding (gated by ListTypeName)
 
 The data source section uses the `dschema` alias because the resource section
 binds `schema` to resource/schema.
@@ -50,3 +51,7 @@ import (
 {{ if not .NoTerraformDataSource }}
 {{ template "data_source_section" . }}
 {{ end }}
+
+{{ if .ListTypeName }}
+{{ template "list_data_source_section" . }}
+{{ end }}
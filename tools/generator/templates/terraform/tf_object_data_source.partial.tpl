@@ -19,13 +19,18 @@ func New{{ .Name }}DataSource() datasource.DataSource {
 {{- end }}
                 Attributes: map[string]dschema.Attribute{
 {{- range $key, $value := $.ReadProperties }}
+{{- if eq $value.Generated.AttributeType "Object" }}
+                    "{{ $key | lowerCase }}": dschema.SingleNestedAttribute{
+                        Attributes: {{ $value.ObjectAttributes }}DataSourceAttributes(),
+{{- else }}
                     "{{ $key | lowerCase }}": dschema.{{ $value.Generated.AttributeType }}Attribute{
+{{- end }}
 {{- if $value.Deprecated }}
                         DeprecationMessage: "This field is deprecated and will be removed in a future release.",
 {{- end }}
 {{- if and (eq $value.Generated.AttributeType "List") (eq $value.ElementType "choice") }}
                         ElementType: types.ListType{ElemType: types.StringType},
-{{- else if eq $value.Generated.AttributeType "List" }}
+{{- else if or (eq $value.Generated.AttributeType "List") (eq $value.Generated.AttributeType "Set") }}
                         ElementType: types.StringType,
 {{- end }}
                         Description: {{ escape_quotes (or .Description .Label) }},
@@ -48,6 +53,12 @@ func New{{ .Name }}DataSource() datasource.DataSource {
                         },
 {{- end }}
                     },
+{{- if $value.JsonAttribute }}
+                    "{{ $value.JsonAttribute.Name }}": dschema.StringAttribute{
+                        Description: {{ escape_quotes $value.JsonAttribute.Description }},
+                        Computed:    true,
+                    },
+{{- end }}
 {{- end }}
 {{- range $key, $value := $.WriteProperties }}
 {{- if $value.IsWriteOnly }}
@@ -67,18 +78,27 @@ func New{{ .Name }}DataSource() datasource.DataSource {
                     },
 {{- end }}
 {{- end }}
-                },
-            },
-{{- if .HasSearchFields }}
-            SearchGroups: []framework.SearchGroup{
-{{- range $field := .SearchFields }}
-                {Name: "{{ $field.Name }}", URLSuffix: "{{ $field.UrlSuffix }}", Fields: []framework.SearchField{
-{{- range $attr := $field.Fields }}
-                    {Name: "{{ $attr.Name }}", Type: "{{ if eq (index $.ReadProperties $attr.Name).Generated.AwxGoType "types.Int64" }}int64{{ else }}string{{ end }}", URLEscape: {{ $attr.UrlEscapeValue }}},
+{{- range $field := $.SearchOnlyFields }}
+                    "{{ $field.Name }}": dschema.StringAttribute{
+                        Description: "Search only, looks the {{ $.Name }} up by its AWX named URL together with the other fields of the named URL.",
+                        Optional:    true,
+{{- if $field.AttributeValidationData }}
+                        Validators: []validator.String{
+{{- range $key, $attrs := $field.AttributeValidationData }}
+                            stringvalidator.{{ $key }}(
+{{- range $attr := $attrs }}
+                                path.MatchRoot("{{ $attr }}"),
 {{- end }}
-                }},
+                            ),
+{{- end }}
+                        },
 {{- end }}
+                    },
+{{- end }}
+                },
             },
+{{- if .HasSearchFields }}
+            SearchGroups: {{ template "search_groups" . }},
 {{- end }}
 {{- if .PreStateSetHookFunction }}
 {{- if eq .PreStateSetHookFunction "hooks.RequireResourceStateOrOrig" }}
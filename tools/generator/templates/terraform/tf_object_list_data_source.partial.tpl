@@ -0,0 +1,80 @@
+{{- /*
+list_data_source_section emits the plural data source (e.g. awx_hosts) for a
+regular generated resource. The item attributes mirror the singular data
+source, but are all Computed since they are only ever read back from AWX.
+Rendered inside tf_object.go.tpl which provides the package + imports.
+*/ -}}
+{{- define "list_data_source_section" -}}
+type {{ .Name | lowerCamelCase }}ListDataSource = framework.GenericListDataSource[{{ .Name | lowerCamelCase }}TerraformModel, *{{ .Name | lowerCamelCase }}TerraformModel]
+
+// New{{ .Name }}ListDataSource is a helper function to instantiate the {{ .Name }} list data source.
+func New{{ .Name }}ListDataSource() datasource.DataSource {
+    return &{{ .Name | lowerCamelCase }}ListDataSource{
+        DataSourceBase: framework.DataSourceBase{ProviderBase: framework.ProviderBase{TypeName: "{{ .ListTypeName }}", Endpoint: "{{ .Endpoint }}"}},
+        Cfg: framework.ListDataSourceCfg[{{ .Name | lowerCamelCase }}TerraformModel]{
+            Description: "Lists every {{ .Name }} matching the filters.",
+{{- if .Deprecated }}
+            DeprecationMessage: "This data source has been deprecated and will be removed in a future release.",
+{{- end }}
+            ItemAttributes: map[string]dschema.Attribute{
+{{- range $key, $value := $.ReadProperties }}
+{{- if eq $value.Generated.AttributeType "Object" }}
+                "{{ $key | lowerCase }}": dschema.SingleNestedAttribute{
+                    Attributes: {{ $value.ObjectAttributes }}DataSourceAttributes(),
+{{- else }}
+                "{{ $key | lowerCase }}": dschema.{{ $value.Generated.AttributeType }}Attribute{
+{{- end }}
+{{- if $value.Deprecated }}
+                    DeprecationMessage: "This field is deprecated and will be removed in a future release.",
+{{- end }}
+{{- if and (eq $value.Generated.AttributeType "List") (eq $value.ElementType "choice") }}
+                    ElementType: types.ListType{ElemType: types.StringType},
+{{- else if or (eq $value.Generated.AttributeType "List") (eq $value.Generated.AttributeType "Set") }}
+                    ElementType: types.StringType,
+{{- end }}
+                    Description: {{ escape_quotes (or .Description .Label) }},
+{{- if .IsSensitive }}
+                    Sensitive:   true,
+{{- end }}
+                    Computed:    true,
+                },
+{{- if $value.JsonAttribute }}
+                "{{ $value.JsonAttribute.Name }}": dschema.StringAttribute{
+                    Description: {{ escape_quotes $value.JsonAttribute.Description }},
+                    Computed:    true,
+                },
+{{- end }}
+{{- end }}
+{{- range $key, $value := $.WriteProperties }}
+{{- if $value.IsWriteOnly }}
+                "{{ $key | lowerCase }}": dschema.{{ $value.Generated.AttributeType }}Attribute{
+{{- if eq $value.Generated.AttributeType "List" }}
+                    ElementType: types.{{ camelCase $value.ElementType }}Type,
+{{- end }}
+{{- if $value.Deprecated }}
+                    DeprecationMessage: "This field is deprecated and will be removed in a future release.",
+{{- end }}
+                    Description: {{ escape_quotes (or .Description .Label) }},
+{{- if $value.IsSensitive }}
+                    Sensitive:   true,
+{{- end }}
+                    Computed:    true,
+                },
+{{- end }}
+{{- end }}
+            },
+{{- if .PreStateSetHookFunction }}
+{{- if eq .PreStateSetHookFunction "hooks.RequireResourceStateOrOrig" }}
+            Hook: func(ctx context.Context, apiVersion string, source hooks.Source, callee hooks.Callee, orig, state *{{ .Name | lowerCamelCase }}TerraformModel) error {
+                return hooks.RequireResourceStateOrOrig(ctx, apiVersion, source, callee, orig, state)
+            },
+{{- else }}
+            Hook: {{ .PreStateSetHookFunction }},
+{{- end }}
+{{- end }}
+            ApiVersion: ApiVersion,
+            ResourceName: "{{ .Name }}",
+        },
+    }
+}
+{{- end -}}
@@ -8,6 +8,10 @@ inside tf_object.go.tpl, which carries the package+imports.
 type {{ .Name | lowerCamelCase }}TerraformModel struct {
 {{- range $key, $value := .ReadProperties }}
     {{ $value.Generated.PropertyName }} {{ $value.Generated.AwxGoType }} `tfsdk:"{{ $key | lowerCase }}" json:"{{ $key }}"`
+{{- if $value.JsonAttribute }}
+    // {{ $value.JsonAttribute.Name | camelCase }} holds {{ $key }} as raw JSON when no nested object of it describes the value.
+    {{ $value.JsonAttribute.Name | camelCase }} types.String `tfsdk:"{{ $value.JsonAttribute.Name }}" json:"-"`
+{{- end }}
 {{- end }}
 {{- range $key, $value := .WriteProperties }}
 {{- if $value.IsWriteOnly }}
@@ -18,6 +22,25 @@ type {{ .Name | lowerCamelCase }}TerraformModel struct {
     // {{ .WaitLifecycle.WaitAttribute | camelCase }} is a Terraform-only toggle, not synced to the AWX API.
     {{ .WaitLifecycle.WaitAttribute | camelCase }} types.Bool `tfsdk:"{{ .WaitLifecycle.WaitAttribute }}" json:"-"`
     Timeouts timeouts.Value `tfsdk:"timeouts" json:"-"`
+{{- if .WaitLifecycle.Output }}
+    // {{ .WaitLifecycle.Output.Attribute | camelCase }} is captured after the wait, not synced to the AWX API.
+    {{ .WaitLifecycle.Output.Attribute | camelCase }} types.String `tfsdk:"{{ .WaitLifecycle.Output.Attribute }}" json:"-"`
+    {{ .WaitLifecycle.Output.MaxBytesAttribute | camelCase }} types.Int64 `tfsdk:"{{ .WaitLifecycle.Output.MaxBytesAttribute }}" json:"-"`
+{{- end }}
+{{- if .WaitLifecycle.Launch }}
+{{- if .WaitLifecycle.Launch.TriggersAttribute }}
+    // {{ .WaitLifecycle.Launch.TriggersAttribute | camelCase }} is a Terraform-only map, a change launches a job.
+    {{ .WaitLifecycle.Launch.TriggersAttribute | camelCase }} types.Map `tfsdk:"{{ .WaitLifecycle.Launch.TriggersAttribute }}" json:"-"`
+{{- end }}
+{{- if .WaitLifecycle.Launch.IDAttribute }}
+    // {{ .WaitLifecycle.Launch.IDAttribute | camelCase }} and {{ .WaitLifecycle.Launch.StatusAttribute | camelCase }} record the last launched job, not synced to the AWX API.
+    {{ .WaitLifecycle.Launch.IDAttribute | camelCase }} types.Int64 `tfsdk:"{{ .WaitLifecycle.Launch.IDAttribute }}" json:"-"`
+    {{ .WaitLifecycle.Launch.StatusAttribute | camelCase }} types.String `tfsdk:"{{ .WaitLifecycle.Launch.StatusAttribute }}" json:"-"`
+{{- end }}
+{{- range .WaitLifecycle.Launch.Counts }}
+    {{ .Attribute | camelCase }} types.Int64 `tfsdk:"{{ .Attribute }}" json:"-"`
+{{- end }}
+{{- end }}
 {{- end }}
 }
 
@@ -31,6 +54,8 @@ func (o *{{ .Name | lowerCamelCase }}TerraformModel) BodyRequest() *{{ .Name | l
 {{- if not $value.IsWriteOnly }}
 {{- if eq $value.Generated.AwxGoType "types.List" }}
     req.{{ $value.Generated.PropertyName }} = helpers.ListAsStringSlice(o.{{ $value.Generated.PropertyName }}, {{ or .Trim false }})
+{{- else if eq $value.Generated.AwxGoType "types.Set" }}
+    req.{{ $value.Generated.PropertyName }} = helpers.SetAsStringSlice(o.{{ $value.Generated.PropertyName }}, {{ or .Trim false }})
 {{- else }}
     req.{{ $value.Generated.PropertyName }} = {{ $value.Generated.ModelBodyRequestValue }}
 {{- end }}
@@ -54,6 +79,12 @@ func (o *{{ .Name | lowerCamelCase }}TerraformModel) UpdateFromApiData(data map[
     collect(helpers.AttrValueSetBool(&o.{{ $value.Generated.PropertyName }}, data["{{ $key }}"]))
 {{- else if eq $value.Generated.AwxGoValue "types.ListValueMust(types.StringType, val.Elements())" }}
     collect(helpers.AttrValueSetListString(&o.{{ $value.Generated.PropertyName }}, data["{{ $key }}"], {{ or .Trim false }}))
+{{- else if and (eq $value.Generated.AwxGoType "types.Object") $value.JsonAttribute }}
+    collect(helpers.AttrValueSetNestedObjectOrJson(&o.{{ $value.Generated.PropertyName }}, &o.{{ $value.JsonAttribute.Name | camelCase }}, data["{{ $key }}"], {{ $value.ObjectAttributes }}AttrTypes))
+{{- else if eq $value.Generated.AwxGoType "types.Object" }}
+    collect(helpers.AttrValueSetObject(&o.{{ $value.Generated.PropertyName }}, data["{{ $key }}"], {{ $value.ObjectAttributes }}AttrTypes))
+{{- else if eq $value.Generated.AwxGoType "types.Set" }}
+    collect(helpers.AttrValueSetSetString(&o.{{ $value.Generated.PropertyName }}, data["{{ $key }}"], {{ or .Trim false }}))
 {{- else if and (eq $value.Generated.AwxGoValue "types.StringValue") (eq .Type "json") }}
     collect(helpers.AttrValueSetJsonString(&o.{{ $value.Generated.PropertyName }}, data["{{ $key }}"], {{ or .Trim false }}))
 {{- else if and (eq $value.Generated.AwxGoValue "types.StringValue") (eq .Type "json-yaml") }}
@@ -5,11 +5,18 @@ omitted when empty. Used for both regular and write-only attributes.
 */ -}}
 {{- define "attrSchema" -}}
 {{- $key := .Key }}{{ $value := .Value -}}
+{{- if eq $value.Generated.AttributeType "Object" -}}
+"{{ $key | lowerCase }}": schema.SingleNestedAttribute{
+	Attributes: {{ $value.ObjectAttributes }}ResourceAttributes(),
+{{- else -}}
 "{{ $key | lowerCase }}": schema.{{ $value.Generated.AttributeType }}Attribute{
+{{- end }}
 {{- if and (eq $value.Generated.AttributeType "List") (eq $value.ElementType "choice") }}
 	ElementType: types.ListType{ElemType: types.StringType},
 {{- else if eq $value.Generated.AttributeType "List" }}
 	ElementType: types.{{ camelCase $value.ElementType }}Type,
+{{- else if eq $value.Generated.AttributeType "Set" }}
+	ElementType: types.StringType,
 {{- end }}
 {{- if $value.Deprecated }}
 	DeprecationMessage: "This field is deprecated and will be removed in a future release.",
@@ -29,9 +36,14 @@ omitted when empty. Used for both regular and write-only attributes.
 {{- if $value.HasDefaultValue }}
 	Default:     {{ $value.DefaultValue }},
 {{- end }}
-{{- if not $value.IsRequired }}
+{{- if or $value.IsComputed $value.RequiresReplace }}
 	PlanModifiers: []planmodifier.{{ $value.Generated.AttributeType }}{
+{{- if $value.IsComputed }}
 		{{ $value.Generated.AttributeType | lowerCase }}planmodifier.UseStateForUnknown(),
+{{- end }}
+{{- if $value.RequiresReplace }}
+		{{ $value.Generated.AttributeType | lowerCase }}planmodifier.RequiresReplace(),
+{{- end }}
 	},
 {{- end }}
 {{- if and (eq $value.Generated.AwxGoValue "types.StringValue") (hasKey $value.ValidatorData "max_length") }}
@@ -72,6 +84,23 @@ omitted when empty. Used for both regular and write-only attributes.
 			{{ $item | quote }},
 {{- end }}
 		)),
+{{- range $value.Constraints }}
+		// {{ .Id }}
+		{{ .Constraint }}({{ range $k := .Fields }}path.MatchRoot("{{ $k }}"), {{ end }}),
+{{- end }}
+	},
+{{- else if and (eq $value.Generated.AttributeType "Set") (or $value.Generated.ValidationAvailableChoiceData $value.Validators) }}
+	Validators: []validator.{{ $value.Generated.AttributeType }}{
+{{- range $item := $value.Validators }}
+		{{ $item }},
+{{- end }}
+{{- if $value.Generated.ValidationAvailableChoiceData }}
+		setvalidator.ValueStringsAre(stringvalidator.OneOf(
+{{- range $item := $value.Generated.ValidationAvailableChoiceData }}
+			{{ $item | quote }},
+{{- end }}
+		)),
+{{- end }}
 {{- range $value.Constraints }}
 		// {{ .Id }}
 		{{ .Constraint }}({{ range $k := .Fields }}path.MatchRoot("{{ $k }}"), {{ end }}),
@@ -103,10 +132,22 @@ func New{{ .Name }}Resource() resource.Resource {
 			Schema: schema.Schema{
 {{- if .Deprecated }}
 				DeprecationMessage: "This resource has been deprecated and will be removed in a future release.",
+{{- end }}
+{{- if .SchemaVersion }}
+				Version: {{ .SchemaVersion }},
 {{- end }}
 				Attributes: map[string]schema.Attribute{
 {{- range $key, $value := .WriteProperties }}
 					{{ template "attrSchema" (dict "Key" $key "Value" $value) }}
+{{- if $value.JsonAttribute }}
+					"{{ $value.JsonAttribute.Name }}": schema.StringAttribute{
+						Description: {{ escape_quotes $value.JsonAttribute.Description }},
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRoot("{{ $key | lowerCase }}")),
+						},
+					},
+{{- end }}
 {{- end }}
 {{- range $key, $value := .ReadProperties }}
 {{- if not $value.IsInWriteProperty }}
@@ -149,6 +190,58 @@ func New{{ .Name }}Resource() resource.Resource {
 							boolplanmodifier.UseStateForUnknown(),
 						},
 					},
+{{- if .WaitLifecycle.Output }}
+					"{{ .WaitLifecycle.Output.Attribute }}": schema.StringAttribute{
+						Description: {{ escape_quotes .WaitLifecycle.Output.Description }},
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"{{ .WaitLifecycle.Output.MaxBytesAttribute }}": schema.Int64Attribute{
+						Description: {{ escape_quotes .WaitLifecycle.Output.MaxBytesDescription }},
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64({{ .WaitLifecycle.Output.DefaultMaxBytes }}),
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+{{- end }}
+{{- if .WaitLifecycle.Launch }}
+{{- if .WaitLifecycle.Launch.TriggersAttribute }}
+					"{{ .WaitLifecycle.Launch.TriggersAttribute }}": schema.MapAttribute{
+						Description: {{ escape_quotes .WaitLifecycle.Launch.TriggersDescription }},
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+{{- end }}
+{{- if .WaitLifecycle.Launch.IDAttribute }}
+					"{{ .WaitLifecycle.Launch.IDAttribute }}": schema.Int64Attribute{
+						Description: {{ escape_quotes .WaitLifecycle.Launch.IDDescription }},
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"{{ .WaitLifecycle.Launch.StatusAttribute }}": schema.StringAttribute{
+						Description: {{ escape_quotes .WaitLifecycle.Launch.StatusDescription }},
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+{{- end }}
+{{- range .WaitLifecycle.Launch.Counts }}
+					"{{ .Attribute }}": schema.Int64Attribute{
+						Description: {{ escape_quotes .Description }},
+						Computed:    true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+{{- end }}
+{{- end }}
 {{- end }}
 				},
 			},
@@ -167,9 +260,18 @@ func New{{ .Name }}Resource() resource.Resource {
 {{- if .NoImport }}
 			NoImport: true,
 {{- end }}
+{{- if and .HasSearchFields (not .NoId) (not .NoImport) }}
+			SearchGroups: {{ template "search_groups" . }},
+{{- if .ImportIdFields }}
+			ImportIDFields: []string{ {{- range $i, $f := .ImportIdFields }}{{ if $i }}, {{ end }}"{{ $f }}"{{ end -}} },
+{{- end }}
+{{- end }}
 {{- if .UnDeletable }}
 			UnDeletable: true,
 {{- end }}
+{{- if .Immutable }}
+			Immutable: true,
+{{- end }}
 {{- if .PreStateSetHookFunction }}
 {{- if eq .PreStateSetHookFunction "hooks.RequireResourceStateOrOrig" }}
 			Hook: func(ctx context.Context, apiVersion string, source hooks.Source, callee hooks.Callee, orig, state *{{ .Name | lowerCamelCase }}TerraformModel) error {
@@ -179,6 +281,12 @@ func New{{ .Name }}Resource() resource.Resource {
 			Hook: {{ .PreStateSetHookFunction }},
 {{- end }}
 {{- end }}
+{{- if .PlanValidatorFunction }}
+			ValidatePlan: {{ .PlanValidatorFunction }},
+{{- end }}
+{{- if .SchemaVersion }}
+			StateUpgraders: framework.RawStateUpgraders[{{ .Name | lowerCamelCase }}TerraformModel, {{ .Name | lowerCamelCase }}BodyRequestModel, *{{ .Name | lowerCamelCase }}TerraformModel]({{ .SchemaVersion }}, {{ if .StateUpgradeJsonFields }}framework.DecodeJSONStrings({{ range $i, $f := .StateUpgradeJsonFields }}{{ if $i }}, {{ end }}{{ $f | quote }}{{ end }}){{ else }}nil{{ end }}),
+{{- end }}
 {{- $hasWriteOnly := false }}
 {{- range $key, $value := $.WriteProperties }}
 {{- if $value.IsWriteOnly }}
@@ -206,6 +314,30 @@ func New{{ .Name }}Resource() resource.Resource {
 			CopyExtraAttributes: func(plan, state *{{ .Name | lowerCamelCase }}TerraformModel) {
 				state.{{ .WaitLifecycle.WaitAttribute | camelCase }} = plan.{{ .WaitLifecycle.WaitAttribute | camelCase }}
 				state.Timeouts = plan.Timeouts
+{{- if .WaitLifecycle.Output }}
+				state.{{ .WaitLifecycle.Output.MaxBytesAttribute | camelCase }} = plan.{{ .WaitLifecycle.Output.MaxBytesAttribute | camelCase }}
+				if !plan.{{ .WaitLifecycle.Output.Attribute | camelCase }}.IsUnknown() {
+					state.{{ .WaitLifecycle.Output.Attribute | camelCase }} = plan.{{ .WaitLifecycle.Output.Attribute | camelCase }}
+				}
+{{- end }}
+{{- if .WaitLifecycle.Launch }}
+{{- if .WaitLifecycle.Launch.TriggersAttribute }}
+				state.{{ .WaitLifecycle.Launch.TriggersAttribute | camelCase }} = plan.{{ .WaitLifecycle.Launch.TriggersAttribute | camelCase }}
+{{- end }}
+{{- if .WaitLifecycle.Launch.IDAttribute }}
+				if !plan.{{ .WaitLifecycle.Launch.IDAttribute | camelCase }}.IsUnknown() {
+					state.{{ .WaitLifecycle.Launch.IDAttribute | camelCase }} = plan.{{ .WaitLifecycle.Launch.IDAttribute | camelCase }}
+				}
+				if !plan.{{ .WaitLifecycle.Launch.StatusAttribute | camelCase }}.IsUnknown() {
+					state.{{ .WaitLifecycle.Launch.StatusAttribute | camelCase }} = plan.{{ .WaitLifecycle.Launch.StatusAttribute | camelCase }}
+				}
+{{- end }}
+{{- range .WaitLifecycle.Launch.Counts }}
+				if !plan.{{ .Attribute | camelCase }}.IsUnknown() {
+					state.{{ .Attribute | camelCase }} = plan.{{ .Attribute | camelCase }}
+				}
+{{- end }}
+{{- end }}
 			},
 			WaitLifecycle: &framework.WaitLifecycleCfg[{{ .Name | lowerCamelCase }}TerraformModel]{
 				ShouldWait: func(plan *{{ .Name | lowerCamelCase }}TerraformModel) bool {
@@ -233,6 +365,78 @@ func New{{ .Name }}Resource() resource.Resource {
 					}
 					return plan.Timeouts.Create(ctx, {{ go_duration .WaitLifecycle.DefaultTimeout }})
 				},
+{{- if .WaitLifecycle.RefreshAfterWait }}
+				RefreshAfterWait: true,
+{{- end }}
+{{- if .WaitLifecycle.Output }}
+				Output: &framework.WaitOutputCfg[{{ .Name | lowerCamelCase }}TerraformModel]{
+					EndpointForModel: func(m *{{ .Name | lowerCamelCase }}TerraformModel) string {
+						return fmt.Sprintf("{{ $.Endpoint }}{{ .WaitLifecycle.Output.EndpointSuffix }}", m.{{ camelCase $.IdKey }}.{{ $.IdProperty.Generated.TfGoPrimitiveValue }}())
+					},
+					MaxBytes: func(plan *{{ .Name | lowerCamelCase }}TerraformModel) int64 {
+						return plan.{{ .WaitLifecycle.Output.MaxBytesAttribute | camelCase }}.ValueInt64()
+					},
+					SetOutput: func(state *{{ .Name | lowerCamelCase }}TerraformModel, output string) {
+						state.{{ .WaitLifecycle.Output.Attribute | camelCase }} = types.StringValue(output)
+					},
+				},
+{{- end }}
+{{- if .WaitLifecycle.Launch }}
+				Launch: &framework.WaitLaunchCfg[{{ .Name | lowerCamelCase }}TerraformModel]{
+{{- if .WaitLifecycle.Launch.TriggersAttribute }}
+					ShouldLaunch: func(plan, prior *{{ .Name | lowerCamelCase }}TerraformModel) bool {
+						return prior != nil && !plan.{{ .WaitLifecycle.Launch.TriggersAttribute | camelCase }}.Equal(prior.{{ .WaitLifecycle.Launch.TriggersAttribute | camelCase }})
+					},
+{{- end }}
+					EndpointForModel: func(m *{{ .Name | lowerCamelCase }}TerraformModel) string {
+						return fmt.Sprintf("{{ $.Endpoint }}{{ .WaitLifecycle.Launch.EndpointSuffix }}", m.{{ camelCase $.IdKey }}.{{ $.IdProperty.Generated.TfGoPrimitiveValue }}())
+					},
+					IDField:     {{ .WaitLifecycle.Launch.IDField | quote }},
+					JobEndpoint: {{ .WaitLifecycle.Launch.JobEndpoint | quote }},
+{{- if .WaitLifecycle.Launch.IDAttribute }}
+					SetJob: func(state *{{ .Name | lowerCamelCase }}TerraformModel, id int64, status string) {
+						state.{{ .WaitLifecycle.Launch.IDAttribute | camelCase }} = types.Int64Value(id)
+						state.{{ .WaitLifecycle.Launch.StatusAttribute | camelCase }} = types.StringValue(status)
+					},
+{{- end }}
+{{- if .WaitLifecycle.Launch.Counts }}
+					Counts: []framework.WaitCountCfg[{{ .Name | lowerCamelCase }}TerraformModel]{
+{{- range .WaitLifecycle.Launch.Counts }}
+						{
+							EndpointForModel: func(m *{{ $.Name | lowerCamelCase }}TerraformModel) string {
+								return fmt.Sprintf("{{ $.Endpoint }}{{ .EndpointSuffix }}", m.{{ camelCase $.IdKey }}.{{ $.IdProperty.Generated.TfGoPrimitiveValue }}())
+							},
+							SetCount: func(state *{{ $.Name | lowerCamelCase }}TerraformModel, count int64) {
+								state.{{ .Attribute | camelCase }} = types.Int64Value(count)
+							},
+						},
+{{- end }}
+					},
+{{- end }}
+				},
+{{- end }}
+{{- if or .WaitLifecycle.UnknownAfterWait (and .WaitLifecycle.Launch (or .WaitLifecycle.Launch.IDAttribute .WaitLifecycle.Launch.Counts)) }}
+				MarkUnknown: func(plan *{{ .Name | lowerCamelCase }}TerraformModel, waiting bool) {
+{{- if and .WaitLifecycle.Launch .WaitLifecycle.Launch.IDAttribute }}
+					plan.{{ .WaitLifecycle.Launch.IDAttribute | camelCase }} = types.Int64Unknown()
+					plan.{{ .WaitLifecycle.Launch.StatusAttribute | camelCase }} = types.StringUnknown()
+{{- end }}
+{{- if or .WaitLifecycle.UnknownAfterWait (and .WaitLifecycle.Launch .WaitLifecycle.Launch.Counts) }}
+					if !waiting {
+						return
+					}
+{{- end }}
+{{- range .WaitLifecycle.UnknownAfterWait }}
+{{- $p := index $.ReadProperties . }}
+					plan.{{ $p.Generated.PropertyName }} = types.{{ $p.Generated.AttributeType }}Unknown()
+{{- end }}
+{{- if .WaitLifecycle.Launch }}
+{{- range .WaitLifecycle.Launch.Counts }}
+					plan.{{ .Attribute | camelCase }} = types.Int64Unknown()
+{{- end }}
+{{- end }}
+				},
+{{- end }}
 			},
 {{- end }}
 			ApiVersion: ApiVersion,
@@ -0,0 +1,17 @@
+{{- /*
+search_groups emits the []framework.SearchGroup literal for a regular generated
+resource. Shared by the data source (lookup by attributes) and the resource
+(import by key=value pairs). Caller supplies ModelConfig.ToMap() data.
+*/ -}}
+{{- define "search_groups" -}}
+[]framework.SearchGroup{
+{{- range $field := .SearchFields }}
+                {Name: "{{ $field.Name }}", URLSuffix: "{{ $field.UrlSuffix }}",{{ if $field.NamedURL }} NamedURL: true,{{ end }} Fields: []framework.SearchField{
+{{- range $attr := $field.Fields }}
+{{- $prop := index $.ReadProperties $attr.Name }}
+                    {Name: "{{ $attr.Name }}", Type: "{{ if and $prop (eq $prop.Generated.AwxGoType "types.Int64") }}int64{{ else }}string{{ end }}", URLEscape: {{ $attr.UrlEscapeValue }}},
+{{- end }}
+                }},
+{{- end }}
+            }
+{{- end -}}
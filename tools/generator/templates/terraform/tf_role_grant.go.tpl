@@ -0,0 +1,23 @@
+package {{ .PackageName }}
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// NewRoleGrantResource returns the resource granting a user or a team a role of an object by name.
+func NewRoleGrantResource() resource.Resource {
+	return framework.NewRoleGrantResource(framework.RoleGrantConfig{
+		TypeName: "role_grant",
+		Principals: map[string]string{
+			"team": "/api/v2/teams/%d/roles/",
+			"user": "/api/v2/users/%d/roles/",
+		},
+		ObjectRoles: map[string]string{
+{{- range $obj := .Objects }}
+			"{{ $obj.TypeName }}": "{{ $obj.Endpoint }}%d/object_roles/",
+{{- end }}
+		},
+	})
+}
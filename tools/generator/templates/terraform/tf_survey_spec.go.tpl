@@ -108,8 +108,12 @@ func (o *{{ .Name | lowerCamelCase }}Survey) Read(ctx context.Context, request r
 	if framework.DiagnosticsHasError(&response.Diagnostics, request.State.Get(ctx, &state)...) { return }
 
 	endpoint := o.endpointFor(state.{{ .Name }}ID.ValueInt64())
-	data, d := framework.ReadRequest(ctx, o.Client, endpoint, "{{ .Name }}/Survey")
+	data, found, d := framework.ReadRequestAllowNotFound(ctx, o.Client, endpoint, "{{ .Name }}/Survey")
 	if framework.DiagnosticsHasError(&response.Diagnostics, d...) { return }
+	if !found {
+		response.State.RemoveResource(ctx)
+		return
+	}
 
 	if val, ok := data["spec"]; ok {
 		dg, _ := helpers.AttrValueSetJsonString(&state.Spec, val, {{ or .Trim false }})
@@ -0,0 +1,17 @@
+package {{ .PackageName }}
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/ilijamt/terraform-provider-awx/internal/framework"
+)
+
+// New{{ .Name }}GraphResource returns the resource managing every node and edge of a {{ .Name }}.
+func New{{ .Name }}GraphResource() resource.Resource {
+	return framework.NewWorkflowGraphResource(
+		"{{ $.TypeName }}_graph",
+		"{{ .Endpoint }}%d/workflow_nodes/",
+		"/api/v2/workflow_job_template_nodes/",
+		"/api/v2/workflow_approval_templates/",
+	)
+}